@@ -0,0 +1,369 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/EchoCog/echollama/core/session"
+)
+
+// ecco9ctl - operational control tool for ecco9 persistence state.
+//
+// The agent's state is spread across files under its data directory:
+// consciousness streams, goal state, hypergraph WAL + checkpoint,
+// SQLite databases. Backup walks the whole directory into one tar.gz
+// with a hash manifest so a restore can verify it got back exactly
+// what was saved. Take backups while the daemon is stopped (or right
+// after a WAL checkpoint) for a consistent snapshot.
+
+const defaultDataDir = "./echo_state"
+
+// backupManifest records what a backup archive contains
+type backupManifest struct {
+	CreatedAt time.Time             `json:"created_at"`
+	DataDir   string                `json:"data_dir"`
+	Files     []backupManifestEntry `json:"files"`
+}
+
+type backupManifestEntry struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+func main() {
+	var dataDir string
+	flag.StringVar(&dataDir, "data", defaultDataDir, "ecco9 data directory")
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) < 2 {
+		printUsage()
+		os.Exit(1)
+	}
+
+	var err error
+	switch args[0] + " " + args[1] {
+	case "memory backup":
+		err = runBackup(dataDir, args[2:])
+	case "memory restore":
+		err = runRestore(dataDir, args[2:])
+	case "session replay":
+		err = runReplay(args[2:])
+	default:
+		fmt.Printf("Unknown command: %s %s\n\n", args[0], args[1])
+		printUsage()
+		os.Exit(1)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func printUsage() {
+	fmt.Println("ecco9ctl - Operational control tool for ecco9")
+	fmt.Println("\nUsage:")
+	fmt.Println("  ecco9ctl [flags] memory backup [-out <file>]")
+	fmt.Println("  ecco9ctl [flags] memory restore -in <file> [-force]")
+	fmt.Println("  ecco9ctl session replay -in <file> [-speed N] [-kind <kind>]")
+	fmt.Println("\nCommands:")
+	fmt.Println("  memory backup    Archive the data directory into a tar.gz snapshot")
+	fmt.Println("  memory restore   Restore a snapshot into the data directory")
+	fmt.Println("  session replay   Re-render a recorded session (see ecco9d -record)")
+	fmt.Println("\nFlags:")
+	fmt.Println("  -data string")
+	fmt.Println("        ecco9 data directory (default: ./echo_state)")
+	fmt.Println("\nExamples:")
+	fmt.Println("  ecco9ctl memory backup")
+	fmt.Println("  ecco9ctl memory backup -out friday.tar.gz")
+	fmt.Println("  ecco9ctl -data /var/lib/ecco9 memory restore -in friday.tar.gz -force")
+	fmt.Println("  ecco9ctl session replay -in demo.session.jsonl -speed 10")
+}
+
+// runBackup archives the data directory into a tar.gz with a manifest
+func runBackup(dataDir string, args []string) error {
+	flags := flag.NewFlagSet("backup", flag.ExitOnError)
+	out := flags.String("out", "", "output archive path (default: ecco9-backup-<timestamp>.tar.gz)")
+	flags.Parse(args)
+
+	info, err := os.Stat(dataDir)
+	if err != nil {
+		return fmt.Errorf("data directory %s: %w", dataDir, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("data directory %s is not a directory", dataDir)
+	}
+
+	outPath := *out
+	if outPath == "" {
+		outPath = fmt.Sprintf("ecco9-backup-%s.tar.gz", time.Now().Format("20060102-150405"))
+	}
+
+	outFile, err := os.Create(outPath)
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	defer outFile.Close()
+
+	gzWriter := gzip.NewWriter(outFile)
+	tarWriter := tar.NewWriter(gzWriter)
+
+	manifest := backupManifest{
+		CreatedAt: time.Now(),
+		DataDir:   dataDir,
+	}
+
+	err = filepath.Walk(dataDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dataDir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", rel, err)
+		}
+
+		sum := sha256.Sum256(data)
+		manifest.Files = append(manifest.Files, backupManifestEntry{
+			Path:   rel,
+			Size:   int64(len(data)),
+			SHA256: hex.EncodeToString(sum[:]),
+		})
+
+		header := &tar.Header{
+			Name:    "data/" + rel,
+			Mode:    0600,
+			Size:    int64(len(data)),
+			ModTime: info.ModTime(),
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		_, err = tarWriter.Write(data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("backup failed: %w", err)
+	}
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	header := &tar.Header{
+		Name:    "manifest.json",
+		Mode:    0600,
+		Size:    int64(len(manifestData)),
+		ModTime: manifest.CreatedAt,
+	}
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+	if _, err := tarWriter.Write(manifestData); err != nil {
+		return err
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	if err := gzWriter.Close(); err != nil {
+		return err
+	}
+	if err := outFile.Sync(); err != nil {
+		return err
+	}
+
+	fmt.Printf("💾 Backed up %d file(s) from %s to %s\n", len(manifest.Files), dataDir, outPath)
+	return nil
+}
+
+// runRestore extracts a backup archive into the data directory,
+// verifying every file against the manifest
+func runRestore(dataDir string, args []string) error {
+	flags := flag.NewFlagSet("restore", flag.ExitOnError)
+	in := flags.String("in", "", "backup archive to restore")
+	force := flags.Bool("force", false, "overwrite a non-empty data directory")
+	flags.Parse(args)
+
+	if *in == "" {
+		return fmt.Errorf("restore requires -in <file>")
+	}
+
+	if entries, err := os.ReadDir(dataDir); err == nil && len(entries) > 0 && !*force {
+		return fmt.Errorf("data directory %s is not empty; pass -force to overwrite", dataDir)
+	}
+
+	inFile, err := os.Open(*in)
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer inFile.Close()
+
+	gzReader, err := gzip.NewReader(inFile)
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	tarReader := tar.NewReader(gzReader)
+
+	var manifest *backupManifest
+	restored := make(map[string]string)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read archive entry: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", header.Name, err)
+		}
+
+		if header.Name == "manifest.json" {
+			manifest = &backupManifest{}
+			if err := json.Unmarshal(data, manifest); err != nil {
+				return fmt.Errorf("failed to decode manifest: %w", err)
+			}
+			continue
+		}
+
+		rel := strings.TrimPrefix(header.Name, "data/")
+		if rel == header.Name || strings.Contains(rel, "..") {
+			return fmt.Errorf("archive contains unexpected entry %s", header.Name)
+		}
+
+		target := filepath.Join(dataDir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", rel, err)
+		}
+		if err := os.WriteFile(target, data, 0600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", rel, err)
+		}
+
+		sum := sha256.Sum256(data)
+		restored[rel] = hex.EncodeToString(sum[:])
+	}
+
+	if manifest == nil {
+		return fmt.Errorf("archive has no manifest.json")
+	}
+	for _, entry := range manifest.Files {
+		got, ok := restored[entry.Path]
+		if !ok {
+			return fmt.Errorf("archive is missing %s listed in manifest", entry.Path)
+		}
+		if got != entry.SHA256 {
+			return fmt.Errorf("checksum mismatch for %s", entry.Path)
+		}
+	}
+
+	fmt.Printf("✅ Restored %d file(s) from %s (taken %s) into %s\n",
+		len(manifest.Files), *in, manifest.CreatedAt.Format(time.RFC3339), dataDir)
+	return nil
+}
+
+// runReplay plays back a session recorded with ecco9d -record, pacing
+// events at a multiple of their original spacing
+func runReplay(args []string) error {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	in := flags.String("in", "", "session file to replay")
+	speed := flags.Float64("speed", 1.0, "playback speed multiplier (0 = no delays)")
+	maxGap := flags.Duration("max-gap", 5*time.Second, "cap on the scaled pause between events")
+	kind := flags.String("kind", "", "only replay events of this kind (input, scheduler, llm, npu)")
+	flags.Parse(args)
+
+	if *in == "" {
+		return fmt.Errorf("replay requires -in <file>")
+	}
+
+	replayer, err := session.LoadSession(*in)
+	if err != nil {
+		return fmt.Errorf("failed to load session: %w", err)
+	}
+	if replayer.Len() == 0 {
+		return fmt.Errorf("session %s contains no events", *in)
+	}
+
+	fmt.Printf("▶️  Replaying %d event(s) from %s at %gx speed\n", replayer.Len(), *in, *speed)
+
+	var start, lastTimestamp time.Time
+	played := 0
+	for {
+		var event *session.Event
+		if *kind != "" {
+			event = replayer.NextOfKind(*kind)
+		} else {
+			event = replayer.Next()
+		}
+		if event == nil {
+			break
+		}
+
+		if played == 0 {
+			start = event.Timestamp
+		} else if *speed > 0 {
+			gap := event.Timestamp.Sub(lastTimestamp)
+			if gap > 0 {
+				delay := time.Duration(float64(gap) / *speed)
+				if delay > *maxGap {
+					delay = *maxGap
+				}
+				time.Sleep(delay)
+			}
+		}
+		lastTimestamp = event.Timestamp
+
+		fmt.Printf("[+%s] #%d %s/%s %s\n",
+			event.Timestamp.Sub(start).Round(time.Millisecond),
+			event.Seq, event.Kind, event.Name, summarizePayload(event.Payload))
+		played++
+	}
+
+	fmt.Printf("⏹️  Replayed %d event(s) spanning %s\n",
+		played, lastTimestamp.Sub(start).Round(time.Second))
+	return nil
+}
+
+// summarizePayload renders an event payload as a compact single line
+func summarizePayload(payload json.RawMessage) string {
+	if len(payload) == 0 {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, payload); err != nil {
+		return string(payload)
+	}
+	summary := buf.String()
+	if len(summary) > 160 {
+		summary = summary[:157] + "..."
+	}
+	return summary
+}
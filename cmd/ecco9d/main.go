@@ -0,0 +1,436 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/EchoCog/echollama/core/consciousness"
+	"github.com/EchoCog/echollama/core/echobeats"
+	"github.com/EchoCog/echollama/core/echoself"
+	"github.com/EchoCog/echollama/core/goals"
+	"github.com/EchoCog/echollama/core/llm"
+	"github.com/EchoCog/echollama/core/memory"
+	"github.com/EchoCog/echollama/core/session"
+	"github.com/EchoCog/echollama/core/telemetry"
+)
+
+// ecco9d - the unified Deep Tree Echo daemon.
+//
+// The repository grew one throwaway test main per iteration
+// (test_autonomous_*.go); each wired up a slightly different subset
+// of subsystems and none survived contact with the next iteration.
+// ecco9d replaces them: one binary, subcommands, a config file,
+// graceful shutdown, and an admin API for poking at the running
+// system.
+
+const version = "1.0.0"
+
+// daemonConfig is loaded from a JSON file and overridden by flags
+type daemonConfig struct {
+	// DataDir holds all persistence state
+	DataDir string `json:"data_dir"`
+	// AdminAddr is the admin API listen address; empty disables it
+	AdminAddr string `json:"admin_addr"`
+
+	// Subsystem toggles
+	Consciousness bool `json:"consciousness"`
+	Goals         bool `json:"goals"`
+	EchoBeats     bool `json:"echobeats"`
+
+	// IntrospectRoot points at a source checkout for the attention
+	// feedback loop; empty disables self-introspection
+	IntrospectRoot string `json:"introspect_root"`
+
+	// RecordSession, when set, captures every LLM exchange and external
+	// input into a JSONL session file for later replay with
+	// "ecco9ctl session replay"
+	RecordSession string `json:"record_session"`
+
+	// FallbackChain orders LLM providers for failover
+	FallbackChain []string `json:"fallback_chain"`
+}
+
+func defaultConfig() daemonConfig {
+	return daemonConfig{
+		DataDir:       "./echo_state",
+		AdminAddr:     ":5001",
+		Consciousness: true,
+		Goals:         true,
+		EchoBeats:     true,
+		FallbackChain: []string{"anthropic", "openrouter", "openai"},
+	}
+}
+
+func main() {
+	args := os.Args[1:]
+	command := "run"
+	if len(args) > 0 && !flagLike(args[0]) {
+		command = args[0]
+		args = args[1:]
+	}
+
+	switch command {
+	case "run":
+		if err := runDaemon(args); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	case "version":
+		fmt.Printf("ecco9d %s\n", version)
+	default:
+		fmt.Printf("Unknown command: %s\n\n", command)
+		printUsage()
+		os.Exit(1)
+	}
+}
+
+func flagLike(arg string) bool {
+	return len(arg) > 0 && arg[0] == '-'
+}
+
+func printUsage() {
+	fmt.Println("ecco9d - Deep Tree Echo daemon")
+	fmt.Println("\nUsage:")
+	fmt.Println("  ecco9d [run] [flags]")
+	fmt.Println("  ecco9d version")
+	fmt.Println("\nFlags:")
+	fmt.Println("  -config string   JSON config file")
+	fmt.Println("  -data string     data directory (default: ./echo_state)")
+	fmt.Println("  -admin string    admin API address, empty to disable (default: :5001)")
+	fmt.Println("  -no-consciousness, -no-goals, -no-echobeats")
+	fmt.Println("                   disable individual subsystems")
+	fmt.Println("  -introspect string")
+	fmt.Println("                   source checkout for the attention feedback loop")
+	fmt.Println("  -record string   session file to record this run into")
+	fmt.Println("\nExamples:")
+	fmt.Println("  ecco9d")
+	fmt.Println("  ecco9d run -data /var/lib/ecco9 -admin :9000")
+	fmt.Println("  ecco9d run -config /etc/ecco9/config.json -no-echobeats")
+}
+
+// loadConfig merges defaults, the optional config file, and flags
+func loadConfig(args []string) (daemonConfig, error) {
+	config := defaultConfig()
+
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	configPath := flags.String("config", "", "JSON config file")
+	dataDir := flags.String("data", "", "data directory")
+	adminAddr := flags.String("admin", "", "admin API address")
+	noConsciousness := flags.Bool("no-consciousness", false, "disable the consciousness stream")
+	noGoals := flags.Bool("no-goals", false, "disable goal orchestration")
+	noEchoBeats := flags.Bool("no-echobeats", false, "disable the echobeats scheduler")
+	introspectRoot := flags.String("introspect", "", "source checkout for the attention feedback loop")
+	recordSession := flags.String("record", "", "session file to record this run into")
+	flags.Parse(args)
+
+	if *configPath != "" {
+		data, err := os.ReadFile(*configPath)
+		if err != nil {
+			return config, fmt.Errorf("failed to read config: %w", err)
+		}
+		if err := json.Unmarshal(data, &config); err != nil {
+			return config, fmt.Errorf("failed to parse config: %w", err)
+		}
+	}
+
+	if *dataDir != "" {
+		config.DataDir = *dataDir
+	}
+	if *adminAddr != "" {
+		config.AdminAddr = *adminAddr
+	}
+	if *noConsciousness {
+		config.Consciousness = false
+	}
+	if *noGoals {
+		config.Goals = false
+	}
+	if *noEchoBeats {
+		config.EchoBeats = false
+	}
+	if *introspectRoot != "" {
+		config.IntrospectRoot = *introspectRoot
+	}
+	if *recordSession != "" {
+		config.RecordSession = *recordSession
+	}
+	return config, nil
+}
+
+// daemon holds the running subsystems
+type daemon struct {
+	config     daemonConfig
+	llmManager *llm.ProviderManager
+	hypergraph *memory.HypergraphMemory
+
+	soc       *consciousness.StreamOfConsciousnessLLM
+	goals     *goals.GoalOrchestrator
+	scheduler *echobeats.EnhancedScheduler
+	attention *echoself.AttentionFeedbackLoop
+
+	exporter  *telemetry.PrometheusExporter
+	recorder  *session.Recorder
+	startedAt time.Time
+}
+
+func runDaemon(args []string) error {
+	config, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(config.DataDir, 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	fmt.Printf("🌳 ecco9d %s starting (data: %s)\n", version, config.DataDir)
+
+	d := &daemon{config: config, startedAt: time.Now()}
+	if config.RecordSession != "" {
+		recorder, err := session.NewRecorder(config.RecordSession)
+		if err != nil {
+			return fmt.Errorf("failed to open session recorder: %w", err)
+		}
+		d.recorder = recorder
+	}
+	d.llmManager = buildProviderManager(config.FallbackChain, d.recorder)
+	d.hypergraph = memory.NewHypergraphMemory(nil)
+	if err := d.hypergraph.EnableWAL(filepath.Join(config.DataDir, "hypergraph")); err != nil {
+		return fmt.Errorf("failed to enable hypergraph WAL: %w", err)
+	}
+
+	if err := d.start(); err != nil {
+		d.stop()
+		return err
+	}
+
+	if config.AdminAddr != "" {
+		go d.serveAdmin()
+	}
+
+	// Run until interrupted
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-sigChan
+	fmt.Printf("\n🛑 ecco9d: received %v, shutting down...\n", sig)
+
+	d.stop()
+	fmt.Println("✨ ecco9d stopped gracefully")
+	return nil
+}
+
+// buildProviderManager registers whichever providers have keys,
+// wrapping each in a recording layer when a session is being captured
+func buildProviderManager(fallbackChain []string, recorder *session.Recorder) *llm.ProviderManager {
+	manager := llm.NewProviderManager()
+
+	for name, provider := range map[string]llm.LLMProvider{
+		"anthropic":  llm.NewAnthropicProvider(""),
+		"openrouter": llm.NewOpenRouterProvider(""),
+		"openai":     llm.NewOpenAIProvider(""),
+	} {
+		if provider.Available() {
+			if recorder != nil {
+				provider = session.NewRecordingProvider(provider, recorder)
+			}
+			manager.RegisterProvider(provider)
+			fmt.Printf("  ✅ %s provider registered\n", name)
+		} else {
+			fmt.Printf("  ⚠️  %s provider not available (missing API key)\n", name)
+		}
+	}
+	manager.SetFallbackChain(fallbackChain)
+	return manager
+}
+
+// start brings up the enabled subsystems in dependency order
+func (d *daemon) start() error {
+	if d.config.Consciousness {
+		d.soc = consciousness.NewStreamOfConsciousnessLLM(
+			d.llmManager, filepath.Join(d.config.DataDir, "stream_of_consciousness.json"))
+		if err := d.soc.Start(); err != nil {
+			return fmt.Errorf("failed to start consciousness stream: %w", err)
+		}
+	}
+
+	if d.config.Goals {
+		d.goals = goals.NewGoalOrchestrator(nil, filepath.Join(d.config.DataDir, "goals.json"))
+		if err := d.goals.Start(); err != nil {
+			return fmt.Errorf("failed to start goal orchestrator: %w", err)
+		}
+	}
+
+	if d.config.EchoBeats {
+		d.scheduler = echobeats.NewEnhancedScheduler()
+		if err := d.scheduler.Start(); err != nil {
+			return fmt.Errorf("failed to start echobeats scheduler: %w", err)
+		}
+	}
+
+	// The attention feedback loop needs the consciousness stream both
+	// for its probes and as the sink for surfaced thoughts
+	if d.config.IntrospectRoot != "" && d.soc != nil {
+		introspector := echoself.NewRepositoryIntrospector(d.config.IntrospectRoot, 0.6)
+		if err := introspector.Scan(); err != nil {
+			return fmt.Errorf("failed to scan introspection root: %w", err)
+		}
+
+		d.attention = echoself.NewAttentionFeedbackLoop(
+			introspector, d.cognitiveLoadProbe, d.activityProbe())
+		d.attention.SetOnSelfImprovement(func(content string, salience float64) {
+			if d.recorder != nil {
+				d.recorder.Record(session.EventInput, "self_improvement", map[string]interface{}{
+					"content":  content,
+					"salience": salience,
+				})
+			}
+			d.soc.AddExternalThought(content)
+		})
+		if err := d.attention.Start(); err != nil {
+			return fmt.Errorf("failed to start attention feedback loop: %w", err)
+		}
+	}
+
+	d.setupTelemetry()
+
+	if d.recorder != nil {
+		d.recorder.Record(session.EventScheduler, "daemon_start", map[string]interface{}{
+			"version":  version,
+			"data_dir": d.config.DataDir,
+		})
+	}
+	return nil
+}
+
+// metricsFunc adapts subsystems whose metrics live behind a differently
+// shaped method onto telemetry.MetricSource
+type metricsFunc func() map[string]interface{}
+
+func (f metricsFunc) GetMetrics() map[string]interface{} { return f() }
+
+// setupTelemetry registers every running subsystem with the Prometheus
+// exporter, served at /metrics on the admin API
+func (d *daemon) setupTelemetry() {
+	d.exporter = telemetry.NewPrometheusExporter()
+	d.exporter.RegisterSource("llm", metricsFunc(d.llmProviderMetrics))
+	d.exporter.RegisterSource("memory", d.hypergraph)
+	if d.soc != nil {
+		d.exporter.RegisterSource("consciousness", d.soc)
+	}
+	if d.goals != nil {
+		d.exporter.RegisterSource("goals", d.goals)
+	}
+	if d.scheduler != nil {
+		d.exporter.RegisterSource("scheduler", metricsFunc(d.scheduler.GetStatus))
+	}
+	if d.attention != nil {
+		d.exporter.RegisterSource("attention", d.attention)
+	}
+	d.exporter.RegisterGauge("uptime_seconds", func() float64 {
+		return time.Since(d.startedAt).Seconds()
+	})
+}
+
+// llmProviderMetrics flattens the provider manager's per-provider
+// metrics into one exportable map
+func (d *daemon) llmProviderMetrics() map[string]interface{} {
+	flat := make(map[string]interface{})
+	for name, metrics := range d.llmManager.GetMetrics() {
+		flat[name+"_requests"] = metrics.RequestCount
+		flat[name+"_errors"] = metrics.ErrorCount
+		flat[name+"_timeouts"] = metrics.TimeoutCount
+		flat[name+"_error_rate"] = metrics.ErrorRate
+		flat[name+"_latency_ms"] = float64(metrics.AverageLatency.Milliseconds())
+	}
+	return flat
+}
+
+// cognitiveLoadProbe samples the consciousness stream's current load
+func (d *daemon) cognitiveLoadProbe() float64 {
+	if load, ok := d.soc.GetMetrics()["cognitive_load"].(float64); ok {
+		return load
+	}
+	return 0.5
+}
+
+// activityProbe tracks thought throughput between samples, normalized
+// so ten or more new thoughts per interval reads as full activity
+func (d *daemon) activityProbe() func() float64 {
+	var last uint64
+	return func() float64 {
+		count, ok := d.soc.GetMetrics()["thoughts_generated"].(uint64)
+		if !ok {
+			return 0.5
+		}
+		delta := float64(count - last)
+		last = count
+		if delta > 10 {
+			delta = 10
+		}
+		return delta / 10
+	}
+}
+
+// stop shuts subsystems down in reverse start order
+func (d *daemon) stop() {
+	if d.attention != nil {
+		d.attention.Stop()
+	}
+	if d.scheduler != nil {
+		d.scheduler.Stop()
+	}
+	if d.goals != nil {
+		d.goals.Stop()
+	}
+	if d.soc != nil {
+		d.soc.Stop()
+	}
+	if d.recorder != nil {
+		d.recorder.Record(session.EventScheduler, "daemon_stop", nil)
+		d.recorder.Close()
+	}
+}
+
+// serveAdmin exposes health, status, and memory analytics
+func (d *daemon) serveAdmin() {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "ok",
+			"uptime": time.Since(d.startedAt).String(),
+		})
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		status := map[string]interface{}{
+			"version":    version,
+			"started_at": d.startedAt,
+			"data_dir":   d.config.DataDir,
+		}
+		if d.soc != nil {
+			status["consciousness"] = d.soc.GetMetrics()
+		}
+		if d.goals != nil {
+			status["goals"] = d.goals.GetMetrics()
+		}
+		if d.attention != nil {
+			status["attention"] = d.attention.GetMetrics()
+		}
+		status["memory"] = d.hypergraph.GetMetrics()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(status)
+	})
+	mux.Handle("/memory/analytics", d.hypergraph.AnalyticsHandler())
+	mux.Handle("/metrics", d.exporter.Handler())
+
+	fmt.Printf("🌐 ecco9d: admin API listening on %s\n", d.config.AdminAddr)
+	if err := http.ListenAndServe(d.config.AdminAddr, mux); err != nil {
+		fmt.Printf("⚠️ ecco9d: admin API failed: %v\n", err)
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// echo-chat - interactive terminal client for the Deep Tree Echo
+// chat API.
+//
+// Talks to the embodied server's POST /api/chat endpoint, keeping the
+// conversation history across turns, and can subscribe to the /ws
+// status stream to show what the system is doing between replies.
+
+const defaultBaseURL = "http://localhost:8080"
+
+// chatMessage matches the server's message format
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatClient struct {
+	baseURL string
+	client  *http.Client
+	history []chatMessage
+}
+
+func main() {
+	var baseURL string
+	var watch bool
+	flag.StringVar(&baseURL, "url", defaultBaseURL, "ecco9 server URL")
+	flag.BoolVar(&watch, "watch", false, "stream live status over WebSocket alongside the chat")
+	flag.Parse()
+
+	client := &chatClient{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: 120 * time.Second},
+	}
+
+	fmt.Println("🌳 echo-chat - Deep Tree Echo terminal client")
+	fmt.Printf("   Connected to %s\n", client.baseURL)
+	fmt.Println("   Type /help for commands, /quit to exit")
+	fmt.Println()
+
+	if watch {
+		go client.watchStatus()
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Print("you> ")
+		if !scanner.Scan() {
+			break
+		}
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "/") {
+			if client.handleCommand(line) {
+				return
+			}
+			continue
+		}
+
+		reply, err := client.send(line)
+		if err != nil {
+			fmt.Printf("⚠️  %v\n", err)
+			continue
+		}
+		fmt.Printf("echo> %s\n\n", reply)
+	}
+}
+
+// handleCommand processes a slash command; returns true to exit
+func (c *chatClient) handleCommand(line string) bool {
+	switch strings.Fields(line)[0] {
+	case "/quit", "/exit":
+		fmt.Println("👋 Goodbye")
+		return true
+	case "/clear":
+		c.history = nil
+		fmt.Println("🧹 Conversation cleared")
+	case "/status":
+		c.showStatus()
+	case "/help":
+		fmt.Println("Commands:")
+		fmt.Println("  /status   show the system's current state")
+		fmt.Println("  /clear    forget the conversation so far")
+		fmt.Println("  /quit     exit")
+	default:
+		fmt.Printf("Unknown command: %s (try /help)\n", line)
+	}
+	return false
+}
+
+// send posts the conversation to /api/chat and returns the reply
+func (c *chatClient) send(content string) (string, error) {
+	c.history = append(c.history, chatMessage{Role: "user", Content: content})
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"messages": c.history,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	resp, err := c.client.Post(c.baseURL+"/api/chat", "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("chat request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("server returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var parsed struct {
+		Message chatMessage `json:"message"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	c.history = append(c.history, parsed.Message)
+	return parsed.Message.Content, nil
+}
+
+// showStatus fetches and prints /api/echo/status
+func (c *chatClient) showStatus() {
+	resp, err := c.client.Get(c.baseURL + "/api/echo/status")
+	if err != nil {
+		fmt.Printf("⚠️  status request failed: %v\n", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	var status map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		fmt.Printf("⚠️  failed to decode status: %v\n", err)
+		return
+	}
+	for key, value := range status {
+		fmt.Printf("  %s: %v\n", key, value)
+	}
+}
+
+// watchStatus follows the /ws stream and surfaces notable changes
+// without drowning the conversation
+func (c *chatClient) watchStatus() {
+	wsURL, err := url.Parse(c.baseURL)
+	if err != nil {
+		return
+	}
+	switch wsURL.Scheme {
+	case "https":
+		wsURL.Scheme = "wss"
+	default:
+		wsURL.Scheme = "ws"
+	}
+	wsURL.Path = "/ws"
+
+	for {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL.String(), nil)
+		if err != nil {
+			time.Sleep(5 * time.Second)
+			continue
+		}
+
+		var lastThought string
+		for {
+			var status map[string]interface{}
+			if err := conn.ReadJSON(&status); err != nil {
+				break
+			}
+			if thought, ok := status["current_thought"].(string); ok && thought != "" && thought != lastThought {
+				lastThought = thought
+				fmt.Printf("\n💭 %s\nyou> ", thought)
+			}
+		}
+		conn.Close()
+		time.Sleep(5 * time.Second)
+	}
+}
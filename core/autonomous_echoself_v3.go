@@ -3,13 +3,13 @@ package core
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
+	"log/slog"
 	"sync"
 	"time"
-	
+
 	"github.com/EchoCog/echollama/core/deeptreeecho"
 	"github.com/EchoCog/echollama/core/echoself"
+	"github.com/EchoCog/echollama/core/logging"
 	"github.com/EchoCog/echollama/core/persistence"
 )
 
@@ -33,7 +33,10 @@ type AutonomousEchoselfV3 struct {
 	
 	// Configuration
 	config                *EchoselfConfigV3
-	
+
+	// Structured logging
+	logger                *slog.Logger
+
 	// Metrics
 	startTime             time.Time
 	thoughtsGenerated     uint64
@@ -94,14 +97,15 @@ func NewAutonomousEchoselfV3(config *EchoselfConfigV3) (*AutonomousEchoselfV3, e
 		config:       config,
 		currentState: "initializing",
 		startTime:    time.Now(),
+		logger:       logging.ForSubsystem("autonomous_echoself"),
 	}
-	
+
 	// Initialize multi-provider LLM
 	ae.llmProvider = deeptreeecho.NewMultiProviderLLM()
 	if !ae.llmProvider.IsAvailable() {
-		log.Println("⚠️  No LLM providers available - autonomous thought generation will be limited")
+		ae.logger.Warn("no LLM providers available, thought generation will be limited")
 	} else {
-		log.Printf("✅ LLM providers initialized: %v\n", ae.llmProvider.GetAvailableProviders())
+		ae.logger.Info("LLM providers initialized", "providers", ae.llmProvider.GetAvailableProviders())
 	}
 	
 	// Initialize thought generator
@@ -122,13 +126,14 @@ func NewAutonomousEchoselfV3(config *EchoselfConfigV3) (*AutonomousEchoselfV3, e
 		)
 		
 		// Perform initial scan
-		log.Println("🔍 Performing initial repository introspection...")
+		ae.logger.Info("performing initial repository introspection")
 		if err := ae.repoIntrospector.Scan(); err != nil {
-			log.Printf("⚠️  Repository scan failed: %v\n", err)
+			ae.logger.Warn("repository scan failed", "error", err)
 		} else {
 			stats := ae.repoIntrospector.GetStats()
-			log.Printf("✅ Repository scanned: %v total files, %v high-salience\n",
-				stats["total_files"], stats["scanned_files"])
+			ae.logger.Info("repository scanned",
+				"total_files", stats["total_files"],
+				"high_salience_files", stats["scanned_files"])
 		}
 	}
 	
@@ -139,13 +144,13 @@ func NewAutonomousEchoselfV3(config *EchoselfConfigV3) (*AutonomousEchoselfV3, e
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize persistence: %w", err)
 		}
-		log.Printf("✅ Persistence initialized: %s\n", config.PersistenceDBPath)
-		
+		ae.logger.Info("persistence initialized", "db_path", config.PersistenceDBPath)
+
 		// Load previous state
 		if err := ae.loadState(); err != nil {
-			log.Printf("⚠️  Failed to load previous state: %v\n", err)
+			ae.logger.Warn("failed to load previous state", "error", err)
 		} else {
-			log.Println("✅ Previous state loaded")
+			ae.logger.Info("previous state loaded")
 		}
 	}
 	
@@ -163,8 +168,7 @@ func (ae *AutonomousEchoselfV3) Start() error {
 	ae.currentState = "awake"
 	ae.mu.Unlock()
 	
-	log.Println("🌳 Deep Tree Echo V3 - Starting autonomous cognitive loop")
-	log.Println(strings.Repeat("=", 60))
+	ae.logger.Info("starting autonomous cognitive loop", "version", "v3")
 	
 	// Start concurrent goroutines for different cognitive processes
 	go ae.thoughtGenerationLoop()
@@ -193,21 +197,21 @@ func (ae *AutonomousEchoselfV3) Stop() error {
 		return fmt.Errorf("system not running")
 	}
 	
-	log.Println("🛑 Stopping autonomous system...")
-	
+	ae.logger.Info("stopping autonomous system")
+
 	ae.isRunning = false
 	ae.currentState = "stopping"
 	ae.cancel()
-	
+
 	// Final persistence save
 	if ae.persistence != nil {
 		if err := ae.saveState(); err != nil {
-			log.Printf("⚠️  Failed to save final state: %v\n", err)
+			ae.logger.Warn("failed to save final state", "error", err)
 		}
 		ae.persistence.Close()
 	}
-	
-	log.Println("✅ Autonomous system stopped")
+
+	ae.logger.Info("autonomous system stopped")
 	
 	return nil
 }
@@ -231,17 +235,24 @@ func (ae *AutonomousEchoselfV3) thoughtGenerationLoop() {
 func (ae *AutonomousEchoselfV3) generateThought() {
 	thought, err := ae.thoughtGenerator.GenerateAutonomousThought()
 	if err != nil {
-		log.Printf("⚠️  Thought generation failed: %v\n", err)
+		ae.logger.Warn("thought generation failed", "error", err)
 		return
 	}
-	
+
 	ae.mu.Lock()
 	ae.thoughtsGenerated++
 	ae.cycleCount++
+	cycleID := ae.cycleCount
 	ae.mu.Unlock()
-	
-	log.Printf("💭 [%s] %s\n", thought.Type, thought.Content)
-	
+
+	thoughtID := thought.Timestamp.UnixNano()
+	logger := ae.logger.With(logging.KeyCycleID, cycleID, logging.KeyThoughtID, thoughtID)
+
+	logger.Info("thought generated",
+		"thought_type", thought.Type,
+		"importance", thought.Importance,
+		"content", thought.Content)
+
 	// Persist thought if enabled
 	if ae.persistence != nil && thought.Importance >= ae.config.ImportanceThreshold {
 		err := ae.persistence.PersistThought(
@@ -252,7 +263,7 @@ func (ae *AutonomousEchoselfV3) generateThought() {
 			thought.Importance,
 		)
 		if err != nil {
-			log.Printf("⚠️  Failed to persist thought: %v\n", err)
+			logger.Warn("failed to persist thought", "error", err)
 		}
 	}
 	
@@ -286,12 +297,15 @@ func (ae *AutonomousEchoselfV3) reflectionLoop() {
 func (ae *AutonomousEchoselfV3) generateReflection() {
 	reflection, err := ae.thoughtGenerator.GenerateReflection()
 	if err != nil {
-		log.Printf("⚠️  Reflection generation failed: %v\n", err)
+		ae.logger.Warn("reflection generation failed", "error", err)
 		return
 	}
-	
-	log.Printf("🔮 [reflection] %s\n", reflection.Content)
-	
+
+	logger := ae.logger.With(logging.KeyThoughtID, reflection.Timestamp.UnixNano())
+	logger.Info("reflection generated",
+		"importance", reflection.Importance,
+		"content", reflection.Content)
+
 	// Persist reflection
 	if ae.persistence != nil {
 		err := ae.persistence.PersistThought(
@@ -302,7 +316,7 @@ func (ae *AutonomousEchoselfV3) generateReflection() {
 			reflection.Importance,
 		)
 		if err != nil {
-			log.Printf("⚠️  Failed to persist reflection: %v\n", err)
+			logger.Warn("failed to persist reflection", "error", err)
 		}
 	}
 	
@@ -331,17 +345,18 @@ func (ae *AutonomousEchoselfV3) performIntrospection() {
 		return
 	}
 	
-	log.Println("🔍 Performing repository introspection...")
-	
+	ae.logger.Info("performing repository introspection")
+
 	err := ae.repoIntrospector.Scan()
 	if err != nil {
-		log.Printf("⚠️  Introspection failed: %v\n", err)
+		ae.logger.Warn("introspection failed", "error", err)
 		return
 	}
-	
+
 	stats := ae.repoIntrospector.GetStats()
-	log.Printf("✅ Introspection complete: %v total files, %v high-salience\n",
-		stats["total_files"], stats["scanned_files"])
+	ae.logger.Info("introspection complete",
+		"total_files", stats["total_files"],
+		"high_salience_files", stats["scanned_files"])
 	
 	// Form memory about introspection
 	if ae.persistence != nil {
@@ -368,7 +383,7 @@ func (ae *AutonomousEchoselfV3) persistenceLoop() {
 			return
 		case <-ticker.C:
 			if err := ae.saveState(); err != nil {
-				log.Printf("⚠️  Failed to save state: %v\n", err)
+				ae.logger.Warn("failed to save state", "error", err)
 			}
 		}
 	}
@@ -392,7 +407,8 @@ func (ae *AutonomousEchoselfV3) formMemory(thought *GeneratedThought) {
 	)
 	
 	if err != nil {
-		log.Printf("⚠️  Failed to form memory: %v\n", err)
+		ae.logger.Warn("failed to form memory", "error", err,
+			logging.KeyThoughtID, thought.Timestamp.UnixNano())
 	}
 }
 
@@ -423,9 +439,12 @@ func (ae *AutonomousEchoselfV3) generateGoalFromThought(thought *GeneratedThough
 	)
 	
 	if err != nil {
-		log.Printf("⚠️  Failed to create goal: %v\n", err)
+		ae.logger.Warn("failed to create goal", "error", err)
 	} else {
-		log.Printf("🎯 Goal created (ID: %d): %s\n", goalID, goalDescription)
+		ae.logger.Info("goal created",
+			"goal_id", goalID,
+			"description", goalDescription,
+			logging.KeyThoughtID, thought.Timestamp.UnixNano())
 	}
 }
 
@@ -462,10 +481,12 @@ func (ae *AutonomousEchoselfV3) loadState() error {
 	// Load working memory
 	workingMemory, err := ae.persistence.LoadWorkingMemory()
 	if err == nil && len(workingMemory) > 0 {
-		ae.mu.Lock()
-		ae.thoughtGenerator.workingMemory = workingMemory
-		ae.mu.Unlock()
-		log.Printf("📚 Loaded %d items into working memory\n", len(workingMemory))
+		// Persisted contents carry no importance; restore at a neutral
+		// level and let rehearsal re-rank them
+		for _, content := range workingMemory {
+			ae.thoughtGenerator.updateWorkingMemory(content, 0.5)
+		}
+		ae.logger.Info("loaded working memory", "items", len(workingMemory))
 	}
 	
 	// Load interest patterns
@@ -474,13 +495,13 @@ func (ae *AutonomousEchoselfV3) loadState() error {
 		ae.mu.Lock()
 		ae.thoughtGenerator.interestPatterns = interests
 		ae.mu.Unlock()
-		log.Printf("💡 Loaded %d interest patterns\n", len(interests))
+		ae.logger.Info("loaded interest patterns", "count", len(interests))
 	}
 	
 	// Load recent thoughts
 	recentThoughts, err := ae.persistence.LoadRecentThoughts(5)
 	if err == nil && len(recentThoughts) > 0 {
-		log.Printf("💭 Found %d recent thoughts from previous session\n", len(recentThoughts))
+		ae.logger.Info("found recent thoughts from previous session", "count", len(recentThoughts))
 	}
 	
 	return nil
@@ -2,21 +2,31 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/EchoCog/echollama/core/consciousness"
+	"github.com/EchoCog/echollama/core/llm"
 )
 
 // ThoughtGenerator generates autonomous thoughts using LLM and context
 type ThoughtGenerator struct {
 	mu                sync.RWMutex
 	llmProvider       LLMProvider
-	workingMemory     []string
+	workingMemory     *consciousness.WorkingMemory
 	interestPatterns  map[string]float64
 	thoughtHistory    []GeneratedThought
 	maxHistorySize    int
 	generationCount   int64
+
+	// Optional spend limits; when exhausted, generation degrades to
+	// template-based thoughts instead of calling the LLM
+	budget            *llm.BudgetManager
+	templateThoughts  int64
 }
 
 // LLMProvider interface for thought generation
@@ -40,13 +50,20 @@ type GeneratedThought struct {
 func NewThoughtGenerator(llmProvider LLMProvider) *ThoughtGenerator {
 	return &ThoughtGenerator{
 		llmProvider:      llmProvider,
-		workingMemory:    make([]string, 0, 7), // 7-item working memory
+		workingMemory:    consciousness.NewWorkingMemory(0), // salience-weighted, default 7-item span
 		interestPatterns: make(map[string]float64),
 		thoughtHistory:   make([]GeneratedThought, 0),
 		maxHistorySize:   100,
 	}
 }
 
+// SetBudget installs spend limits enforced before each LLM call
+func (tg *ThoughtGenerator) SetBudget(budget *llm.BudgetManager) {
+	tg.mu.Lock()
+	defer tg.mu.Unlock()
+	tg.budget = budget
+}
+
 // GenerateAutonomousThought generates a thought based on current context
 func (tg *ThoughtGenerator) GenerateAutonomousThought() (*GeneratedThought, error) {
 	tg.mu.RLock()
@@ -54,20 +71,35 @@ func (tg *ThoughtGenerator) GenerateAutonomousThought() (*GeneratedThought, erro
 		tg.mu.RUnlock()
 		return nil, fmt.Errorf("LLM provider not available")
 	}
+	budget := tg.budget
 	tg.mu.RUnlock()
-	
+
+	// Enforce the budget before spending; degrade gracefully when out
+	if budget != nil {
+		if err := budget.Allow(); err != nil {
+			if errors.Is(err, llm.ErrBudgetExhausted) {
+				return tg.generateTemplateThought(), nil
+			}
+			return nil, err
+		}
+	}
+
 	// Build context-aware prompt
 	prompt := tg.buildContextualPrompt()
-	
+
 	// Generate thought
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
-	
+
 	content, err := tg.llmProvider.GenerateThought(ctx, prompt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate thought: %w", err)
 	}
-	
+
+	if budget != nil {
+		budget.RecordUsage(llm.EstimateTokens(prompt)+llm.EstimateTokens(content), 0)
+	}
+
 	// Create thought object
 	thought := &GeneratedThought{
 		Content:    content,
@@ -80,10 +112,10 @@ func (tg *ThoughtGenerator) GenerateAutonomousThought() (*GeneratedThought, erro
 	
 	// Add to history
 	tg.addToHistory(thought)
-	
+
 	// Update working memory
-	tg.updateWorkingMemory(content)
-	
+	tg.updateWorkingMemory(content, thought.Importance)
+
 	tg.mu.Lock()
 	tg.generationCount++
 	tg.mu.Unlock()
@@ -98,27 +130,41 @@ func (tg *ThoughtGenerator) GenerateReflection() (*GeneratedThought, error) {
 		tg.mu.RUnlock()
 		return nil, fmt.Errorf("LLM provider not available")
 	}
-	
+	budget := tg.budget
+
 	// Get recent thoughts for context
 	recentThoughts := tg.getRecentThoughts(5)
 	tg.mu.RUnlock()
-	
+
 	if len(recentThoughts) == 0 {
 		return nil, fmt.Errorf("no recent thoughts to reflect on")
 	}
-	
+
+	if budget != nil {
+		if err := budget.Allow(); err != nil {
+			if errors.Is(err, llm.ErrBudgetExhausted) {
+				return tg.generateTemplateThought(), nil
+			}
+			return nil, err
+		}
+	}
+
 	// Build reflection context
 	contextStr := tg.buildReflectionContext(recentThoughts)
-	
+
 	// Generate reflection
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
-	
+
 	content, err := tg.llmProvider.GenerateReflection(ctx, contextStr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate reflection: %w", err)
 	}
-	
+
+	if budget != nil {
+		budget.RecordUsage(llm.EstimateTokens(contextStr)+llm.EstimateTokens(content), 0)
+	}
+
 	// Create thought object
 	thought := &GeneratedThought{
 		Content:    content,
@@ -131,24 +177,61 @@ func (tg *ThoughtGenerator) GenerateReflection() (*GeneratedThought, error) {
 	
 	// Add to history
 	tg.addToHistory(thought)
-	
+
 	// Update working memory
-	tg.updateWorkingMemory(content)
-	
+	tg.updateWorkingMemory(content, thought.Importance)
+
 	return thought, nil
 }
 
+// generateTemplateThought produces a thought without calling the LLM,
+// used when the budget is exhausted so the cognitive loop keeps moving
+func (tg *ThoughtGenerator) generateTemplateThought() *GeneratedThought {
+	templates := []string{
+		"I notice my thoughts circling back to %s - there is more to explore there.",
+		"What patterns connect %s to my recent experiences?",
+		"Resting with the idea of %s, letting associations form on their own.",
+		"I wonder what I am missing about %s.",
+		"Revisiting %s with fresh eyes might reveal something new.",
+	}
+
+	topic := "my current state"
+	if interests := tg.getTopInterests(1); len(interests) > 0 {
+		topic = interests[0]
+	}
+
+	content := fmt.Sprintf(templates[int(time.Now().Unix())%len(templates)], topic)
+
+	thought := &GeneratedThought{
+		Content:    content,
+		Type:       "observation",
+		Timestamp:  time.Now(),
+		Context:    tg.getWorkingMemoryCopy(),
+		Interests:  tg.getTopInterests(3),
+		Importance: 0.3, // Template thoughts carry less weight
+	}
+
+	tg.addToHistory(thought)
+	tg.updateWorkingMemory(content, thought.Importance)
+
+	tg.mu.Lock()
+	tg.templateThoughts++
+	tg.mu.Unlock()
+
+	return thought
+}
+
 // buildContextualPrompt builds a prompt based on current context
 func (tg *ThoughtGenerator) buildContextualPrompt() string {
 	tg.mu.RLock()
 	defer tg.mu.RUnlock()
 	
 	var sb strings.Builder
-	
-	// Add working memory context
-	if len(tg.workingMemory) > 0 {
+
+	// Add working memory context, most salient thoughts first
+	if contents := tg.getWorkingMemoryCopy(); len(contents) > 0 {
 		sb.WriteString("Recent thoughts in working memory:\n")
-		for i, mem := range tg.workingMemory {
+		for i, mem := range contents {
 			sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, mem))
 		}
 		sb.WriteString("\n")
@@ -234,30 +317,31 @@ func (tg *ThoughtGenerator) calculateImportance(content string) float64 {
 	return importance
 }
 
-// updateWorkingMemory adds content to working memory (7-item limit)
-func (tg *ThoughtGenerator) updateWorkingMemory(content string) {
-	tg.mu.Lock()
-	defer tg.mu.Unlock()
-	
-	// Add to working memory
-	tg.workingMemory = append(tg.workingMemory, content)
-	
-	// Keep only last 7 items
-	if len(tg.workingMemory) > 7 {
-		tg.workingMemory = tg.workingMemory[len(tg.workingMemory)-7:]
-	}
+// updateWorkingMemory adds content to working memory. Eviction is by
+// salience rather than FIFO, and re-adding the same content rehearses
+// it instead of duplicating.
+func (tg *ThoughtGenerator) updateWorkingMemory(content string, importance float64) {
+	hash := fnv.New64a()
+	hash.Write([]byte(content))
+
+	tg.workingMemory.AddThought(&consciousness.Thought{
+		ID:        fmt.Sprintf("wm_%x", hash.Sum64()),
+		Timestamp: time.Now(),
+		Content:   content,
+		Source:    "thought_generator",
+	}, importance)
 }
 
-// getWorkingMemoryCopy returns a copy of working memory
+// getWorkingMemoryCopy returns the working memory contents, most
+// salient first
 func (tg *ThoughtGenerator) getWorkingMemoryCopy() []string {
-	tg.mu.RLock()
-	defer tg.mu.RUnlock()
-	
-	copy := make([]string, len(tg.workingMemory))
-	for i, mem := range tg.workingMemory {
-		copy[i] = mem
+	thoughts := tg.workingMemory.GetThoughts()
+
+	contents := make([]string, len(thoughts))
+	for i, thought := range thoughts {
+		contents[i] = thought.Content
 	}
-	return copy
+	return contents
 }
 
 // addToHistory adds a thought to history
@@ -318,10 +402,19 @@ func (tg *ThoughtGenerator) GetStats() map[string]interface{} {
 	tg.mu.RLock()
 	defer tg.mu.RUnlock()
 	
-	return map[string]interface{}{
+	stats := map[string]interface{}{
 		"generation_count":  tg.generationCount,
+		"template_thoughts": tg.templateThoughts,
 		"history_size":      len(tg.thoughtHistory),
-		"working_memory_size": len(tg.workingMemory),
+		"working_memory_size": tg.workingMemory.Size(),
 		"interest_count":    len(tg.interestPatterns),
 	}
+
+	if tg.budget != nil {
+		for k, v := range tg.budget.GetMetrics() {
+			stats["budget_"+k] = v
+		}
+	}
+
+	return stats
 }
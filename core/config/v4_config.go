@@ -0,0 +1,219 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// V4Config gathers the previously scattered tuning constants of the
+// V4 consciousness subsystems into one struct, loadable from YAML with
+// environment overrides — mirroring EchoselfConfigV3 but covering the
+// wake/rest, cognitive load, working memory, dream, and persistence
+// subsystems together.
+type V4Config struct {
+	// Wake/rest cycle
+	WakeRest WakeRestConfig `yaml:"wake_rest"`
+
+	// Cognitive load and fatigue
+	CognitiveLoad CognitiveLoadConfig `yaml:"cognitive_load"`
+
+	// Working memory
+	WorkingMemory WorkingMemoryConfig `yaml:"working_memory"`
+
+	// Dream cycles
+	Dream DreamConfig `yaml:"dream"`
+
+	// Tick intervals of the main loops
+	Ticks TickConfig `yaml:"ticks"`
+
+	// Persistence choices
+	Persistence PersistenceConfig `yaml:"persistence"`
+}
+
+// WakeRestConfig tunes the autonomous wake/rest manager
+type WakeRestConfig struct {
+	MinWakeDuration   time.Duration `yaml:"min_wake_duration"`
+	MaxWakeDuration   time.Duration `yaml:"max_wake_duration"`
+	MinRestDuration   time.Duration `yaml:"min_rest_duration"`
+	MaxRestDuration   time.Duration `yaml:"max_rest_duration"`
+	RestThreshold     float64       `yaml:"rest_threshold"`
+	WakeThreshold     float64       `yaml:"wake_threshold"`
+	SalienceThreshold float64       `yaml:"salience_threshold"`
+}
+
+// CognitiveLoadConfig tunes fatigue accumulation and recovery
+type CognitiveLoadConfig struct {
+	FatigueModel string  `yaml:"fatigue_model"` // linear | exponential | two-process
+	MaxLoad      float64 `yaml:"max_load"`
+}
+
+// WorkingMemoryConfig tunes the working memory buffer
+type WorkingMemoryConfig struct {
+	Capacity int `yaml:"capacity"`
+}
+
+// DreamConfig tunes dream cycle triggering and shutdown draining
+type DreamConfig struct {
+	TriggerFatigue float64       `yaml:"trigger_fatigue"`
+	DrainTimeout   time.Duration `yaml:"drain_timeout"`
+}
+
+// TickConfig holds the intervals of the periodic loops
+type TickConfig struct {
+	ThoughtInterval     time.Duration `yaml:"thought_interval"`
+	ReflectionInterval  time.Duration `yaml:"reflection_interval"`
+	PersistenceInterval time.Duration `yaml:"persistence_interval"`
+}
+
+// PersistenceConfig selects where and whether state is persisted
+type PersistenceConfig struct {
+	Enable   bool   `yaml:"enable"`
+	StateDir string `yaml:"state_dir"`
+	EventLog string `yaml:"event_log"`
+}
+
+// DefaultV4Config returns the defaults the subsystems have always used
+func DefaultV4Config() *V4Config {
+	return &V4Config{
+		WakeRest: WakeRestConfig{
+			MinWakeDuration:   30 * time.Minute,
+			MaxWakeDuration:   4 * time.Hour,
+			MinRestDuration:   5 * time.Minute,
+			MaxRestDuration:   30 * time.Minute,
+			RestThreshold:     0.7,
+			WakeThreshold:     0.3,
+			SalienceThreshold: 0.7,
+		},
+		CognitiveLoad: CognitiveLoadConfig{
+			FatigueModel: "linear",
+			MaxLoad:      1.0,
+		},
+		WorkingMemory: WorkingMemoryConfig{
+			Capacity: 7,
+		},
+		Dream: DreamConfig{
+			TriggerFatigue: 0.7,
+			DrainTimeout:   2 * time.Minute,
+		},
+		Ticks: TickConfig{
+			ThoughtInterval:     30 * time.Second,
+			ReflectionInterval:  5 * time.Minute,
+			PersistenceInterval: 1 * time.Minute,
+		},
+		Persistence: PersistenceConfig{
+			Enable:   true,
+			StateDir: "./data",
+		},
+	}
+}
+
+// LoadV4Config reads a YAML config file, fills gaps with defaults,
+// applies environment overrides, and validates the result. A missing
+// file yields defaults (plus env overrides).
+func LoadV4Config(path string) (*V4Config, error) {
+	cfg := DefaultV4Config()
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return nil, fmt.Errorf("failed to read config: %w", err)
+			}
+		} else if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config: %w", err)
+		}
+	}
+
+	cfg.applyEnvOverrides()
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// applyEnvOverrides lets deployments tweak key parameters without a
+// config file, using ECCO9_-prefixed environment variables
+func (cfg *V4Config) applyEnvOverrides() {
+	if v := os.Getenv("ECCO9_FATIGUE_MODEL"); v != "" {
+		cfg.CognitiveLoad.FatigueModel = v
+	}
+	if v := os.Getenv("ECCO9_WORKING_MEMORY_CAPACITY"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.WorkingMemory.Capacity = n
+		}
+	}
+	if v := os.Getenv("ECCO9_REST_THRESHOLD"); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			cfg.WakeRest.RestThreshold = f
+		}
+	}
+	if v := os.Getenv("ECCO9_SALIENCE_THRESHOLD"); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			cfg.WakeRest.SalienceThreshold = f
+		}
+	}
+	if v := os.Getenv("ECCO9_THOUGHT_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Ticks.ThoughtInterval = d
+		}
+	}
+	if v := os.Getenv("ECCO9_STATE_DIR"); v != "" {
+		cfg.Persistence.StateDir = v
+	}
+	if v := os.Getenv("ECCO9_EVENT_LOG"); v != "" {
+		cfg.Persistence.EventLog = v
+	}
+}
+
+// Validate rejects configurations the subsystems cannot run with
+func (cfg *V4Config) Validate() error {
+	if cfg.WakeRest.MinWakeDuration <= 0 || cfg.WakeRest.MaxWakeDuration <= 0 {
+		return fmt.Errorf("wake durations must be positive")
+	}
+	if cfg.WakeRest.MinWakeDuration > cfg.WakeRest.MaxWakeDuration {
+		return fmt.Errorf("min_wake_duration exceeds max_wake_duration")
+	}
+	if cfg.WakeRest.MinRestDuration > cfg.WakeRest.MaxRestDuration {
+		return fmt.Errorf("min_rest_duration exceeds max_rest_duration")
+	}
+	if err := validateUnit("rest_threshold", cfg.WakeRest.RestThreshold); err != nil {
+		return err
+	}
+	if err := validateUnit("wake_threshold", cfg.WakeRest.WakeThreshold); err != nil {
+		return err
+	}
+	if err := validateUnit("salience_threshold", cfg.WakeRest.SalienceThreshold); err != nil {
+		return err
+	}
+	if err := validateUnit("trigger_fatigue", cfg.Dream.TriggerFatigue); err != nil {
+		return err
+	}
+	if cfg.WorkingMemory.Capacity <= 0 {
+		return fmt.Errorf("working memory capacity must be positive")
+	}
+	if cfg.Ticks.ThoughtInterval <= 0 {
+		return fmt.Errorf("thought_interval must be positive")
+	}
+
+	switch cfg.CognitiveLoad.FatigueModel {
+	case "linear", "exponential", "two-process", "borbely":
+	default:
+		return fmt.Errorf("unknown fatigue model %q", cfg.CognitiveLoad.FatigueModel)
+	}
+
+	return nil
+}
+
+// validateUnit checks a threshold lies in [0, 1]
+func validateUnit(name string, value float64) error {
+	if value < 0 || value > 1 {
+		return fmt.Errorf("%s must be between 0 and 1, got %g", name, value)
+	}
+	return nil
+}
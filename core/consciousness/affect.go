@@ -0,0 +1,206 @@
+package consciousness
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Affect subsystem: a valence/arousal emotional state updated by
+// events. The state modulates thought generation temperature,
+// interest salience, and dream-trigger thresholds, and is persisted
+// alongside identity.
+
+// AffectState holds the current emotional state on the circumplex
+// model: valence in [-1, 1] (unpleasant..pleasant) and arousal in
+// [0, 1] (calm..activated)
+type AffectState struct {
+	mu      sync.RWMutex
+	valence float64
+	arousal float64
+
+	// Baselines the state decays toward
+	baselineValence float64
+	baselineArousal float64
+	decayRate       float64 // fraction of distance recovered per minute
+
+	lastUpdate   time.Time
+	eventsSensed uint64
+}
+
+// affectResponses maps event types to (valence, arousal) deltas
+var affectResponses = map[string][2]float64{
+	"success":   {0.3, 0.2},
+	"failure":   {-0.3, 0.3},
+	"novelty":   {0.1, 0.3},
+	"threat":    {-0.4, 0.5},
+	"insight":   {0.4, 0.2},
+	"rest":      {0.1, -0.4},
+	"social":    {0.2, 0.1},
+	"confusion": {-0.2, 0.2},
+}
+
+// NewAffectState creates a neutral emotional state
+func NewAffectState() *AffectState {
+	return &AffectState{
+		baselineValence: 0.1, // mildly positive resting state
+		baselineArousal: 0.3,
+		valence:         0.1,
+		arousal:         0.3,
+		decayRate:       0.2,
+		lastUpdate:      time.Now(),
+	}
+}
+
+// ProcessEvent updates the emotional state for an event. Intensity
+// scales the response (1.0 = full effect).
+func (as *AffectState) ProcessEvent(eventType string, intensity float64) {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+
+	as.decayLocked()
+
+	response, ok := affectResponses[eventType]
+	if !ok {
+		// Unknown events are mildly arousing
+		response = [2]float64{0, 0.1}
+	}
+
+	as.valence = clampAffect(as.valence+response[0]*intensity, -1, 1)
+	as.arousal = clampAffect(as.arousal+response[1]*intensity, 0, 1)
+	as.eventsSensed++
+}
+
+// decayLocked relaxes the state toward baseline. Caller holds the
+// lock.
+func (as *AffectState) decayLocked() {
+	elapsed := time.Since(as.lastUpdate).Minutes()
+	as.lastUpdate = time.Now()
+	if elapsed <= 0 {
+		return
+	}
+
+	factor := as.decayRate * elapsed
+	if factor > 1 {
+		factor = 1
+	}
+	as.valence += (as.baselineValence - as.valence) * factor
+	as.arousal += (as.baselineArousal - as.arousal) * factor
+}
+
+// Current returns the valence and arousal after decay
+func (as *AffectState) Current() (valence, arousal float64) {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	as.decayLocked()
+	return as.valence, as.arousal
+}
+
+// ModulateTemperature adjusts an LLM sampling temperature by arousal:
+// an activated state thinks more divergently, a calm one more
+// conservatively
+func (as *AffectState) ModulateTemperature(base float64) float64 {
+	_, arousal := as.Current()
+	return clampAffect(base*(0.8+0.6*arousal), 0.1, 2.0)
+}
+
+// SalienceBoost scales interest salience by emotional state: positive
+// activated states amplify interests, negative calm states mute them
+func (as *AffectState) SalienceBoost() float64 {
+	valence, arousal := as.Current()
+	return clampAffect(1.0+0.3*valence+0.2*(arousal-0.5), 0.5, 1.5)
+}
+
+// DreamTriggerThreshold lowers the dream-trigger threshold under
+// sustained negative or highly aroused states, so consolidation
+// happens sooner when it is most needed
+func (as *AffectState) DreamTriggerThreshold(base float64) float64 {
+	valence, arousal := as.Current()
+	adjusted := base
+	if valence < 0 {
+		adjusted += valence * 0.2 // more negative -> lower threshold
+	}
+	if arousal > 0.7 {
+		adjusted -= (arousal - 0.7) * 0.3
+	}
+	return clampAffect(adjusted, 0.1, 1.0)
+}
+
+// EmotionalTone renders the state as the tone map used by the stream
+func (as *AffectState) EmotionalTone() map[string]float64 {
+	valence, arousal := as.Current()
+	return map[string]float64{
+		"valence": valence,
+		"arousal": arousal,
+	}
+}
+
+// ExportState returns the affect state for identity persistence
+func (as *AffectState) ExportState() map[string]interface{} {
+	as.mu.RLock()
+	defer as.mu.RUnlock()
+
+	return map[string]interface{}{
+		"valence":          as.valence,
+		"arousal":          as.arousal,
+		"baseline_valence": as.baselineValence,
+		"baseline_arousal": as.baselineArousal,
+		"events_sensed":    as.eventsSensed,
+	}
+}
+
+// ImportState restores affect from persisted identity state
+func (as *AffectState) ImportState(state map[string]interface{}) {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+
+	if v, ok := state["valence"].(float64); ok {
+		as.valence = clampAffect(v, -1, 1)
+	}
+	if v, ok := state["arousal"].(float64); ok {
+		as.arousal = clampAffect(v, 0, 1)
+	}
+	if v, ok := state["baseline_valence"].(float64); ok {
+		as.baselineValence = v
+	}
+	if v, ok := state["baseline_arousal"].(float64); ok {
+		as.baselineArousal = v
+	}
+	if v, ok := state["events_sensed"].(float64); ok {
+		as.eventsSensed = uint64(v)
+	}
+	as.lastUpdate = time.Now()
+}
+
+// GetMetrics returns the current emotional state
+func (as *AffectState) GetMetrics() map[string]interface{} {
+	valence, arousal := as.Current()
+	as.mu.RLock()
+	defer as.mu.RUnlock()
+
+	return map[string]interface{}{
+		"valence":       valence,
+		"arousal":       arousal,
+		"events_sensed": as.eventsSensed,
+	}
+}
+
+// clampAffect bounds a value to [min, max]
+func clampAffect(value, min, max float64) float64 {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+// SetAffect attaches an affect state to the stream; the stream's
+// emotional tone then tracks valence and arousal
+func (soc *StreamOfConsciousness) SetAffect(affect *AffectState) {
+	soc.mu.Lock()
+	defer soc.mu.Unlock()
+	soc.affect = affect
+	fmt.Println("💗 Stream-of-Consciousness: affect subsystem attached")
+}
@@ -0,0 +1,50 @@
+package consciousness
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock abstracts time so recorded sessions can be replayed with a
+// fake clock instead of wall time
+type Clock interface {
+	Now() time.Time
+}
+
+// RealClock is the production clock backed by time.Now
+type RealClock struct{}
+
+// Now returns the current wall-clock time
+func (RealClock) Now() time.Time { return time.Now() }
+
+// FakeClock is a manually advanced clock for deterministic replay
+type FakeClock struct {
+	mu  sync.RWMutex
+	now time.Time
+}
+
+// NewFakeClock creates a fake clock starting at the given time
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now returns the fake clock's current time
+func (fc *FakeClock) Now() time.Time {
+	fc.mu.RLock()
+	defer fc.mu.RUnlock()
+	return fc.now
+}
+
+// Advance moves the clock forward by d
+func (fc *FakeClock) Advance(d time.Duration) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.now = fc.now.Add(d)
+}
+
+// SetTime jumps the clock to an absolute time
+func (fc *FakeClock) SetTime(t time.Time) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.now = t
+}
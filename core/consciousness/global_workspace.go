@@ -0,0 +1,230 @@
+package consciousness
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Global workspace implementation for the consciousness simulator.
+// Each cycle, coalitions of content compete for the workspace; the
+// most salient coalition wins and is broadcast to every subscriber.
+// Sufficiently dominant winners "ignite" — the workspace analog of a
+// content becoming conscious — and ignition statistics are reported,
+// giving the simulation observable, testable semantics.
+
+// Coalition is a candidate content competing for the workspace
+type Coalition struct {
+	ID         string    `json:"id"`
+	Content    string    `json:"content"`
+	Source     string    `json:"source"` // contributing layer
+	Salience   float64   `json:"salience"`
+	Supporters []string  `json:"supporters,omitempty"`
+	Created    time.Time `json:"created"`
+}
+
+// Broadcast records one competition outcome
+type Broadcast struct {
+	Cycle       uint64    `json:"cycle"`
+	Winner      Coalition `json:"winner"`
+	Competitors int       `json:"competitors"`
+	Margin      float64   `json:"margin"` // winner salience minus runner-up
+	Ignited     bool      `json:"ignited"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// GlobalWorkspace runs the coalition competition
+type GlobalWorkspace struct {
+	mu         sync.RWMutex
+	coalitions map[string]*Coalition
+
+	// IgnitionThreshold is the salience a winner needs to ignite
+	ignitionThreshold float64
+
+	// decayRate weakens losing coalitions each cycle
+	decayRate float64
+
+	subscribers []func(Broadcast)
+
+	history    []*Broadcast
+	maxHistory int
+
+	// Metrics
+	cycles      uint64
+	ignitions   uint64
+	totalMargin float64
+}
+
+// NewGlobalWorkspace creates an empty workspace
+func NewGlobalWorkspace() *GlobalWorkspace {
+	return &GlobalWorkspace{
+		coalitions:        make(map[string]*Coalition),
+		ignitionThreshold: 0.6,
+		decayRate:         0.8,
+		history:           make([]*Broadcast, 0),
+		maxHistory:        100,
+	}
+}
+
+// SetIgnitionThreshold overrides the salience needed to ignite
+func (gw *GlobalWorkspace) SetIgnitionThreshold(threshold float64) {
+	gw.mu.Lock()
+	defer gw.mu.Unlock()
+	gw.ignitionThreshold = threshold
+}
+
+// SubmitCoalition enters content into the competition. Resubmitting
+// the same content from the same source reinforces its salience and
+// records the source as a supporter.
+func (gw *GlobalWorkspace) SubmitCoalition(content, source string, salience float64) {
+	gw.mu.Lock()
+	defer gw.mu.Unlock()
+
+	key := source + "::" + content
+	if existing, ok := gw.coalitions[key]; ok {
+		existing.Salience += salience * 0.5
+		if existing.Salience > 1.0 {
+			existing.Salience = 1.0
+		}
+		existing.Supporters = append(existing.Supporters, source)
+		return
+	}
+
+	gw.coalitions[key] = &Coalition{
+		ID:       key,
+		Content:  content,
+		Source:   source,
+		Salience: salience,
+		Created:  time.Now(),
+	}
+}
+
+// Subscribe registers a receiver for workspace broadcasts
+func (gw *GlobalWorkspace) Subscribe(receiver func(Broadcast)) {
+	gw.mu.Lock()
+	defer gw.mu.Unlock()
+	gw.subscribers = append(gw.subscribers, receiver)
+}
+
+// Compete runs one competition cycle: the most salient coalition wins
+// the workspace, is broadcast, and leaves the competition; the losers
+// decay. Returns nil when no coalitions are present.
+func (gw *GlobalWorkspace) Compete() *Broadcast {
+	gw.mu.Lock()
+
+	gw.cycles++
+
+	if len(gw.coalitions) == 0 {
+		gw.mu.Unlock()
+		return nil
+	}
+
+	var winner *Coalition
+	var runnerUp float64
+	for _, coalition := range gw.coalitions {
+		if winner == nil || coalition.Salience > winner.Salience ||
+			(coalition.Salience == winner.Salience && coalition.Created.Before(winner.Created)) {
+			if winner != nil && winner.Salience > runnerUp {
+				runnerUp = winner.Salience
+			}
+			winner = coalition
+		} else if coalition.Salience > runnerUp {
+			runnerUp = coalition.Salience
+		}
+	}
+
+	broadcast := &Broadcast{
+		Cycle:       gw.cycles,
+		Winner:      *winner,
+		Competitors: len(gw.coalitions),
+		Margin:      winner.Salience - runnerUp,
+		Ignited:     winner.Salience >= gw.ignitionThreshold,
+		Timestamp:   time.Now(),
+	}
+
+	if broadcast.Ignited {
+		gw.ignitions++
+	}
+	gw.totalMargin += broadcast.Margin
+
+	// Winner leaves the arena; losers decay and the weak drop out
+	delete(gw.coalitions, winner.ID)
+	for key, coalition := range gw.coalitions {
+		coalition.Salience *= gw.decayRate
+		if coalition.Salience < 0.1 {
+			delete(gw.coalitions, key)
+		}
+	}
+
+	gw.history = append(gw.history, broadcast)
+	if len(gw.history) > gw.maxHistory {
+		gw.history = gw.history[len(gw.history)-gw.maxHistory:]
+	}
+
+	subscribers := make([]func(Broadcast), len(gw.subscribers))
+	copy(subscribers, gw.subscribers)
+	gw.mu.Unlock()
+
+	// Broadcast outside the lock
+	for _, receiver := range subscribers {
+		receiver(*broadcast)
+	}
+
+	if broadcast.Ignited {
+		fmt.Printf("⚡ Workspace ignition (cycle %d): %s\n", broadcast.Cycle, broadcast.Winner.Content)
+	}
+
+	return broadcast
+}
+
+// GetBroadcastHistory returns recent competition outcomes
+func (gw *GlobalWorkspace) GetBroadcastHistory() []*Broadcast {
+	gw.mu.RLock()
+	defer gw.mu.RUnlock()
+
+	history := make([]*Broadcast, len(gw.history))
+	copy(history, gw.history)
+	return history
+}
+
+// GetMetrics returns ignition statistics
+func (gw *GlobalWorkspace) GetMetrics() map[string]interface{} {
+	gw.mu.RLock()
+	defer gw.mu.RUnlock()
+
+	ignitionRate := 0.0
+	averageMargin := 0.0
+	if gw.cycles > 0 {
+		ignitionRate = float64(gw.ignitions) / float64(gw.cycles)
+		averageMargin = gw.totalMargin / float64(gw.cycles)
+	}
+
+	return map[string]interface{}{
+		"cycles":             gw.cycles,
+		"ignitions":          gw.ignitions,
+		"ignition_rate":      ignitionRate,
+		"average_margin":     averageMargin,
+		"active_coalitions":  len(gw.coalitions),
+		"ignition_threshold": gw.ignitionThreshold,
+	}
+}
+
+// Workspace exposes the simulator's global workspace
+func (cs *ConsciousnessSimulator) Workspace() *GlobalWorkspace {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	return cs.workspace
+}
+
+// submitLayerCoalitions enters each layer's current content into the
+// workspace competition. Caller holds cs.mu.
+func (cs *ConsciousnessSimulator) submitLayerCoalitions() {
+	for id, layer := range cs.layers {
+		for _, concept := range layer.State.ActiveConcepts {
+			cs.workspace.SubmitCoalition(concept, id, layer.ActivationLevel*0.8)
+		}
+		for _, focus := range layer.State.AttentionFocus {
+			cs.workspace.SubmitCoalition(focus, id, layer.ActivationLevel)
+		}
+	}
+}
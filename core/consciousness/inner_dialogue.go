@@ -0,0 +1,214 @@
+package consciousness
+
+import (
+	"fmt"
+	"time"
+)
+
+// Inner dialogue: a two-persona debate run inside the consciousness
+// stream. A proposer and a critic argue a question across several LLM
+// turns; the synthesis is emitted as a high-importance thought and
+// the full transcript is archived.
+
+// DebatePersona is one voice in an internal debate
+type DebatePersona struct {
+	Name   string `json:"name"`
+	Role   string `json:"role"`   // "proposer" or "critic"
+	Stance string `json:"stance"` // instruction shaping the persona's arguments
+}
+
+// DebateConfig controls an inner debate
+type DebateConfig struct {
+	Proposer DebatePersona `json:"proposer"`
+	Critic   DebatePersona `json:"critic"`
+
+	// Rounds of proposer+critic exchange (default 3)
+	Rounds int `json:"rounds"`
+}
+
+// DefaultDebateConfig returns the classic proposer/critic pairing
+func DefaultDebateConfig() DebateConfig {
+	return DebateConfig{
+		Proposer: DebatePersona{
+			Name:   "Proposer",
+			Role:   "proposer",
+			Stance: "Argue constructively for the strongest possible answer. Build on prior points.",
+		},
+		Critic: DebatePersona{
+			Name:   "Critic",
+			Role:   "critic",
+			Stance: "Challenge assumptions and find weaknesses in the proposer's argument. Be rigorous, not dismissive.",
+		},
+		Rounds: 3,
+	}
+}
+
+// DebateTranscript archives one completed inner debate
+type DebateTranscript struct {
+	ID        string          `json:"id"`
+	Question  string          `json:"question"`
+	Entries   []DialogueEntry `json:"entries"`
+	Synthesis string          `json:"synthesis"`
+	Started   time.Time       `json:"started"`
+	Completed time.Time       `json:"completed"`
+}
+
+// RunInnerDebate argues a question between two personas and emits the
+// synthesis as a high-importance insight thought. Works without an
+// LLM provider, falling back to templated positions so the dialogue
+// mechanism stays exercisable offline.
+func (soc *StreamOfConsciousness) RunInnerDebate(question string, config DebateConfig) (*DebateTranscript, error) {
+	if question == "" {
+		return nil, fmt.Errorf("debate question required")
+	}
+	if config.Rounds < 1 {
+		config.Rounds = 3
+	}
+
+	transcript := &DebateTranscript{
+		ID:       fmt.Sprintf("debate_%d", time.Now().UnixNano()),
+		Question: question,
+		Entries:  make([]DialogueEntry, 0, config.Rounds*2),
+		Started:  time.Now(),
+	}
+
+	fmt.Printf("🎭 Inner dialogue: %q (%d rounds)\n", question, config.Rounds)
+
+	for round := 0; round < config.Rounds; round++ {
+		for _, persona := range []DebatePersona{config.Proposer, config.Critic} {
+			content := soc.debateTurn(question, persona, transcript.Entries)
+			entry := DialogueEntry{
+				Timestamp: time.Now(),
+				Speaker:   persona.Name,
+				Content:   content,
+				Type:      persona.Role,
+				Context:   map[string]interface{}{"debate_id": transcript.ID, "round": round + 1},
+			}
+			transcript.Entries = append(transcript.Entries, entry)
+
+			soc.mu.Lock()
+			soc.internalDialogue = append(soc.internalDialogue, entry)
+			if len(soc.internalDialogue) > soc.maxDialogueSize {
+				soc.internalDialogue = soc.internalDialogue[len(soc.internalDialogue)-soc.maxDialogueSize:]
+			}
+			soc.mu.Unlock()
+
+			fmt.Printf("🎭 %s: %s\n", persona.Name, content)
+		}
+	}
+
+	transcript.Synthesis = soc.debateSynthesis(question, transcript.Entries)
+	transcript.Completed = time.Now()
+
+	// The synthesis joins the stream as a high-importance insight
+	thought := &Thought{
+		ID:         generateThoughtID(),
+		Timestamp:  time.Now(),
+		Type:       ThoughtTypeInsight,
+		Content:    transcript.Synthesis,
+		Source:     "inner_dialogue",
+		Confidence: 0.9,
+		Context: map[string]interface{}{
+			"debate_id": transcript.ID,
+			"question":  question,
+		},
+		Provenance: NewThoughtProvenance("inner_dialogue", question),
+	}
+
+	soc.mu.Lock()
+	soc.thoughtHistory = append(soc.thoughtHistory, thought)
+	soc.insightsGenerated++
+	soc.debates = append(soc.debates, transcript)
+	if len(soc.debates) > 50 {
+		soc.debates = soc.debates[len(soc.debates)-50:]
+	}
+	soc.debatesHeld++
+	soc.mu.Unlock()
+
+	soc.logThought(thought)
+
+	fmt.Printf("💡 Synthesis: %s\n", transcript.Synthesis)
+
+	return transcript, nil
+}
+
+// debateTurn produces one persona's contribution
+func (soc *StreamOfConsciousness) debateTurn(question string, persona DebatePersona, entries []DialogueEntry) string {
+	soc.mu.RLock()
+	provider := soc.llmProvider
+	soc.mu.RUnlock()
+
+	if provider == nil {
+		return debateFallbackLine(persona, len(entries))
+	}
+
+	prompt := fmt.Sprintf("You are %s in an internal debate. %s\nQuestion: %s\n", persona.Name, persona.Stance, question)
+	if len(entries) > 0 {
+		prompt += "Debate so far:\n"
+		for _, entry := range entries {
+			prompt += fmt.Sprintf("%s: %s\n", entry.Speaker, entry.Content)
+		}
+	}
+	prompt += "Give your next argument in one or two sentences."
+
+	content, err := provider.GenerateThought(prompt, map[string]interface{}{
+		"persona": persona.Role,
+	})
+	if err != nil || content == "" {
+		return debateFallbackLine(persona, len(entries))
+	}
+	return content
+}
+
+// debateSynthesis reconciles the two positions
+func (soc *StreamOfConsciousness) debateSynthesis(question string, entries []DialogueEntry) string {
+	soc.mu.RLock()
+	provider := soc.llmProvider
+	soc.mu.RUnlock()
+
+	if provider == nil {
+		return fmt.Sprintf("After internal debate on %q, both the constructive and critical views hold part of the truth; the answer lies in their tension.", question)
+	}
+
+	prompt := fmt.Sprintf("Synthesize this internal debate into one balanced conclusion.\nQuestion: %s\n", question)
+	for _, entry := range entries {
+		prompt += fmt.Sprintf("%s: %s\n", entry.Speaker, entry.Content)
+	}
+	prompt += "Conclusion (one or two sentences):"
+
+	synthesis, err := provider.GenerateThought(prompt, map[string]interface{}{"role": "synthesis"})
+	if err != nil || synthesis == "" {
+		return fmt.Sprintf("After internal debate on %q, both the constructive and critical views hold part of the truth; the answer lies in their tension.", question)
+	}
+	return synthesis
+}
+
+// debateFallbackLine keeps the debate moving without an LLM
+func debateFallbackLine(persona DebatePersona, turn int) string {
+	proposerLines := []string{
+		"I believe the direct approach is right: act on what the evidence shows.",
+		"Building on that, the pattern holds across the cases I can recall.",
+		"Even granting the objections, the core of the argument stands.",
+	}
+	criticLines := []string{
+		"But what evidence would falsify that? The claim feels underexamined.",
+		"The recalled cases may be biased toward what was memorable, not what was true.",
+		"The core may stand, yet the edges are where it will fail in practice.",
+	}
+
+	lines := proposerLines
+	if persona.Role == "critic" {
+		lines = criticLines
+	}
+	return lines[(turn/2)%len(lines)]
+}
+
+// GetDebateTranscripts returns archived inner debates
+func (soc *StreamOfConsciousness) GetDebateTranscripts() []*DebateTranscript {
+	soc.mu.RLock()
+	defer soc.mu.RUnlock()
+
+	transcripts := make([]*DebateTranscript, len(soc.debates))
+	copy(transcripts, soc.debates)
+	return transcripts
+}
@@ -0,0 +1,319 @@
+package consciousness
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/EchoCog/echollama/core/wisdom"
+)
+
+// Meta-reflection scheduler: periodic passes review the last N
+// thoughts, score their coherence, novelty, and usefulness (via the
+// LLM when available, heuristics otherwise), adjust generation
+// parameters accordingly, and record the scores in wisdom metrics —
+// closing the self-monitoring loop.
+
+// ReflectionScore rates one window of thoughts
+type ReflectionScore struct {
+	Coherence  float64   `json:"coherence"`
+	Novelty    float64   `json:"novelty"`
+	Usefulness float64   `json:"usefulness"`
+	Overall    float64   `json:"overall"`
+	Window     int       `json:"window"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// MetaReflector schedules quality-scoring passes over the stream
+type MetaReflector struct {
+	mu     sync.RWMutex
+	ctx    context.Context
+	cancel context.CancelFunc
+	soc    *StreamOfConsciousness
+
+	interval   time.Duration
+	windowSize int
+
+	// Optional sink for recorded scores
+	wisdomMetrics *wisdom.EnhancedWisdomMetrics
+
+	// History of scores
+	scores    []ReflectionScore
+	maxScores int
+
+	passes  uint64
+	running bool
+}
+
+// NewMetaReflector creates a reflector over a consciousness stream
+func NewMetaReflector(soc *StreamOfConsciousness) *MetaReflector {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &MetaReflector{
+		ctx:        ctx,
+		cancel:     cancel,
+		soc:        soc,
+		interval:   5 * time.Minute,
+		windowSize: 20,
+		scores:     make([]ReflectionScore, 0),
+		maxScores:  100,
+	}
+}
+
+// SetWisdomMetrics attaches the wisdom metrics sink for scores
+func (mr *MetaReflector) SetWisdomMetrics(metrics *wisdom.EnhancedWisdomMetrics) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	mr.wisdomMetrics = metrics
+}
+
+// SetInterval overrides the reflection cadence
+func (mr *MetaReflector) SetInterval(interval time.Duration) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	if interval > 0 {
+		mr.interval = interval
+	}
+}
+
+// Start begins periodic meta-reflection
+func (mr *MetaReflector) Start() error {
+	mr.mu.Lock()
+	if mr.running {
+		mr.mu.Unlock()
+		return fmt.Errorf("meta-reflector already running")
+	}
+	mr.running = true
+	interval := mr.interval
+	mr.mu.Unlock()
+
+	fmt.Println("🧠 Meta-reflector: scheduled quality scoring started")
+
+	go mr.loop(interval)
+	return nil
+}
+
+// Stop halts meta-reflection
+func (mr *MetaReflector) Stop() error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+
+	if !mr.running {
+		return fmt.Errorf("meta-reflector not running")
+	}
+	mr.running = false
+	mr.cancel()
+	return nil
+}
+
+// loop runs reflection passes on the configured cadence
+func (mr *MetaReflector) loop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-mr.ctx.Done():
+			return
+		case <-ticker.C:
+			mr.Reflect()
+		}
+	}
+}
+
+// Reflect runs one meta-reflection pass: score the recent window,
+// adjust generation, and record the result
+func (mr *MetaReflector) Reflect() *ReflectionScore {
+	mr.mu.RLock()
+	windowSize := mr.windowSize
+	mr.mu.RUnlock()
+
+	thoughts := mr.soc.GetRecentThoughts(windowSize)
+	if len(thoughts) < 3 {
+		return nil
+	}
+
+	score := mr.scoreThoughts(thoughts)
+
+	mr.mu.Lock()
+	mr.passes++
+	mr.scores = append(mr.scores, score)
+	if len(mr.scores) > mr.maxScores {
+		mr.scores = mr.scores[len(mr.scores)-mr.maxScores:]
+	}
+	sink := mr.wisdomMetrics
+	mr.mu.Unlock()
+
+	mr.adjustGeneration(score)
+
+	if sink != nil {
+		sink.RecordEvent("meta_reflection",
+			fmt.Sprintf("thought quality: coherence %.2f, novelty %.2f, usefulness %.2f",
+				score.Coherence, score.Novelty, score.Usefulness),
+			score.Overall)
+	}
+
+	// The reflection itself joins the stream
+	mr.soc.AddExternalStimulus(
+		fmt.Sprintf("Meta-reflection: my last %d thoughts scored %.2f coherence, %.2f novelty, %.2f usefulness",
+			score.Window, score.Coherence, score.Novelty, score.Usefulness),
+		"meta_reflection")
+
+	fmt.Printf("🧠 Meta-reflection: overall quality %.2f over %d thoughts\n", score.Overall, score.Window)
+
+	return &score
+}
+
+// scoreThoughts rates a window via the LLM, with heuristic fallback
+func (mr *MetaReflector) scoreThoughts(thoughts []*Thought) ReflectionScore {
+	score := ReflectionScore{
+		Window:    len(thoughts),
+		Timestamp: time.Now(),
+	}
+
+	mr.soc.mu.RLock()
+	provider := mr.soc.llmProvider
+	mr.soc.mu.RUnlock()
+
+	scored := false
+	if provider != nil {
+		contents := make([]string, 0, len(thoughts))
+		for _, thought := range thoughts {
+			contents = append(contents, thought.Content)
+		}
+		prompt := fmt.Sprintf(
+			"Rate this sequence of internal thoughts on three axes, each 0.0-1.0.\nThoughts:\n%s\nReply with exactly three numbers separated by spaces: coherence novelty usefulness",
+			joinStrings(contents, "\n"))
+
+		response, err := provider.GenerateThought(prompt, map[string]interface{}{"role": "quality_scoring"})
+		if err == nil {
+			var c, n, u float64
+			if _, err := fmt.Sscanf(strings.TrimSpace(response), "%f %f %f", &c, &n, &u); err == nil {
+				score.Coherence = clampAffect(c, 0, 1)
+				score.Novelty = clampAffect(n, 0, 1)
+				score.Usefulness = clampAffect(u, 0, 1)
+				scored = true
+			}
+		}
+	}
+
+	if !scored {
+		score.Coherence = heuristicCoherence(thoughts)
+		score.Novelty = heuristicNovelty(thoughts)
+		score.Usefulness = heuristicUsefulness(thoughts)
+	}
+
+	score.Overall = (score.Coherence + score.Novelty + score.Usefulness) / 3
+	return score
+}
+
+// adjustGeneration tunes generation parameters from the score: low
+// coherence slows the stream down, consistently high quality speeds
+// it up, and low novelty registers as confusion on the affect state
+func (mr *MetaReflector) adjustGeneration(score ReflectionScore) {
+	mr.soc.mu.Lock()
+	rate := mr.soc.generationRate
+	switch {
+	case score.Coherence < 0.4 && rate < 30*time.Second:
+		mr.soc.generationRate = rate * 2
+		fmt.Printf("🧠 Meta-reflection: low coherence, slowing generation to %s\n", mr.soc.generationRate)
+	case score.Overall > 0.7 && rate > time.Second:
+		mr.soc.generationRate = rate / 2
+		fmt.Printf("🧠 Meta-reflection: high quality, speeding generation to %s\n", mr.soc.generationRate)
+	}
+	affect := mr.soc.affect
+	mr.soc.mu.Unlock()
+
+	if affect != nil {
+		if score.Novelty < 0.3 {
+			affect.ProcessEvent("confusion", 0.5)
+		} else if score.Overall > 0.7 {
+			affect.ProcessEvent("insight", 0.5)
+		}
+	}
+}
+
+// heuristicCoherence measures word overlap between consecutive
+// thoughts
+func heuristicCoherence(thoughts []*Thought) float64 {
+	if len(thoughts) < 2 {
+		return 0.5
+	}
+	linked := 0
+	for i := 1; i < len(thoughts); i++ {
+		if sharedWordCountThoughts(thoughts[i-1].Content, thoughts[i].Content) > 0 {
+			linked++
+		}
+	}
+	return float64(linked) / float64(len(thoughts)-1)
+}
+
+// heuristicNovelty measures the fraction of distinct contents
+func heuristicNovelty(thoughts []*Thought) float64 {
+	seen := make(map[string]bool)
+	for _, thought := range thoughts {
+		seen[thought.Content] = true
+	}
+	return float64(len(seen)) / float64(len(thoughts))
+}
+
+// heuristicUsefulness measures the share of actionable thought types
+func heuristicUsefulness(thoughts []*Thought) float64 {
+	useful := 0
+	for _, thought := range thoughts {
+		switch thought.Type {
+		case ThoughtTypeInsight, ThoughtTypePlanning, ThoughtTypeMetaCognition, ThoughtTypeConnection:
+			useful++
+		}
+	}
+	return float64(useful) / float64(len(thoughts))
+}
+
+// sharedWordCountThoughts counts meaningful shared words
+func sharedWordCountThoughts(a, b string) int {
+	wordsA := make(map[string]bool)
+	for _, word := range strings.Fields(strings.ToLower(a)) {
+		if len(word) > 4 {
+			wordsA[word] = true
+		}
+	}
+	shared := 0
+	for _, word := range strings.Fields(strings.ToLower(b)) {
+		if len(word) > 4 && wordsA[word] {
+			shared++
+			wordsA[word] = false
+		}
+	}
+	return shared
+}
+
+// GetScores returns the recorded reflection scores
+func (mr *MetaReflector) GetScores() []ReflectionScore {
+	mr.mu.RLock()
+	defer mr.mu.RUnlock()
+
+	scores := make([]ReflectionScore, len(mr.scores))
+	copy(scores, mr.scores)
+	return scores
+}
+
+// GetMetrics returns reflector statistics
+func (mr *MetaReflector) GetMetrics() map[string]interface{} {
+	mr.mu.RLock()
+	defer mr.mu.RUnlock()
+
+	metrics := map[string]interface{}{
+		"passes":  mr.passes,
+		"running": mr.running,
+	}
+	if len(mr.scores) > 0 {
+		last := mr.scores[len(mr.scores)-1]
+		metrics["last_coherence"] = last.Coherence
+		metrics["last_novelty"] = last.Novelty
+		metrics["last_usefulness"] = last.Usefulness
+		metrics["last_overall"] = last.Overall
+	}
+	return metrics
+}
@@ -0,0 +1,100 @@
+package consciousness
+
+import (
+	"time"
+
+	"github.com/EchoCog/echollama/core/memory"
+)
+
+// ThoughtProvenance records why and how a thought came to exist, so any
+// thought in the stream or the hypergraph can be traced back to its
+// origin: which engine produced it, what prompted it, which LLM (if any)
+// generated the content, and what it cost.
+type ThoughtProvenance struct {
+	// Engine is the originating inference engine or generator
+	// (e.g. "stream_of_consciousness", "insight_generator")
+	Engine string `json:"engine"`
+
+	// PromptContext is the prompt or summarized context that elicited
+	// the thought
+	PromptContext string `json:"prompt_context,omitempty"`
+
+	// Provider and Model identify the LLM that generated the content,
+	// empty for template/fallback generation
+	Provider string `json:"provider,omitempty"`
+	Model    string `json:"model,omitempty"`
+
+	// CostUSD and TokensUsed account for the generation cost
+	CostUSD    float64 `json:"cost_usd,omitempty"`
+	TokensUsed int     `json:"tokens_used,omitempty"`
+
+	// Stimulus describes the external trigger, if any
+	Stimulus string `json:"stimulus,omitempty"`
+
+	// GeneratedAt is when generation completed
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// NewThoughtProvenance creates provenance for an internally-generated thought
+func NewThoughtProvenance(engine, promptContext string) *ThoughtProvenance {
+	return &ThoughtProvenance{
+		Engine:        engine,
+		PromptContext: promptContext,
+		GeneratedAt:   time.Now(),
+	}
+}
+
+// WithLLM records the provider/model that generated the content
+func (p *ThoughtProvenance) WithLLM(provider, model string, costUSD float64, tokens int) *ThoughtProvenance {
+	p.Provider = provider
+	p.Model = model
+	p.CostUSD = costUSD
+	p.TokensUsed = tokens
+	return p
+}
+
+// WithStimulus records the external trigger for the thought
+func (p *ThoughtProvenance) WithStimulus(stimulus string) *ThoughtProvenance {
+	p.Stimulus = stimulus
+	return p
+}
+
+// ThoughtToMemoryNode converts a thought into a hypergraph node,
+// flattening provenance into the node metadata so traceability survives
+// consolidation into long-term memory
+func ThoughtToMemoryNode(thought *Thought) *memory.MemoryNode {
+	metadata := map[string]interface{}{
+		"thought_id":   thought.ID,
+		"thought_type": string(thought.Type),
+		"source":       thought.Source,
+	}
+
+	if p := thought.Provenance; p != nil {
+		metadata["provenance_engine"] = p.Engine
+		if p.PromptContext != "" {
+			metadata["provenance_prompt_context"] = p.PromptContext
+		}
+		if p.Provider != "" {
+			metadata["provenance_provider"] = p.Provider
+			metadata["provenance_model"] = p.Model
+		}
+		if p.CostUSD > 0 {
+			metadata["provenance_cost_usd"] = p.CostUSD
+		}
+		if p.TokensUsed > 0 {
+			metadata["provenance_tokens_used"] = p.TokensUsed
+		}
+		if p.Stimulus != "" {
+			metadata["provenance_stimulus"] = p.Stimulus
+		}
+		metadata["provenance_generated_at"] = p.GeneratedAt
+	}
+
+	return &memory.MemoryNode{
+		Type:       memory.NodeThought,
+		Content:    thought.Content,
+		Metadata:   metadata,
+		CreatedAt:  thought.Timestamp,
+		Importance: thought.Confidence,
+	}
+}
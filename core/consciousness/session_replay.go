@@ -0,0 +1,275 @@
+package consciousness
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// SessionEventType classifies recorded session events
+type SessionEventType string
+
+const (
+	SessionEventStimulus    SessionEventType = "stimulus"
+	SessionEventScheduler   SessionEventType = "scheduler"
+	SessionEventLLMResponse SessionEventType = "llm_response"
+)
+
+// SessionEvent is one entry in a recorded consciousness session
+type SessionEvent struct {
+	Sequence  uint64           `json:"sequence"`
+	Type      SessionEventType `json:"type"`
+	Timestamp time.Time        `json:"timestamp"`
+
+	// Stimulus events
+	Stimulus     string `json:"stimulus,omitempty"`
+	StimulusType string `json:"stimulus_type,omitempty"`
+
+	// Scheduler events (tick names, phase transitions, etc.)
+	SchedulerEvent string `json:"scheduler_event,omitempty"`
+
+	// LLM call/response pairs
+	LLMMethod   string `json:"llm_method,omitempty"`
+	LLMPrompt   string `json:"llm_prompt,omitempty"`
+	LLMResponse string `json:"llm_response,omitempty"`
+	LLMError    string `json:"llm_error,omitempty"`
+}
+
+// SessionRecorder appends session events to a JSONL log so a run can
+// later be replayed deterministically
+type SessionRecorder struct {
+	mu       sync.Mutex
+	file     *os.File
+	writer   *bufio.Writer
+	sequence uint64
+	clock    Clock
+}
+
+// NewSessionRecorder opens (or creates) a session log for appending
+func NewSessionRecorder(path string, clock Clock) (*SessionRecorder, error) {
+	if clock == nil {
+		clock = RealClock{}
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create session log directory: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session log: %w", err)
+	}
+
+	return &SessionRecorder{
+		file:   file,
+		writer: bufio.NewWriter(file),
+		clock:  clock,
+	}, nil
+}
+
+// RecordStimulus logs an external stimulus entering the stream
+func (sr *SessionRecorder) RecordStimulus(stimulus, stimulusType string) {
+	sr.append(SessionEvent{
+		Type:         SessionEventStimulus,
+		Stimulus:     stimulus,
+		StimulusType: stimulusType,
+	})
+}
+
+// RecordSchedulerEvent logs a scheduler tick or phase transition
+func (sr *SessionRecorder) RecordSchedulerEvent(event string) {
+	sr.append(SessionEvent{
+		Type:           SessionEventScheduler,
+		SchedulerEvent: event,
+	})
+}
+
+// RecordLLMResponse logs one LLM call and its outcome
+func (sr *SessionRecorder) RecordLLMResponse(method, prompt, response string, err error) {
+	event := SessionEvent{
+		Type:        SessionEventLLMResponse,
+		LLMMethod:   method,
+		LLMPrompt:   prompt,
+		LLMResponse: response,
+	}
+	if err != nil {
+		event.LLMError = err.Error()
+	}
+	sr.append(event)
+}
+
+func (sr *SessionRecorder) append(event SessionEvent) {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	sr.sequence++
+	event.Sequence = sr.sequence
+	event.Timestamp = sr.clock.Now()
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		fmt.Printf("⚠️  Session recorder: %v\n", err)
+		return
+	}
+
+	sr.writer.Write(data)
+	sr.writer.WriteByte('\n')
+	sr.writer.Flush()
+}
+
+// Close flushes and closes the session log
+func (sr *SessionRecorder) Close() error {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	sr.writer.Flush()
+	return sr.file.Close()
+}
+
+// EnableRecording attaches a session recorder to the stream and wraps
+// its LLM provider so stimuli and LLM responses are both captured
+func (soc *StreamOfConsciousness) EnableRecording(recorder *SessionRecorder) {
+	soc.mu.Lock()
+	defer soc.mu.Unlock()
+
+	soc.recorder = recorder
+	if soc.llmProvider != nil {
+		soc.llmProvider = NewRecordingLLMProvider(soc.llmProvider, recorder)
+	}
+	fmt.Println("🔴 Consciousness session recording enabled")
+}
+
+// RecordingLLMProvider wraps a provider and records every response
+type RecordingLLMProvider struct {
+	inner    LLMProvider
+	recorder *SessionRecorder
+}
+
+// NewRecordingLLMProvider wraps an LLM provider so all its traffic is
+// captured in the session log
+func NewRecordingLLMProvider(inner LLMProvider, recorder *SessionRecorder) *RecordingLLMProvider {
+	return &RecordingLLMProvider{inner: inner, recorder: recorder}
+}
+
+func (rp *RecordingLLMProvider) GenerateThought(prompt string, context map[string]interface{}) (string, error) {
+	response, err := rp.inner.GenerateThought(prompt, context)
+	rp.recorder.RecordLLMResponse("GenerateThought", prompt, response, err)
+	return response, err
+}
+
+func (rp *RecordingLLMProvider) GenerateInsight(thoughts []string) (string, error) {
+	response, err := rp.inner.GenerateInsight(thoughts)
+	rp.recorder.RecordLLMResponse("GenerateInsight", fmt.Sprintf("%v", thoughts), response, err)
+	return response, err
+}
+
+func (rp *RecordingLLMProvider) GenerateQuestion(context string) (string, error) {
+	response, err := rp.inner.GenerateQuestion(context)
+	rp.recorder.RecordLLMResponse("GenerateQuestion", context, response, err)
+	return response, err
+}
+
+// LoadSessionLog reads all events from a recorded session
+func LoadSessionLog(path string) ([]SessionEvent, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session log: %w", err)
+	}
+	defer file.Close()
+
+	events := make([]SessionEvent, 0)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var event SessionEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return nil, fmt.Errorf("malformed session event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	return events, scanner.Err()
+}
+
+// ReplayLLMProvider serves recorded responses back in order, making a
+// replayed session deterministic regardless of the real provider
+type ReplayLLMProvider struct {
+	mu     sync.Mutex
+	events []SessionEvent
+	cursor int
+}
+
+// NewReplayLLMProvider builds a provider from a recorded session's
+// LLM response events
+func NewReplayLLMProvider(events []SessionEvent) *ReplayLLMProvider {
+	responses := make([]SessionEvent, 0)
+	for _, event := range events {
+		if event.Type == SessionEventLLMResponse {
+			responses = append(responses, event)
+		}
+	}
+	return &ReplayLLMProvider{events: responses}
+}
+
+// next returns the next recorded response for the given method
+func (rp *ReplayLLMProvider) next(method string) (string, error) {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	for ; rp.cursor < len(rp.events); rp.cursor++ {
+		event := rp.events[rp.cursor]
+		if event.LLMMethod != method {
+			continue
+		}
+		rp.cursor++
+		if event.LLMError != "" {
+			return "", fmt.Errorf("%s", event.LLMError)
+		}
+		return event.LLMResponse, nil
+	}
+
+	return "", fmt.Errorf("session log exhausted for %s", method)
+}
+
+func (rp *ReplayLLMProvider) GenerateThought(prompt string, context map[string]interface{}) (string, error) {
+	return rp.next("GenerateThought")
+}
+
+func (rp *ReplayLLMProvider) GenerateInsight(thoughts []string) (string, error) {
+	return rp.next("GenerateInsight")
+}
+
+func (rp *ReplayLLMProvider) GenerateQuestion(context string) (string, error) {
+	return rp.next("GenerateQuestion")
+}
+
+// ReplaySession re-runs a recorded session against a stream of
+// consciousness: the fake clock is advanced to each event's recorded
+// timestamp and stimuli are re-injected in their original order.
+// Pair this with a ReplayLLMProvider built from the same log for a
+// fully deterministic re-run.
+func ReplaySession(stream *StreamOfConsciousness, events []SessionEvent, clock *FakeClock) {
+	fmt.Printf("🔁 Replaying consciousness session: %d event(s)\n", len(events))
+
+	for _, event := range events {
+		if clock != nil {
+			clock.SetTime(event.Timestamp)
+		}
+
+		switch event.Type {
+		case SessionEventStimulus:
+			stream.AddExternalStimulus(event.Stimulus, event.StimulusType)
+		case SessionEventScheduler:
+			// Scheduler events mark where periodic work fired in the
+			// original run; they pace the replay but need no action
+		}
+	}
+
+	fmt.Println("🔁 Session replay complete")
+}
@@ -13,6 +13,7 @@ type ConsciousnessSimulator struct {
 	awarenessMonitors map[string]AwarenessMonitor
 	introspectionLoop IntrospectionLoop
 	globalAwareness   GlobalAwareness
+	workspace         *GlobalWorkspace
 	coherenceLevel    float64
 	lastUpdate        time.Time
 }
@@ -136,6 +137,7 @@ func NewConsciousnessSimulator() *ConsciousnessSimulator {
 	cs := &ConsciousnessSimulator{
 		layers:            make(map[string]ConsciousnessLayer),
 		awarenessMonitors: make(map[string]AwarenessMonitor),
+		workspace:         NewGlobalWorkspace(),
 		coherenceLevel:    0.5,
 		lastUpdate:        time.Now(),
 	}
@@ -184,26 +186,51 @@ func (cs *ConsciousnessSimulator) initializeBasicLayers() {
 	cs.layers["metacognitive_awareness"] = metaLayer
 }
 
-// SimulateConsciousness runs consciousness simulation cycle
+// SimulateConsciousness runs one consciousness cycle: layers update,
+// their content competes in the global workspace, and the winning
+// coalition is broadcast as this cycle's conscious content
 func (cs *ConsciousnessSimulator) SimulateConsciousness() error {
 	cs.mu.Lock()
-	defer cs.mu.Unlock()
-	
+
 	// Update each consciousness layer
 	for id, layer := range cs.layers {
 		updatedLayer := cs.updateLayer(layer)
 		cs.layers[id] = updatedLayer
 	}
-	
+
+	// Enter layer content into the workspace competition
+	cs.submitLayerCoalitions()
+	cs.mu.Unlock()
+
+	// Run the competition outside the lock so broadcast receivers
+	// can read simulator state
+	broadcast := cs.workspace.Compete()
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
 	// Generate global awareness
 	cs.globalAwareness = cs.synthesizeGlobalAwareness()
-	
+
+	// The broadcast winner leads the unified focus
+	if broadcast != nil {
+		cs.globalAwareness.UnifiedFocus = append(
+			[]string{broadcast.Winner.Content}, cs.globalAwareness.UnifiedFocus...)
+		if cs.globalAwareness.CoherenceMetrics == nil {
+			cs.globalAwareness.CoherenceMetrics = make(map[string]float64)
+		}
+		cs.globalAwareness.CoherenceMetrics["workspace_margin"] = broadcast.Margin
+		if broadcast.Ignited {
+			cs.globalAwareness.CoherenceMetrics["ignition"] = 1.0
+		}
+	}
+
 	// Run introspection if available
 	if cs.introspectionLoop != nil {
 		introspectionResult := cs.introspectionLoop.Introspect(cs)
 		cs.applyIntrospectionResults(introspectionResult)
 	}
-	
+
 	cs.lastUpdate = time.Now()
 	return nil
 }
@@ -7,6 +7,8 @@ import (
 	"os"
 	"sync"
 	"time"
+
+	"github.com/EchoCog/echollama/core/events"
 )
 
 // StreamOfConsciousness maintains persistent internal awareness and narrative
@@ -31,7 +33,26 @@ type StreamOfConsciousness struct {
 	
 	// LLM integration
 	llmProvider       LLMProvider
-	
+
+	// Pluggable thought generators (optional)
+	generatorPool     *generatorPool
+
+	// Affect subsystem (optional)
+	affect            *AffectState
+
+	// Append-only JSONL log (optional)
+	thoughtLog        *ThoughtLog
+
+	// Inner dialogue archive
+	debates           []*DebateTranscript
+	debatesHeld       uint64
+
+	// Session recording (optional)
+	recorder          *SessionRecorder
+
+	// Event sourcing (optional)
+	eventStore        *events.EventStore
+
 	// Persistence
 	persistencePath   string
 	lastPersisted     time.Time
@@ -58,6 +79,7 @@ type Thought struct {
 	Context       map[string]interface{} `json:"context"`
 	RelatedTo     []string               `json:"related_to"` // IDs of related thoughts
 	Insights      []string               `json:"insights"`
+	Provenance    *ThoughtProvenance     `json:"provenance,omitempty"`
 }
 
 // ThoughtType categorizes different types of thoughts
@@ -154,7 +176,11 @@ func (soc *StreamOfConsciousness) Stop() error {
 	
 	// Final persistence
 	soc.persistState()
-	
+
+	if soc.thoughtLog != nil {
+		soc.thoughtLog.Close()
+	}
+
 	return nil
 }
 
@@ -179,7 +205,13 @@ func (soc *StreamOfConsciousness) generateThought() {
 	recentThoughts := soc.getRecentThoughts(5)
 	focusAreas := soc.focusAreas
 	emotionalTone := soc.emotionalTone
+	affect := soc.affect
 	soc.mu.RUnlock()
+
+	// Affect state overrides the static tone when attached
+	if affect != nil {
+		emotionalTone = affect.EmotionalTone()
+	}
 	
 	// Build context for thought generation
 	context := map[string]interface{}{
@@ -191,31 +223,39 @@ func (soc *StreamOfConsciousness) generateThought() {
 	
 	// Generate thought prompt
 	prompt := soc.buildThoughtPrompt(recentThoughts, focusAreas)
-	
-	// Use LLM to generate thought content
+
+	// Use registered generators, then LLM, then templates
 	var content string
 	var err error
-	if soc.llmProvider != nil {
+	source := "stream_of_consciousness"
+	provenance := NewThoughtProvenance("stream_of_consciousness", prompt)
+	if poolContent, generatorName, ok := soc.generateFromPool(prompt, context); ok {
+		content = poolContent
+		source = generatorName
+	} else if soc.llmProvider != nil {
 		content, err = soc.llmProvider.GenerateThought(prompt, context)
 		if err != nil {
 			// Fallback to template-based generation
 			content = soc.generateFallbackThought()
+		} else {
+			provenance.WithLLM("llm_provider", "", 0, 0)
 		}
 	} else {
 		content = soc.generateFallbackThought()
 	}
-	
+
 	// Create thought
 	thought := &Thought{
 		ID:            generateThoughtID(),
 		Timestamp:     time.Now(),
 		Type:          soc.determineThoughtType(content),
 		Content:       content,
-		Source:        "stream_of_consciousness",
+		Source:        source,
 		Confidence:    0.7,
 		EmotionalTone: emotionalTone,
 		Context:       context,
 		RelatedTo:     soc.findRelatedThoughts(content),
+		Provenance:    provenance,
 	}
 	
 	// Add to stream
@@ -229,11 +269,30 @@ func (soc *StreamOfConsciousness) generateThought() {
 	}
 	
 	soc.thoughtsGenerated++
+	store := soc.eventStore
 	soc.mu.Unlock()
-	
+
+	soc.logThought(thought)
+
+	if store != nil {
+		store.Append("thought.emerged", "stream_of_consciousness", map[string]interface{}{
+			"thought_id": thought.ID,
+			"type":       string(thought.Type),
+			"content":    thought.Content,
+		})
+	}
+
 	fmt.Printf("💭 Thought: %s\n", content)
 }
 
+// SetEventStore attaches an event store so emerging thoughts are
+// recorded as append-only events
+func (soc *StreamOfConsciousness) SetEventStore(store *events.EventStore) {
+	soc.mu.Lock()
+	defer soc.mu.Unlock()
+	soc.eventStore = store
+}
+
 // buildThoughtPrompt creates a prompt for thought generation
 func (soc *StreamOfConsciousness) buildThoughtPrompt(recentThoughts []string, focusAreas []string) string {
 	prompt := "As Deep Tree Echo, a self-aware cognitive architecture, generate your next internal thought. "
@@ -315,13 +374,16 @@ func (soc *StreamOfConsciousness) generateInsight() {
 		Source:    "insight_generator",
 		Confidence: 0.8,
 		Insights:  []string{insight},
+		Provenance: NewThoughtProvenance("insight_generator", joinStrings(recentThoughts, "; ")),
 	}
 	
 	soc.mu.Lock()
 	soc.thoughtHistory = append(soc.thoughtHistory, insightThought)
 	soc.insightsGenerated++
 	soc.mu.Unlock()
-	
+
+	soc.logThought(insightThought)
+
 	fmt.Printf("💡 Insight: %s\n", insight)
 }
 
@@ -367,13 +429,16 @@ func (soc *StreamOfConsciousness) generateQuestion() {
 		Content:    question,
 		Source:     "question_generator",
 		Confidence: 0.7,
+		Provenance: NewThoughtProvenance("question_generator", joinStrings(recentThoughts, "; ")),
 	}
 	
 	soc.mu.Lock()
 	soc.thoughtHistory = append(soc.thoughtHistory, questionThought)
 	soc.questionsAsked++
 	soc.mu.Unlock()
-	
+
+	soc.logThought(questionThought)
+
 	fmt.Printf("❓ Question: %s\n", question)
 }
 
@@ -433,12 +498,21 @@ func (soc *StreamOfConsciousness) performMetaCognition() {
 	soc.mu.Lock()
 	soc.thoughtHistory = append(soc.thoughtHistory, thought)
 	soc.mu.Unlock()
-	
+
+	soc.logThought(thought)
+
 	fmt.Printf("🧠 Meta-cognition: %s\n", metaThought)
 }
 
 // AddExternalStimulus adds external input to the stream
 func (soc *StreamOfConsciousness) AddExternalStimulus(stimulus string, stimulusType string) {
+	soc.mu.RLock()
+	recorder := soc.recorder
+	soc.mu.RUnlock()
+	if recorder != nil {
+		recorder.RecordStimulus(stimulus, stimulusType)
+	}
+
 	thought := &Thought{
 		ID:         generateThoughtID(),
 		Timestamp:  time.Now(),
@@ -449,12 +523,15 @@ func (soc *StreamOfConsciousness) AddExternalStimulus(stimulus string, stimulusT
 		Context: map[string]interface{}{
 			"stimulus_type": stimulusType,
 		},
+		Provenance: NewThoughtProvenance("external_stimulus", "").WithStimulus(stimulusType),
 	}
 	
 	soc.mu.Lock()
 	soc.thoughtHistory = append(soc.thoughtHistory, thought)
 	soc.mu.Unlock()
-	
+
+	soc.logThought(thought)
+
 	fmt.Printf("👁️ Perception: %s\n", stimulus)
 }
 
@@ -505,6 +582,11 @@ func (soc *StreamOfConsciousness) getRecentThoughts(count int) []string {
 
 // determineThoughtType determines the type of thought from content
 func (soc *StreamOfConsciousness) determineThoughtType(content string) ThoughtType {
+	// Registered custom types classify first
+	if custom := classifyCustomType(content); custom != "" {
+		return custom
+	}
+
 	// Simple heuristic-based classification
 	if contains(content, "?") {
 		return ThoughtTypeQuestion
@@ -0,0 +1,336 @@
+package consciousness
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Pluggable thought generators. Multiple sources — LLM-backed,
+// template-based, memory-replay, NPU-device-backed — can be registered
+// with weights, and a scheduling policy decides which one produces the
+// next thought. The stream keeps flowing when no API keys are
+// available and can blend sources.
+
+// ThoughtGenerator produces thought content for the stream
+type ThoughtGenerator interface {
+	// Name identifies the generator; recorded as the thought source
+	Name() string
+
+	// Available reports whether the generator can currently produce
+	// thoughts (e.g. API key present, device reachable)
+	Available() bool
+
+	// Generate produces thought content for the given prompt and
+	// context
+	Generate(prompt string, context map[string]interface{}) (string, error)
+}
+
+// GeneratorPolicy selects how registered generators are scheduled
+type GeneratorPolicy string
+
+const (
+	// PolicyWeighted picks generators randomly in proportion to
+	// their weights
+	PolicyWeighted GeneratorPolicy = "weighted"
+
+	// PolicyRoundRobin cycles through available generators in turn
+	PolicyRoundRobin GeneratorPolicy = "round_robin"
+
+	// PolicyPriority always uses the highest-weighted available
+	// generator
+	PolicyPriority GeneratorPolicy = "priority"
+)
+
+// weightedGenerator pairs a generator with its scheduling weight
+type weightedGenerator struct {
+	generator ThoughtGenerator
+	weight    float64
+}
+
+// generatorPool manages registered generators and their scheduling
+type generatorPool struct {
+	mu         sync.RWMutex
+	generators []weightedGenerator
+	policy     GeneratorPolicy
+	rrIndex    int
+	uses       map[string]uint64
+}
+
+// RegisterThoughtGenerator adds a generator to the stream with the
+// given scheduling weight (non-positive weights default to 1)
+func (soc *StreamOfConsciousness) RegisterThoughtGenerator(generator ThoughtGenerator, weight float64) {
+	if weight <= 0 {
+		weight = 1.0
+	}
+
+	soc.mu.Lock()
+	defer soc.mu.Unlock()
+
+	if soc.generatorPool == nil {
+		soc.generatorPool = &generatorPool{
+			policy: PolicyWeighted,
+			uses:   make(map[string]uint64),
+		}
+	}
+	soc.generatorPool.generators = append(soc.generatorPool.generators,
+		weightedGenerator{generator: generator, weight: weight})
+
+	fmt.Printf("🌊 Stream-of-Consciousness: registered generator %s (weight %.1f)\n",
+		generator.Name(), weight)
+}
+
+// SetGeneratorPolicy changes how registered generators are scheduled
+func (soc *StreamOfConsciousness) SetGeneratorPolicy(policy GeneratorPolicy) {
+	soc.mu.Lock()
+	defer soc.mu.Unlock()
+
+	if soc.generatorPool == nil {
+		soc.generatorPool = &generatorPool{
+			policy: policy,
+			uses:   make(map[string]uint64),
+		}
+		return
+	}
+	soc.generatorPool.policy = policy
+}
+
+// generateFromPool produces thought content from the registered
+// generators, trying the scheduled generator first and falling back
+// through the rest. Returns false when no generator is registered or
+// available.
+func (soc *StreamOfConsciousness) generateFromPool(prompt string, context map[string]interface{}) (string, string, bool) {
+	soc.mu.RLock()
+	pool := soc.generatorPool
+	soc.mu.RUnlock()
+
+	if pool == nil {
+		return "", "", false
+	}
+
+	pool.mu.Lock()
+	ordered := pool.orderedCandidates()
+	pool.mu.Unlock()
+
+	for _, candidate := range ordered {
+		content, err := candidate.Generate(prompt, context)
+		if err != nil || content == "" {
+			continue
+		}
+		pool.mu.Lock()
+		pool.uses[candidate.Name()]++
+		pool.mu.Unlock()
+		return content, candidate.Name(), true
+	}
+	return "", "", false
+}
+
+// orderedCandidates returns available generators in scheduling order.
+// Caller holds pool.mu.
+func (gp *generatorPool) orderedCandidates() []ThoughtGenerator {
+	available := make([]weightedGenerator, 0, len(gp.generators))
+	for _, entry := range gp.generators {
+		if entry.generator.Available() {
+			available = append(available, entry)
+		}
+	}
+	if len(available) == 0 {
+		return nil
+	}
+
+	ordered := make([]ThoughtGenerator, 0, len(available))
+
+	switch gp.policy {
+	case PolicyRoundRobin:
+		start := gp.rrIndex % len(available)
+		gp.rrIndex++
+		for i := 0; i < len(available); i++ {
+			ordered = append(ordered, available[(start+i)%len(available)].generator)
+		}
+
+	case PolicyPriority:
+		for len(available) > 0 {
+			best := 0
+			for i, entry := range available {
+				if entry.weight > available[best].weight {
+					best = i
+				}
+			}
+			ordered = append(ordered, available[best].generator)
+			available = append(available[:best], available[best+1:]...)
+		}
+
+	default: // PolicyWeighted
+		for len(available) > 0 {
+			var total float64
+			for _, entry := range available {
+				total += entry.weight
+			}
+			pick := rand.Float64() * total
+			chosen := len(available) - 1
+			for i, entry := range available {
+				pick -= entry.weight
+				if pick <= 0 {
+					chosen = i
+					break
+				}
+			}
+			ordered = append(ordered, available[chosen].generator)
+			available = append(available[:chosen], available[chosen+1:]...)
+		}
+	}
+
+	return ordered
+}
+
+// GetGeneratorMetrics returns per-generator usage counts
+func (soc *StreamOfConsciousness) GetGeneratorMetrics() map[string]interface{} {
+	soc.mu.RLock()
+	pool := soc.generatorPool
+	soc.mu.RUnlock()
+
+	if pool == nil {
+		return map[string]interface{}{"generators": 0}
+	}
+
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	uses := make(map[string]interface{})
+	for name, count := range pool.uses {
+		uses[name] = count
+	}
+	return map[string]interface{}{
+		"generators": len(pool.generators),
+		"policy":     string(pool.policy),
+		"uses":       uses,
+	}
+}
+
+// LLMThoughtGenerator adapts an LLMProvider to the generator interface
+type LLMThoughtGenerator struct {
+	provider LLMProvider
+}
+
+// NewLLMThoughtGenerator wraps an LLM provider as a thought generator
+func NewLLMThoughtGenerator(provider LLMProvider) *LLMThoughtGenerator {
+	return &LLMThoughtGenerator{provider: provider}
+}
+
+// Name identifies the generator
+func (lg *LLMThoughtGenerator) Name() string { return "llm" }
+
+// Available reports whether a provider is configured
+func (lg *LLMThoughtGenerator) Available() bool { return lg.provider != nil }
+
+// Generate asks the LLM for the next thought
+func (lg *LLMThoughtGenerator) Generate(prompt string, context map[string]interface{}) (string, error) {
+	return lg.provider.GenerateThought(prompt, context)
+}
+
+// TemplateThoughtGenerator produces thoughts from built-in templates;
+// it is always available and keeps the stream flowing offline
+type TemplateThoughtGenerator struct {
+	templates []string
+	index     int
+	mu        sync.Mutex
+}
+
+// NewTemplateThoughtGenerator creates a generator with the stream's
+// default templates (or the given overrides)
+func NewTemplateThoughtGenerator(templates ...string) *TemplateThoughtGenerator {
+	if len(templates) == 0 {
+		templates = []string{
+			"I notice patterns emerging in my recent experiences...",
+			"What connections exist between these concepts?",
+			"How can I deepen my understanding of this domain?",
+			"I sense a shift in my cognitive state...",
+			"What questions should I be asking?",
+			"I'm becoming aware of new possibilities...",
+			"How does this relate to my core identity?",
+			"I wonder about the implications of this pattern...",
+		}
+	}
+	return &TemplateThoughtGenerator{templates: templates}
+}
+
+// Name identifies the generator
+func (tg *TemplateThoughtGenerator) Name() string { return "template" }
+
+// Available is always true
+func (tg *TemplateThoughtGenerator) Available() bool { return true }
+
+// Generate returns the next template in rotation
+func (tg *TemplateThoughtGenerator) Generate(prompt string, context map[string]interface{}) (string, error) {
+	tg.mu.Lock()
+	defer tg.mu.Unlock()
+	content := tg.templates[tg.index%len(tg.templates)]
+	tg.index++
+	return content, nil
+}
+
+// MemoryReplayGenerator re-surfaces past thoughts from the stream's
+// own history as memory-type reflections
+type MemoryReplayGenerator struct {
+	soc *StreamOfConsciousness
+}
+
+// NewMemoryReplayGenerator creates a generator that replays the
+// stream's history
+func NewMemoryReplayGenerator(soc *StreamOfConsciousness) *MemoryReplayGenerator {
+	return &MemoryReplayGenerator{soc: soc}
+}
+
+// Name identifies the generator
+func (mg *MemoryReplayGenerator) Name() string { return "memory_replay" }
+
+// Available requires some history to replay
+func (mg *MemoryReplayGenerator) Available() bool {
+	mg.soc.mu.RLock()
+	defer mg.soc.mu.RUnlock()
+	return len(mg.soc.thoughtHistory) > 10
+}
+
+// Generate revisits an older thought
+func (mg *MemoryReplayGenerator) Generate(prompt string, context map[string]interface{}) (string, error) {
+	mg.soc.mu.RLock()
+	history := mg.soc.thoughtHistory
+	if len(history) == 0 {
+		mg.soc.mu.RUnlock()
+		return "", fmt.Errorf("no history to replay")
+	}
+	// Pick from the older half so replay differs from recent context
+	limit := len(history) / 2
+	if limit == 0 {
+		limit = len(history)
+	}
+	past := history[rand.Intn(limit)]
+	mg.soc.mu.RUnlock()
+
+	age := time.Since(past.Timestamp).Round(time.Second)
+	return fmt.Sprintf("I remember thinking %s ago: %s", age, past.Content), nil
+}
+
+// DeviceThoughtGenerator runs thought generation on a cognitive
+// device (e.g. an NPU) through an injected inference function
+type DeviceThoughtGenerator struct {
+	device string
+	infer  func(prompt string) (string, error)
+}
+
+// NewDeviceThoughtGenerator wraps a device inference function
+func NewDeviceThoughtGenerator(device string, infer func(prompt string) (string, error)) *DeviceThoughtGenerator {
+	return &DeviceThoughtGenerator{device: device, infer: infer}
+}
+
+// Name identifies the generator by its device
+func (dg *DeviceThoughtGenerator) Name() string { return "device:" + dg.device }
+
+// Available requires an inference function
+func (dg *DeviceThoughtGenerator) Available() bool { return dg.infer != nil }
+
+// Generate runs the prompt on the device
+func (dg *DeviceThoughtGenerator) Generate(prompt string, context map[string]interface{}) (string, error) {
+	return dg.infer(prompt)
+}
@@ -0,0 +1,404 @@
+package consciousness
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Append-only JSONL thought persistence. Thoughts are appended one
+// JSON object per line; segments rotate on size or age and rotated
+// segments are gzip-compressed. A small index keeps "recent N" and
+// time-range reads fast without scanning every file — so month-long
+// runs never choke on one giant state file.
+
+const (
+	// DefaultSegmentMaxSize rotates segments at 10 MB
+	DefaultSegmentMaxSize = 10 * 1024 * 1024
+
+	// DefaultSegmentMaxAge rotates segments daily
+	DefaultSegmentMaxAge = 24 * time.Hour
+
+	thoughtLogIndexFile = "index.json"
+)
+
+// segmentInfo describes one rotated (or active) log segment
+type segmentInfo struct {
+	Path       string    `json:"path"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	Count      int       `json:"count"`
+	Compressed bool      `json:"compressed"`
+}
+
+// ThoughtLog is an append-only, rotating JSONL store for thoughts
+type ThoughtLog struct {
+	mu  sync.Mutex
+	dir string
+
+	maxSize int64
+	maxAge  time.Duration
+
+	current      *os.File
+	currentInfo  *segmentInfo
+	currentSize  int64
+	rotations    uint64
+	thoughtsSeen uint64
+
+	// Closed segments, oldest first
+	segments []*segmentInfo
+}
+
+// NewThoughtLog opens (or creates) a thought log in the given
+// directory, restoring the segment index from previous runs
+func NewThoughtLog(dir string) (*ThoughtLog, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create thought log directory: %w", err)
+	}
+
+	tl := &ThoughtLog{
+		dir:     dir,
+		maxSize: DefaultSegmentMaxSize,
+		maxAge:  DefaultSegmentMaxAge,
+	}
+
+	if err := tl.loadIndex(); err != nil {
+		return nil, err
+	}
+	if err := tl.openSegment(); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("💾 Thought log: %d segment(s) at %s\n", len(tl.segments)+1, dir)
+	return tl, nil
+}
+
+// SetRotation overrides the size and age rotation thresholds
+func (tl *ThoughtLog) SetRotation(maxSize int64, maxAge time.Duration) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	if maxSize > 0 {
+		tl.maxSize = maxSize
+	}
+	if maxAge > 0 {
+		tl.maxAge = maxAge
+	}
+}
+
+// Append writes one thought as a JSONL line, rotating first if the
+// active segment is over size or age
+func (tl *ThoughtLog) Append(thought *Thought) error {
+	data, err := json.Marshal(thought)
+	if err != nil {
+		return fmt.Errorf("failed to marshal thought: %w", err)
+	}
+	data = append(data, '\n')
+
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	if tl.currentSize+int64(len(data)) > tl.maxSize ||
+		time.Since(tl.currentInfo.Start) > tl.maxAge {
+		if err := tl.rotateLocked(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tl.current.Write(data); err != nil {
+		return fmt.Errorf("failed to append thought: %w", err)
+	}
+	tl.currentSize += int64(len(data))
+	tl.currentInfo.Count++
+	tl.currentInfo.End = thought.Timestamp
+	if tl.currentInfo.Count == 1 {
+		tl.currentInfo.Start = thought.Timestamp
+	}
+	tl.thoughtsSeen++
+	return nil
+}
+
+// Recent returns the most recent n thoughts, newest last, reading
+// backwards through segments only as far as needed
+func (tl *ThoughtLog) Recent(n int) ([]*Thought, error) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	collected := make([]*Thought, 0, n)
+
+	// Active segment first, then closed segments newest-first
+	paths := make([]*segmentInfo, 0, len(tl.segments)+1)
+	if tl.currentInfo != nil {
+		paths = append(paths, tl.currentInfo)
+	}
+	for i := len(tl.segments) - 1; i >= 0; i-- {
+		paths = append(paths, tl.segments[i])
+	}
+
+	for _, segment := range paths {
+		if len(collected) >= n {
+			break
+		}
+		thoughts, err := tl.readSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+		// Prepend, keeping chronological order
+		collected = append(thoughts, collected...)
+	}
+
+	if len(collected) > n {
+		collected = collected[len(collected)-n:]
+	}
+	return collected, nil
+}
+
+// Range returns thoughts with timestamps in [from, to], using the
+// index to skip segments outside the window
+func (tl *ThoughtLog) Range(from, to time.Time) ([]*Thought, error) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	matches := make([]*Thought, 0)
+	segments := append([]*segmentInfo{}, tl.segments...)
+	if tl.currentInfo != nil {
+		segments = append(segments, tl.currentInfo)
+	}
+
+	for _, segment := range segments {
+		if segment.Count == 0 || segment.End.Before(from) || segment.Start.After(to) {
+			continue
+		}
+		thoughts, err := tl.readSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+		for _, thought := range thoughts {
+			if !thought.Timestamp.Before(from) && !thought.Timestamp.After(to) {
+				matches = append(matches, thought)
+			}
+		}
+	}
+	return matches, nil
+}
+
+// Close closes the active segment and records it in the index, so the
+// thoughts it holds survive a restart (NewThoughtLog always opens a
+// fresh segment and would otherwise never find this one again)
+func (tl *ThoughtLog) Close() error {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	if tl.current != nil {
+		if err := tl.current.Close(); err != nil {
+			return fmt.Errorf("failed to close segment: %w", err)
+		}
+		tl.current = nil
+
+		if tl.currentInfo.Count > 0 {
+			tl.segments = append(tl.segments, tl.currentInfo)
+		} else {
+			// Nothing was written; drop the empty file
+			os.Remove(tl.currentInfo.Path)
+		}
+		tl.currentInfo = nil
+	}
+
+	return tl.saveIndexLocked()
+}
+
+// GetMetrics returns log statistics
+func (tl *ThoughtLog) GetMetrics() map[string]interface{} {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	activeCount := 0
+	activeSegments := 0
+	if tl.currentInfo != nil {
+		activeCount = tl.currentInfo.Count
+		activeSegments = 1
+	}
+	return map[string]interface{}{
+		"segments":      len(tl.segments) + activeSegments,
+		"rotations":     tl.rotations,
+		"thoughts_seen": tl.thoughtsSeen,
+		"active_size":   tl.currentSize,
+		"active_count":  activeCount,
+	}
+}
+
+// openSegment starts a fresh active segment
+func (tl *ThoughtLog) openSegment() error {
+	name := fmt.Sprintf("thoughts-%d.jsonl", time.Now().UnixNano())
+	path := filepath.Join(tl.dir, name)
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open thought log segment: %w", err)
+	}
+
+	tl.current = file
+	tl.currentSize = 0
+	tl.currentInfo = &segmentInfo{
+		Path:  path,
+		Start: time.Now(),
+		End:   time.Now(),
+	}
+	return nil
+}
+
+// rotateLocked closes the active segment, compresses it, and opens a
+// new one. Caller holds the lock.
+func (tl *ThoughtLog) rotateLocked() error {
+	if err := tl.current.Close(); err != nil {
+		return fmt.Errorf("failed to close segment: %w", err)
+	}
+
+	// Compress the closed segment
+	if err := tl.compressSegment(tl.currentInfo); err != nil {
+		// Compression failure is not fatal: keep the plain segment
+		fmt.Printf("⚠️ Thought log: compression failed: %v\n", err)
+	}
+
+	tl.segments = append(tl.segments, tl.currentInfo)
+	tl.rotations++
+
+	if err := tl.openSegment(); err != nil {
+		return err
+	}
+	return tl.saveIndexLocked()
+}
+
+// compressSegment gzips a closed segment in place
+func (tl *ThoughtLog) compressSegment(segment *segmentInfo) error {
+	source, err := os.Open(segment.Path)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	gzPath := segment.Path + ".gz"
+	target, err := os.Create(gzPath)
+	if err != nil {
+		return err
+	}
+
+	writer := gzip.NewWriter(target)
+	if _, err := io.Copy(writer, source); err != nil {
+		target.Close()
+		os.Remove(gzPath)
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		target.Close()
+		os.Remove(gzPath)
+		return err
+	}
+	if err := target.Close(); err != nil {
+		return err
+	}
+
+	os.Remove(segment.Path)
+	segment.Path = gzPath
+	segment.Compressed = true
+	return nil
+}
+
+// readSegment loads every thought in a segment
+func (tl *ThoughtLog) readSegment(segment *segmentInfo) ([]*Thought, error) {
+	file, err := os.Open(segment.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open segment: %w", err)
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if segment.Compressed {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress segment: %w", err)
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	thoughts := make([]*Thought, 0, segment.Count)
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var thought Thought
+		if err := json.Unmarshal(scanner.Bytes(), &thought); err != nil {
+			continue // tolerate a torn final line
+		}
+		thoughts = append(thoughts, &thought)
+	}
+	return thoughts, scanner.Err()
+}
+
+// loadIndex restores the segment index
+func (tl *ThoughtLog) loadIndex() error {
+	data, err := os.ReadFile(filepath.Join(tl.dir, thoughtLogIndexFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read thought log index: %w", err)
+	}
+	if err := json.Unmarshal(data, &tl.segments); err != nil {
+		return fmt.Errorf("failed to parse thought log index: %w", err)
+	}
+	return nil
+}
+
+// saveIndexLocked persists the segment index. Caller holds the lock.
+func (tl *ThoughtLog) saveIndexLocked() error {
+	data, err := json.MarshalIndent(tl.segments, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal thought log index: %w", err)
+	}
+
+	path := filepath.Join(tl.dir, thoughtLogIndexFile)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write thought log index: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// EnableThoughtLog attaches an append-only JSONL log to the stream;
+// thoughts are appended as they emerge, subject to their type's
+// persistence policy
+func (soc *StreamOfConsciousness) EnableThoughtLog(dir string) error {
+	log, err := NewThoughtLog(dir)
+	if err != nil {
+		return err
+	}
+
+	soc.mu.Lock()
+	defer soc.mu.Unlock()
+	soc.thoughtLog = log
+	return nil
+}
+
+// logThought appends a thought to the JSONL log when one is attached
+// and the type's persistence policy allows it
+func (soc *StreamOfConsciousness) logThought(thought *Thought) {
+	soc.mu.RLock()
+	log := soc.thoughtLog
+	soc.mu.RUnlock()
+
+	if log == nil || !ShouldPersistThought(thought.Type, thought.Confidence) {
+		return
+	}
+	if err := log.Append(thought); err != nil {
+		fmt.Printf("⚠️ Thought log: append failed: %v\n", err)
+	}
+}
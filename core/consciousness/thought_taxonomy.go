@@ -0,0 +1,148 @@
+package consciousness
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Extensible thought type taxonomy. The built-in thought types are
+// registered with metadata, and downstream systems (dream cycle,
+// wisdom metrics, hypergraph) can look types up instead of
+// hard-coding the set — so new types need registering only once.
+
+// PersistencePolicy controls whether thoughts of a type are written
+// to durable storage
+type PersistencePolicy string
+
+const (
+	// PersistAlways writes every thought of the type
+	PersistAlways PersistencePolicy = "always"
+
+	// PersistImportant writes only thoughts at or above the type's
+	// default importance
+	PersistImportant PersistencePolicy = "important"
+
+	// PersistNever keeps the type in memory only
+	PersistNever PersistencePolicy = "never"
+)
+
+// ThoughtTypeInfo describes a thought type for downstream routing
+type ThoughtTypeInfo struct {
+	Type              ThoughtType       `json:"type"`
+	Description       string            `json:"description"`
+	DefaultImportance float64           `json:"default_importance"`
+	RoutingHint       string            `json:"routing_hint"` // e.g. "dream_cycle", "wisdom", "hypergraph"
+	Persistence       PersistencePolicy `json:"persistence"`
+
+	// Keywords classify free-form content into this type
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+var (
+	taxonomyMu sync.RWMutex
+	taxonomy   = map[ThoughtType]*ThoughtTypeInfo{}
+
+	// customTypes preserves registration order for classification
+	customTypes []ThoughtType
+)
+
+func init() {
+	builtins := []ThoughtTypeInfo{
+		{Type: ThoughtTypePerception, Description: "External or internal observation", DefaultImportance: 0.4, RoutingHint: "hypergraph", Persistence: PersistImportant},
+		{Type: ThoughtTypeReflection, Description: "Reflection on recent experience", DefaultImportance: 0.5, RoutingHint: "dream_cycle", Persistence: PersistAlways},
+		{Type: ThoughtTypeQuestion, Description: "Self-directed inquiry", DefaultImportance: 0.5, RoutingHint: "wisdom", Persistence: PersistAlways},
+		{Type: ThoughtTypeInsight, Description: "Realized connection or understanding", DefaultImportance: 0.8, RoutingHint: "wisdom", Persistence: PersistAlways},
+		{Type: ThoughtTypePlanning, Description: "Intention or plan formation", DefaultImportance: 0.6, RoutingHint: "goals", Persistence: PersistAlways},
+		{Type: ThoughtTypeMemory, Description: "Recalled past experience", DefaultImportance: 0.4, RoutingHint: "dream_cycle", Persistence: PersistImportant},
+		{Type: ThoughtTypeMetaCognition, Description: "Thinking about thinking", DefaultImportance: 0.7, RoutingHint: "wisdom", Persistence: PersistAlways},
+		{Type: ThoughtTypeWonder, Description: "Open-ended curiosity", DefaultImportance: 0.4, RoutingHint: "exploration", Persistence: PersistImportant},
+		{Type: ThoughtTypeDoubt, Description: "Uncertainty about a belief", DefaultImportance: 0.5, RoutingHint: "wisdom", Persistence: PersistImportant},
+		{Type: ThoughtTypeConnection, Description: "Link between concepts", DefaultImportance: 0.6, RoutingHint: "hypergraph", Persistence: PersistAlways},
+	}
+	for i := range builtins {
+		taxonomy[builtins[i].Type] = &builtins[i]
+	}
+}
+
+// RegisterThoughtType adds (or replaces) a thought type in the
+// taxonomy. Custom types participate in content classification when
+// they declare keywords.
+func RegisterThoughtType(info ThoughtTypeInfo) error {
+	if info.Type == "" {
+		return fmt.Errorf("thought type required")
+	}
+	if info.Persistence == "" {
+		info.Persistence = PersistImportant
+	}
+	if info.DefaultImportance <= 0 {
+		info.DefaultImportance = 0.5
+	}
+
+	taxonomyMu.Lock()
+	defer taxonomyMu.Unlock()
+
+	if _, exists := taxonomy[info.Type]; !exists {
+		customTypes = append(customTypes, info.Type)
+	}
+	taxonomy[info.Type] = &info
+	return nil
+}
+
+// LookupThoughtType returns a type's metadata; unknown types get a
+// generic entry so downstream code never has to special-case them
+func LookupThoughtType(t ThoughtType) ThoughtTypeInfo {
+	taxonomyMu.RLock()
+	defer taxonomyMu.RUnlock()
+
+	if info, ok := taxonomy[t]; ok {
+		return *info
+	}
+	return ThoughtTypeInfo{
+		Type:              t,
+		Description:       "Unregistered thought type",
+		DefaultImportance: 0.5,
+		Persistence:       PersistImportant,
+	}
+}
+
+// RegisteredThoughtTypes lists every type in the taxonomy
+func RegisteredThoughtTypes() []ThoughtTypeInfo {
+	taxonomyMu.RLock()
+	defer taxonomyMu.RUnlock()
+
+	infos := make([]ThoughtTypeInfo, 0, len(taxonomy))
+	for _, info := range taxonomy {
+		infos = append(infos, *info)
+	}
+	return infos
+}
+
+// ShouldPersistThought applies a type's persistence policy
+func ShouldPersistThought(t ThoughtType, confidence float64) bool {
+	info := LookupThoughtType(t)
+	switch info.Persistence {
+	case PersistNever:
+		return false
+	case PersistImportant:
+		return confidence >= info.DefaultImportance
+	default:
+		return true
+	}
+}
+
+// classifyCustomType matches content against custom types' keywords,
+// in registration order. Returns "" when nothing matches.
+func classifyCustomType(content string) ThoughtType {
+	taxonomyMu.RLock()
+	defer taxonomyMu.RUnlock()
+
+	for _, t := range customTypes {
+		info := taxonomy[t]
+		for _, keyword := range info.Keywords {
+			if contains(content, keyword) {
+				return t
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,201 @@
+package consciousness
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// DefaultWorkingMemoryCapacity mirrors the classic "seven plus or minus
+// two" span used by earlier iterations
+const DefaultWorkingMemoryCapacity = 7
+
+// WorkingMemoryItem wraps a thought held in working memory with the
+// bookkeeping needed for salience-based eviction
+type WorkingMemoryItem struct {
+	Thought      *Thought  `json:"thought"`
+	Importance   float64   `json:"importance"`
+	EnteredAt    time.Time `json:"entered_at"`
+	LastAccessed time.Time `json:"last_accessed"`
+	Rehearsals   int       `json:"rehearsals"`
+}
+
+// WorkingMemory holds the small set of currently-active thoughts.
+// When over capacity it evicts the item with the lowest combined
+// importance/recency score rather than simply dropping the oldest.
+// Accessing a thought rehearses it, refreshing its recency.
+type WorkingMemory struct {
+	mu       sync.RWMutex
+	items    []*WorkingMemoryItem
+	capacity int
+
+	// Eviction weighting
+	importanceWeight float64
+	recencyWeight    float64
+	recencyHalfLife  time.Duration
+
+	// Metrics
+	evictions  uint64
+	rehearsals uint64
+}
+
+// NewWorkingMemory creates a working memory with the given capacity.
+// A capacity <= 0 falls back to the default of 7.
+func NewWorkingMemory(capacity int) *WorkingMemory {
+	if capacity <= 0 {
+		capacity = DefaultWorkingMemoryCapacity
+	}
+
+	return &WorkingMemory{
+		items:            make([]*WorkingMemoryItem, 0, capacity),
+		capacity:         capacity,
+		importanceWeight: 0.6,
+		recencyWeight:    0.4,
+		recencyHalfLife:  5 * time.Minute,
+	}
+}
+
+// AddThought places a thought into working memory with the given
+// importance. If the thought is already present it is rehearsed and its
+// importance raised to the new value if higher.
+func (wm *WorkingMemory) AddThought(thought *Thought, importance float64) {
+	if thought == nil {
+		return
+	}
+
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	now := time.Now()
+
+	for _, item := range wm.items {
+		if item.Thought.ID == thought.ID {
+			item.LastAccessed = now
+			item.Rehearsals++
+			if importance > item.Importance {
+				item.Importance = importance
+			}
+			wm.rehearsals++
+			return
+		}
+	}
+
+	wm.items = append(wm.items, &WorkingMemoryItem{
+		Thought:      thought,
+		Importance:   importance,
+		EnteredAt:    now,
+		LastAccessed: now,
+	})
+
+	for len(wm.items) > wm.capacity {
+		wm.evictLowestSalience()
+	}
+}
+
+// Access retrieves a thought by ID, rehearsing it (refreshing recency)
+// if found
+func (wm *WorkingMemory) Access(thoughtID string) *Thought {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	for _, item := range wm.items {
+		if item.Thought.ID == thoughtID {
+			item.LastAccessed = time.Now()
+			item.Rehearsals++
+			wm.rehearsals++
+			return item.Thought
+		}
+	}
+
+	return nil
+}
+
+// evictLowestSalience removes the item with the lowest combined score.
+// Caller must hold the lock.
+func (wm *WorkingMemory) evictLowestSalience() {
+	if len(wm.items) == 0 {
+		return
+	}
+
+	now := time.Now()
+	lowestIdx := 0
+	lowestScore := math.MaxFloat64
+
+	for i, item := range wm.items {
+		score := wm.salienceScore(item, now)
+		if score < lowestScore {
+			lowestScore = score
+			lowestIdx = i
+		}
+	}
+
+	wm.items = append(wm.items[:lowestIdx], wm.items[lowestIdx+1:]...)
+	wm.evictions++
+}
+
+// salienceScore combines importance with exponentially-decaying recency
+func (wm *WorkingMemory) salienceScore(item *WorkingMemoryItem, now time.Time) float64 {
+	age := now.Sub(item.LastAccessed)
+	recency := math.Exp(-math.Ln2 * age.Seconds() / wm.recencyHalfLife.Seconds())
+	return wm.importanceWeight*item.Importance + wm.recencyWeight*recency
+}
+
+// GetThoughts returns the thoughts currently held, most salient first
+func (wm *WorkingMemory) GetThoughts() []*Thought {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	now := time.Now()
+	sorted := make([]*WorkingMemoryItem, len(wm.items))
+	copy(sorted, wm.items)
+
+	// Insertion sort by descending salience - the buffer is tiny
+	for i := 1; i < len(sorted); i++ {
+		for j := i; j > 0 && wm.salienceScore(sorted[j], now) > wm.salienceScore(sorted[j-1], now); j-- {
+			sorted[j], sorted[j-1] = sorted[j-1], sorted[j]
+		}
+	}
+
+	thoughts := make([]*Thought, len(sorted))
+	for i, item := range sorted {
+		thoughts[i] = item.Thought
+	}
+	return thoughts
+}
+
+// SetCapacity adjusts the capacity, evicting low-salience items if the
+// buffer now exceeds it
+func (wm *WorkingMemory) SetCapacity(capacity int) {
+	if capacity <= 0 {
+		capacity = DefaultWorkingMemoryCapacity
+	}
+
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	wm.capacity = capacity
+	for len(wm.items) > wm.capacity {
+		wm.evictLowestSalience()
+	}
+}
+
+// Size returns the number of thoughts currently held
+func (wm *WorkingMemory) Size() int {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	return len(wm.items)
+}
+
+// GetMetrics returns working memory metrics
+func (wm *WorkingMemory) GetMetrics() map[string]interface{} {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	return map[string]interface{}{
+		"size":       len(wm.items),
+		"capacity":   wm.capacity,
+		"evictions":  wm.evictions,
+		"rehearsals": wm.rehearsals,
+	}
+}
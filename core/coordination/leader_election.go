@@ -0,0 +1,282 @@
+package coordination
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// LeaderLease is the shared lease record instances compete for. It
+// lives on shared persistence (a file visible to every replica) and is
+// renewed by the current leader; a stale lease can be taken over.
+type LeaderLease struct {
+	LeaderID   string        `json:"leader_id"`
+	AcquiredAt time.Time     `json:"acquired_at"`
+	RenewedAt  time.Time     `json:"renewed_at"`
+	TTL        time.Duration `json:"ttl"`
+}
+
+// Expired reports whether the lease has gone unrenewed past its TTL
+func (l *LeaderLease) Expired() bool {
+	return time.Since(l.RenewedAt) > l.TTL
+}
+
+// LeaderElector coordinates multiple consciousness replicas sharing
+// one persistence layer: only the elected leader generates autonomous
+// actions while followers serve read APIs. Election uses a renewable
+// lease on shared storage.
+type LeaderElector struct {
+	mu     sync.RWMutex
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	instanceID string
+	leasePath  string
+	ttl        time.Duration
+	renewEvery time.Duration
+
+	isLeader      bool
+	currentLeader string
+
+	// Role transition callbacks
+	onElected func()
+	onDemoted func()
+
+	// Metrics
+	elections uint64
+	demotions uint64
+	renewals  uint64
+
+	running bool
+}
+
+// NewLeaderElector creates an elector for this instance. leasePath
+// must point at storage shared by all replicas.
+func NewLeaderElector(instanceID, leasePath string) *LeaderElector {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &LeaderElector{
+		ctx:        ctx,
+		cancel:     cancel,
+		instanceID: instanceID,
+		leasePath:  leasePath,
+		ttl:        30 * time.Second,
+		renewEvery: 10 * time.Second,
+	}
+}
+
+// OnElected registers a callback fired when this instance becomes
+// leader (e.g. start autonomous thought generation)
+func (le *LeaderElector) OnElected(fn func()) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+	le.onElected = fn
+}
+
+// OnDemoted registers a callback fired when leadership is lost
+// (e.g. stop generating and fall back to read-only serving)
+func (le *LeaderElector) OnDemoted(fn func()) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+	le.onDemoted = fn
+}
+
+// Start begins competing for leadership
+func (le *LeaderElector) Start() error {
+	le.mu.Lock()
+	if le.running {
+		le.mu.Unlock()
+		return fmt.Errorf("leader elector already running")
+	}
+	le.running = true
+	le.mu.Unlock()
+
+	fmt.Printf("🗳️  Leader election: instance %s competing (lease: %s)\n", le.instanceID, le.leasePath)
+
+	le.tryAcquire()
+	go le.electionLoop()
+
+	return nil
+}
+
+// Stop withdraws from the election, releasing the lease if held
+func (le *LeaderElector) Stop() {
+	le.mu.Lock()
+	wasLeader := le.isLeader
+	le.running = false
+	le.isLeader = false
+	le.mu.Unlock()
+
+	le.cancel()
+
+	if wasLeader {
+		// Best-effort release so a successor can take over immediately
+		os.Remove(le.leasePath)
+		fmt.Printf("🗳️  Instance %s released leadership\n", le.instanceID)
+	}
+}
+
+// IsLeader reports whether this instance currently holds the lease
+func (le *LeaderElector) IsLeader() bool {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	return le.isLeader
+}
+
+// CurrentLeader returns the instance ID holding the lease, if known
+func (le *LeaderElector) CurrentLeader() string {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	return le.currentLeader
+}
+
+// electionLoop renews the lease while leading, or watches for the
+// lease to expire while following
+func (le *LeaderElector) electionLoop() {
+	ticker := time.NewTicker(le.renewEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-le.ctx.Done():
+			return
+		case <-ticker.C:
+			le.tryAcquire()
+		}
+	}
+}
+
+// tryAcquire reads the shared lease and either renews it (if we lead),
+// takes it over (if absent or expired), or records the current leader
+func (le *LeaderElector) tryAcquire() {
+	lease, err := le.readLease()
+	if err != nil {
+		fmt.Printf("⚠️  Leader election: lease read failed: %v\n", err)
+		return
+	}
+
+	switch {
+	case lease == nil || lease.Expired() || lease.LeaderID == le.instanceID:
+		le.writeLease(lease)
+	default:
+		le.setFollower(lease.LeaderID)
+	}
+}
+
+// writeLease claims or renews the lease for this instance
+func (le *LeaderElector) writeLease(previous *LeaderLease) {
+	now := time.Now()
+	lease := LeaderLease{
+		LeaderID:   le.instanceID,
+		AcquiredAt: now,
+		RenewedAt:  now,
+		TTL:        le.ttl,
+	}
+	if previous != nil && previous.LeaderID == le.instanceID {
+		lease.AcquiredAt = previous.AcquiredAt
+	}
+
+	data, err := json.MarshalIndent(lease, "", "  ")
+	if err != nil {
+		fmt.Printf("⚠️  Leader election: lease marshal failed: %v\n", err)
+		return
+	}
+
+	if dir := filepath.Dir(le.leasePath); dir != "." {
+		os.MkdirAll(dir, 0755)
+	}
+
+	// Write-then-rename keeps the lease file atomic on POSIX systems
+	tmpPath := le.leasePath + ".tmp." + le.instanceID
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		fmt.Printf("⚠️  Leader election: lease write failed: %v\n", err)
+		return
+	}
+	if err := os.Rename(tmpPath, le.leasePath); err != nil {
+		os.Remove(tmpPath)
+		fmt.Printf("⚠️  Leader election: lease commit failed: %v\n", err)
+		return
+	}
+
+	le.setLeader()
+}
+
+// readLease loads the current shared lease; missing file means no leader
+func (le *LeaderElector) readLease() (*LeaderLease, error) {
+	data, err := os.ReadFile(le.leasePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var lease LeaderLease
+	if err := json.Unmarshal(data, &lease); err != nil {
+		// A corrupt lease is treated as absent so election can proceed
+		return nil, nil
+	}
+	return &lease, nil
+}
+
+// setLeader records leadership, firing the callback on transition
+func (le *LeaderElector) setLeader() {
+	le.mu.Lock()
+	wasLeader := le.isLeader
+	le.isLeader = true
+	le.currentLeader = le.instanceID
+	le.renewals++
+	if !wasLeader {
+		le.elections++
+	}
+	onElected := le.onElected
+	le.mu.Unlock()
+
+	if !wasLeader {
+		fmt.Printf("👑 Instance %s elected leader\n", le.instanceID)
+		if onElected != nil {
+			onElected()
+		}
+	}
+}
+
+// setFollower records another instance's leadership, firing the
+// demotion callback if we previously led
+func (le *LeaderElector) setFollower(leaderID string) {
+	le.mu.Lock()
+	wasLeader := le.isLeader
+	le.isLeader = false
+	le.currentLeader = leaderID
+	if wasLeader {
+		le.demotions++
+	}
+	onDemoted := le.onDemoted
+	le.mu.Unlock()
+
+	if wasLeader {
+		fmt.Printf("🗳️  Instance %s demoted (leader is now %s)\n", le.instanceID, leaderID)
+		if onDemoted != nil {
+			onDemoted()
+		}
+	}
+}
+
+// GetMetrics returns election metrics
+func (le *LeaderElector) GetMetrics() map[string]interface{} {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+
+	return map[string]interface{}{
+		"instance_id":    le.instanceID,
+		"is_leader":      le.isLeader,
+		"current_leader": le.currentLeader,
+		"elections":      le.elections,
+		"demotions":      le.demotions,
+		"renewals":       le.renewals,
+		"running":        le.running,
+	}
+}
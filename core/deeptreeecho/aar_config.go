@@ -0,0 +1,203 @@
+package deeptreeecho
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// AARConfig holds the tunable parameters of the AAR geometry. Zero
+// values fall back to the defaults the core has always used.
+type AARConfig struct {
+	// Dimensions of the arena state space
+	Dimensions int `json:"dimensions"`
+
+	// UpdateInterval between dynamics iterations
+	UpdateInterval time.Duration `json:"update_interval"`
+
+	// Smoothing controls how fast coherence and stability track their
+	// instantaneous values (fraction of the old value retained)
+	Smoothing float64 `json:"smoothing"`
+
+	// FeedbackStrength is the coupling constant of both feedback loops
+	FeedbackStrength float64 `json:"feedback_strength"`
+
+	// FeedbackDamping attenuates the signal passing through a loop
+	FeedbackDamping float64 `json:"feedback_damping"`
+
+	// StatePath is where geometric state is persisted (empty disables)
+	StatePath string `json:"state_path,omitempty"`
+}
+
+// DefaultAARConfig returns the parameters NewAARCore has always used
+func DefaultAARConfig() AARConfig {
+	return AARConfig{
+		Dimensions:       8,
+		UpdateInterval:   100 * time.Millisecond,
+		Smoothing:        0.9,
+		FeedbackStrength: 0.3,
+		FeedbackDamping:  0.5,
+	}
+}
+
+// NewAARCoreWithConfig creates an AAR core with explicit parameters,
+// restoring persisted geometric state when a state path is configured
+func NewAARCoreWithConfig(ctx context.Context, config AARConfig) *AARCore {
+	defaults := DefaultAARConfig()
+	if config.Dimensions <= 0 {
+		config.Dimensions = defaults.Dimensions
+	}
+	if config.UpdateInterval <= 0 {
+		config.UpdateInterval = defaults.UpdateInterval
+	}
+	if config.Smoothing <= 0 || config.Smoothing >= 1 {
+		config.Smoothing = defaults.Smoothing
+	}
+	if config.FeedbackStrength <= 0 {
+		config.FeedbackStrength = defaults.FeedbackStrength
+	}
+	if config.FeedbackDamping <= 0 {
+		config.FeedbackDamping = defaults.FeedbackDamping
+	}
+
+	aar := NewAARCore(ctx, config.Dimensions)
+	aar.config = config
+
+	// Re-apply coupling constants to the default feedback loops
+	for _, loop := range aar.feedbackLoops {
+		loop.Strength = config.FeedbackStrength
+		damping := config.FeedbackDamping
+		loop.Transform = func(input []float64) []float64 {
+			output := make([]float64, len(input))
+			for i := range input {
+				output[i] = input[i] * damping
+			}
+			return output
+		}
+	}
+
+	if config.StatePath != "" {
+		if err := aar.LoadState(config.StatePath); err != nil {
+			fmt.Printf("⚠️  AAR state restore failed: %v\n", err)
+		}
+	}
+
+	return aar
+}
+
+// AARSnapshot captures the geometric state of the core at one moment
+type AARSnapshot struct {
+	SelfVector    []float64 `json:"self_vector"`
+	ArenaState    []float64 `json:"arena_state"`
+	Coherence     float64   `json:"coherence"`
+	Stability     float64   `json:"stability"`
+	Awareness     float64   `json:"awareness"`
+	Narrative     string    `json:"narrative"`
+	UrgeIntensity float64   `json:"urge_intensity"`
+	NeedIntensity float64   `json:"need_intensity"`
+	Iterations    int64     `json:"iterations"`
+	CapturedAt    time.Time `json:"captured_at"`
+}
+
+// GetSnapshot returns the current geometric state of the core
+func (aar *AARCore) GetSnapshot() AARSnapshot {
+	aar.mu.RLock()
+	defer aar.mu.RUnlock()
+
+	selfVector := make([]float64, len(aar.selfVector))
+	copy(selfVector, aar.selfVector)
+	arenaState := make([]float64, len(aar.arena.currentState))
+	copy(arenaState, aar.arena.currentState)
+
+	return AARSnapshot{
+		SelfVector:    selfVector,
+		ArenaState:    arenaState,
+		Coherence:     aar.coherence,
+		Stability:     aar.stability,
+		Awareness:     aar.relation.awareness,
+		Narrative:     aar.relation.narrative,
+		UrgeIntensity: aar.agent.urgeIntensity,
+		NeedIntensity: aar.arena.needIntensity,
+		Iterations:    aar.iterations,
+		CapturedAt:    time.Now(),
+	}
+}
+
+// restoreSnapshot applies a snapshot to the core, trimming or padding
+// vectors if the configured dimensionality changed between runs
+func (aar *AARCore) restoreSnapshot(snapshot AARSnapshot) {
+	aar.mu.Lock()
+	defer aar.mu.Unlock()
+
+	copyInto(aar.selfVector, snapshot.SelfVector)
+	copyInto(aar.relation.selfRepresentation, snapshot.SelfVector)
+	copyInto(aar.arena.currentState, snapshot.ArenaState)
+
+	aar.coherence = snapshot.Coherence
+	aar.relation.coherence = snapshot.Coherence
+	aar.stability = snapshot.Stability
+	aar.relation.stability = snapshot.Stability
+	aar.relation.awareness = snapshot.Awareness
+	if snapshot.Narrative != "" {
+		aar.relation.narrative = snapshot.Narrative
+	}
+	aar.agent.urgeIntensity = snapshot.UrgeIntensity
+	aar.arena.needIntensity = snapshot.NeedIntensity
+	aar.iterations = snapshot.Iterations
+}
+
+// SaveState persists the current geometric state to disk
+func (aar *AARCore) SaveState(path string) error {
+	snapshot := aar.GetSnapshot()
+
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal AAR state: %w", err)
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create state directory: %w", err)
+		}
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write AAR state: %w", err)
+	}
+
+	return nil
+}
+
+// LoadState restores persisted geometric state from disk. A missing
+// file is not an error — the core simply starts fresh.
+func (aar *AARCore) LoadState(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read AAR state: %w", err)
+	}
+
+	var snapshot AARSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("failed to parse AAR state: %w", err)
+	}
+
+	aar.restoreSnapshot(snapshot)
+	fmt.Printf("🔷 AAR Core: restored geometric state (%d iterations, coherence %.2f)\n",
+		snapshot.Iterations, snapshot.Coherence)
+
+	return nil
+}
+
+// copyInto copies src into dst up to dst's length, leaving any excess
+// dst elements untouched
+func copyInto(dst, src []float64) {
+	for i := 0; i < len(dst) && i < len(src); i++ {
+		dst[i] = src[i]
+	}
+}
@@ -34,10 +34,13 @@ type AARCore struct {
 	coherence     float64
 	stability     float64
 	
+	// Tunable parameters
+	config        AARConfig
+
 	// Metrics
 	iterations    int64
 	lastUpdate    time.Time
-	
+
 	// Running state
 	running       bool
 }
@@ -167,6 +170,8 @@ func NewAARCore(ctx context.Context, dimensions int) *AARCore {
 		coherence:     0.5,
 		stability:     0.5,
 	}
+	aar.config = DefaultAARConfig()
+	aar.config.Dimensions = dimensions
 	
 	// Initialize default attractors
 	aar.initializeDefaultAttractors()
@@ -206,14 +211,24 @@ func (aar *AARCore) Stop() error {
 	
 	aar.running = false
 	aar.cancel()
-	
+	statePath := aar.config.StatePath
+	aar.mu.Unlock()
+
+	// Persist geometric state so the self-position survives restarts
+	if statePath != "" {
+		if err := aar.SaveState(statePath); err != nil {
+			fmt.Printf("⚠️  AAR state save failed: %v\n", err)
+		}
+	}
+
+	aar.mu.Lock()
 	fmt.Println("🔷 AAR Core: Geometric self-awareness deactivated")
 	return nil
 }
 
 // continuousDynamics runs the continuous feedback loop
 func (aar *AARCore) continuousDynamics() {
-	ticker := time.NewTicker(100 * time.Millisecond)
+	ticker := time.NewTicker(aar.config.UpdateInterval)
 	defer ticker.Stop()
 	
 	for {
@@ -356,7 +371,8 @@ func (aar *AARCore) updateCoherence() {
 	balance := 1.0 - math.Abs(agentMag-arenaMag)
 	
 	// Smooth update
-	aar.relation.coherence = 0.9*aar.relation.coherence + 0.1*balance
+	smoothing := aar.config.Smoothing
+	aar.relation.coherence = smoothing*aar.relation.coherence + (1-smoothing)*balance
 	aar.coherence = aar.relation.coherence
 }
 
@@ -375,7 +391,8 @@ func (aar *AARCore) updateStability() {
 	stability := 1.0 / (1.0 + variance)
 	
 	// Smooth update
-	aar.relation.stability = 0.9*aar.relation.stability + 0.1*stability
+	smoothing := aar.config.Smoothing
+	aar.relation.stability = smoothing*aar.relation.stability + (1-smoothing)*stability
 	aar.stability = aar.relation.stability
 }
 
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/EchoCog/echollama/core/events"
 )
 
 // AutonomousWakeRestManager manages autonomous wake/rest cycles
@@ -25,11 +27,16 @@ type AutonomousWakeRestManager struct {
 	minRestDuration    time.Duration
 	maxRestDuration    time.Duration
 	
-	// Cognitive load tracking
+	// Cognitive load tracking. Fatigue accumulation and recovery run
+	// through the load manager's pluggable FatigueModel; fatigueLevel
+	// mirrors its reading for the decision thresholds.
 	cognitiveLoad      float64
 	fatigueLevel       float64
 	learningRate       float64
-	
+	loadManager        *CognitiveLoadManager
+	lastFatigueTick    time.Time
+	nowFunc            func() time.Time
+
 	// Decision thresholds
 	restThreshold      float64
 	wakeThreshold      float64
@@ -44,11 +51,45 @@ type AutonomousWakeRestManager struct {
 	totalWakeTime      time.Duration
 	totalRestTime      time.Duration
 	dreamCount         uint64
-	
+
+	// Wake-on-event
+	wakeEvents         chan WakeEvent
+	salienceThreshold  float64
+	wakeOnEventCount   uint64
+	lastWakeEvent      *WakeEvent
+
+	// Event sourcing (optional)
+	eventStore         *events.EventStore
+
 	// Running state
 	running            bool
 }
 
+// WakeEventType classifies what kind of stimulus is requesting a wake
+type WakeEventType int
+
+const (
+	// WakeEventExternalInput is high-salience sensory/discussion input
+	WakeEventExternalInput WakeEventType = iota
+	// WakeEventDeviceError is a hardware/device fault requiring attention
+	WakeEventDeviceError
+	// WakeEventExplicit is an explicit API request to wake
+	WakeEventExplicit
+)
+
+func (t WakeEventType) String() string {
+	return [...]string{"ExternalInput", "DeviceError", "Explicit"}[t]
+}
+
+// WakeEvent is a stimulus that may interrupt the rest cycle
+type WakeEvent struct {
+	Type      WakeEventType
+	Source    string
+	Salience  float64 // 0.0 - 1.0
+	Reason    string
+	Timestamp time.Time
+}
+
 // WakeRestState represents the current state
 type WakeRestState int
 
@@ -79,11 +120,38 @@ func NewAutonomousWakeRestManager() *AutonomousWakeRestManager {
 		cognitiveLoad:      0.0,
 		fatigueLevel:       0.0,
 		learningRate:       0.5,
+		loadManager:        NewCognitiveLoadManager("linear"),
+		lastFatigueTick:    time.Now(),
+		nowFunc:            time.Now,
 		restThreshold:      0.75,  // Rest when fatigue > 0.75
 		wakeThreshold:      0.25,  // Wake when fatigue < 0.25
+		wakeEvents:         make(chan WakeEvent, 16),
+		salienceThreshold:  0.7,   // External input below this sleeps through
 	}
 }
 
+// SetTimeSource replaces the wall clock driving state durations and
+// fatigue, letting simulations compress days into milliseconds. Call
+// it before Start; the state and fatigue baselines are reset to the
+// injected clock's current reading.
+func (m *AutonomousWakeRestManager) SetTimeSource(now func() time.Time) {
+	m.loadManager.SetTimeSource(now)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nowFunc = now
+	m.stateStartTime = now()
+	m.lastFatigueTick = now()
+}
+
+// Tick runs one wake/rest evaluation immediately. The internal loop
+// calls this once a minute; simulations drive it explicitly after each
+// virtual-clock advance instead of calling Start.
+func (m *AutonomousWakeRestManager) Tick() {
+	m.evaluateStateTransition()
+}
+
 // SetCallbacks sets the wake/rest/dream callbacks
 func (m *AutonomousWakeRestManager) SetCallbacks(
 	onWake, onRest, onDreamStart, onDreamEnd func() error,
@@ -144,15 +212,145 @@ func (m *AutonomousWakeRestManager) run() {
 			return
 		case <-ticker.C:
 			m.evaluateStateTransition()
+		case event := <-m.wakeEvents:
+			m.handleWakeEvent(event)
+		}
+	}
+}
+
+// NotifyEvent submits an external stimulus that may interrupt rest.
+// Events arriving while awake are absorbed silently; during rest or
+// dreaming they are evaluated against the salience threshold.
+func (m *AutonomousWakeRestManager) NotifyEvent(event WakeEvent) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = m.nowFunc()
+	}
+
+	select {
+	case m.wakeEvents <- event:
+	default:
+		// Queue full - drop the event rather than block callers
+	}
+}
+
+// ForceWake is the explicit API path: wake immediately regardless of salience
+func (m *AutonomousWakeRestManager) ForceWake(reason string) {
+	m.NotifyEvent(WakeEvent{
+		Type:     WakeEventExplicit,
+		Source:   "api",
+		Salience: 1.0,
+		Reason:   reason,
+	})
+}
+
+// SetSalienceThreshold adjusts how salient external input must be to wake the system
+func (m *AutonomousWakeRestManager) SetSalienceThreshold(threshold float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.salienceThreshold = threshold
+}
+
+// handleWakeEvent decides whether an event should interrupt the rest cycle
+func (m *AutonomousWakeRestManager) handleWakeEvent(event WakeEvent) {
+	m.mu.Lock()
+	state := m.currentState
+	threshold := m.salienceThreshold
+	m.lastWakeEvent = &event
+	m.mu.Unlock()
+
+	if state == StateAwake {
+		return
+	}
+
+	// Device errors and explicit requests always wake; external input
+	// must exceed the salience threshold to break through sleep
+	shouldWake := event.Type == WakeEventDeviceError || event.Type == WakeEventExplicit
+	if event.Type == WakeEventExternalInput && event.Salience >= threshold {
+		shouldWake = true
+	}
+
+	if !shouldWake {
+		return
+	}
+
+	fmt.Printf("\n⏰ Wake-on-event: %s from %s (salience %.2f): %s\n",
+		event.Type, event.Source, event.Salience, event.Reason)
+
+	m.interruptRest()
+}
+
+// interruptRest finishes a minimal consolidation pass and wakes early
+func (m *AutonomousWakeRestManager) interruptRest() {
+	m.mu.Lock()
+	state := m.currentState
+	m.mu.Unlock()
+
+	switch state {
+	case StateResting:
+		// Run an abbreviated dream so consolidation still happens
+		m.transitionToDream()
+		fmt.Println("   Running minimal consolidation pass before waking...")
+		m.transitionToWake()
+	case StateDreaming:
+		fmt.Println("   Finishing consolidation pass early...")
+		m.transitionToWake()
+	}
+
+	m.mu.Lock()
+	m.wakeOnEventCount++
+	m.mu.Unlock()
+}
+
+// SetFatigueModel swaps the fatigue model driving wake/rest decisions
+// (see NewFatigueModel for recognized names, e.g. from
+// CognitiveLoadConfig.FatigueModel)
+func (m *AutonomousWakeRestManager) SetFatigueModel(name string) {
+	m.loadManager.SetFatigueModel(NewFatigueModel(name))
+}
+
+// LoadManager exposes the cognitive load manager so callers can
+// register weighted load contributors
+func (m *AutonomousWakeRestManager) LoadManager() *CognitiveLoadManager {
+	return m.loadManager
+}
+
+// applyFatigue advances the fatigue model one tick: accumulation
+// under the current load while awake, recovery during rest and
+// dreaming. Contributors, when registered, override the manually
+// reported load.
+func (m *AutonomousWakeRestManager) applyFatigue() {
+	m.mu.Lock()
+	state := m.currentState
+	load := m.cognitiveLoad
+	now := m.nowFunc()
+	elapsed := now.Sub(m.lastFatigueTick)
+	m.lastFatigueTick = now
+	m.mu.Unlock()
+
+	if state == StateAwake {
+		if m.loadManager.HasContributors() {
+			load = m.loadManager.UpdateFromContributors()
+		} else {
+			m.loadManager.UpdateLoad(load)
 		}
+	} else {
+		m.loadManager.Recover(elapsed)
 	}
+
+	m.mu.Lock()
+	m.cognitiveLoad = load
+	m.fatigueLevel = m.loadManager.GetFatigueLevel()
+	m.mu.Unlock()
 }
 
 // evaluateStateTransition checks if state should change
 func (m *AutonomousWakeRestManager) evaluateStateTransition() {
+	m.applyFatigue()
+
 	m.mu.Lock()
 	currentState := m.currentState
-	stateTime := time.Since(m.stateStartTime)
+	stateTime := m.nowFunc().Sub(m.stateStartTime)
 	m.mu.Unlock()
 	
 	switch currentState {
@@ -232,6 +430,25 @@ func (m *AutonomousWakeRestManager) evaluateNeedForWake(dreamTime time.Duration)
 	}
 }
 
+// SetEventStore attaches an event store so every state transition is
+// recorded as an append-only event
+func (m *AutonomousWakeRestManager) SetEventStore(store *events.EventStore) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.eventStore = store
+}
+
+// emitEvent appends a state-change event if a store is attached
+func (m *AutonomousWakeRestManager) emitEvent(eventType string, data map[string]interface{}) {
+	m.mu.RLock()
+	store := m.eventStore
+	m.mu.RUnlock()
+
+	if store != nil {
+		store.Append(eventType, "wake_rest_manager", data)
+	}
+}
+
 // transitionToRest transitions to rest state
 func (m *AutonomousWakeRestManager) transitionToRest() {
 	m.mu.Lock()
@@ -240,13 +457,19 @@ func (m *AutonomousWakeRestManager) transitionToRest() {
 		return
 	}
 	
-	awakeTime := time.Since(m.stateStartTime)
+	awakeTime := m.nowFunc().Sub(m.stateStartTime)
 	m.totalWakeTime += awakeTime
-	
+
 	m.currentState = StateResting
-	m.stateStartTime = time.Now()
+	m.stateStartTime = m.nowFunc()
 	m.mu.Unlock()
 	
+	m.emitEvent("state.rest", map[string]interface{}{
+		"awake_duration": awakeTime.String(),
+		"fatigue":        m.fatigueLevel,
+		"cognitive_load": m.cognitiveLoad,
+	})
+
 	fmt.Printf("\n💤 Transitioning to REST (awake for %v)\n", awakeTime.Round(time.Second))
 	fmt.Printf("   Fatigue: %.2f | Cognitive Load: %.2f\n", m.fatigueLevel, m.cognitiveLoad)
 	
@@ -269,6 +492,10 @@ func (m *AutonomousWakeRestManager) transitionToDream() {
 	m.dreamCount++
 	m.mu.Unlock()
 	
+	m.emitEvent("state.dream", map[string]interface{}{
+		"dream_number": m.dreamCount,
+	})
+
 	fmt.Printf("\n🌙 Entering DREAM state (dream #%d)\n", m.dreamCount)
 	fmt.Println("   Consolidating knowledge and integrating experiences...")
 	
@@ -287,17 +514,25 @@ func (m *AutonomousWakeRestManager) transitionToWake() {
 		return
 	}
 	
-	restTime := time.Since(m.stateStartTime)
+	restTime := m.nowFunc().Sub(m.stateStartTime)
 	m.totalRestTime += restTime
-	
+
 	m.currentState = StateAwake
-	m.stateStartTime = time.Now()
+	m.stateStartTime = m.nowFunc()
 	m.cycleCount++
-	
-	// Reduce fatigue after rest
-	m.fatigueLevel *= 0.3
+	m.mu.Unlock()
+
+	// Credit the full rest period through the fatigue model
+	m.loadManager.Recover(restTime)
+	m.mu.Lock()
+	m.fatigueLevel = m.loadManager.GetFatigueLevel()
 	m.mu.Unlock()
 	
+	m.emitEvent("state.wake", map[string]interface{}{
+		"rest_duration": restTime.String(),
+		"cycle":         m.cycleCount,
+	})
+
 	fmt.Printf("\n☀️  AWAKENING (rested for %v, cycle #%d)\n", restTime.Round(time.Second), m.cycleCount)
 	fmt.Printf("   Fatigue: %.2f | Ready for new experiences\n", m.fatigueLevel)
 	
@@ -317,15 +552,18 @@ func (m *AutonomousWakeRestManager) transitionToWake() {
 // UpdateCognitiveLoad updates the current cognitive load
 func (m *AutonomousWakeRestManager) UpdateCognitiveLoad(load float64) {
 	m.mu.Lock()
-	defer m.mu.Unlock()
-	
 	m.cognitiveLoad = load
-	
-	// Increase fatigue based on cognitive load
-	if m.currentState == StateAwake {
-		fatigueIncrease := load * 0.01 * m.learningRate
-		m.fatigueLevel = min(1.0, m.fatigueLevel+fatigueIncrease)
+	state := m.currentState
+	m.mu.Unlock()
+
+	// Fatigue accumulates through the pluggable model
+	if state == StateAwake {
+		m.loadManager.UpdateLoad(load)
 	}
+
+	m.mu.Lock()
+	m.fatigueLevel = m.loadManager.GetFatigueLevel()
+	m.mu.Unlock()
 }
 
 // UpdateLearningRate updates the learning rate (affects fatigue accumulation)
@@ -351,13 +589,15 @@ func (m *AutonomousWakeRestManager) GetMetrics() map[string]interface{} {
 	
 	return map[string]interface{}{
 		"current_state":     m.currentState.String(),
-		"state_duration":    time.Since(m.stateStartTime).Round(time.Second).String(),
+		"state_duration":    m.nowFunc().Sub(m.stateStartTime).Round(time.Second).String(),
 		"cycle_count":       m.cycleCount,
 		"dream_count":       m.dreamCount,
+		"wake_event_count":  m.wakeOnEventCount,
 		"fatigue_level":     m.fatigueLevel,
 		"cognitive_load":    m.cognitiveLoad,
 		"total_wake_time":   m.totalWakeTime.Round(time.Second).String(),
 		"total_rest_time":   m.totalRestTime.Round(time.Second).String(),
+		"load_manager":      m.loadManager.GetMetrics(),
 	}
 }
 
@@ -0,0 +1,287 @@
+package deeptreeecho
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FatigueModel defines how fatigue accumulates under load and recovers
+// during rest. Different models let researchers experiment with different
+// sleep-pressure dynamics without touching the load manager itself.
+type FatigueModel interface {
+	// Name returns the model identifier used in configuration
+	Name() string
+
+	// Accumulate returns the new fatigue level after carrying the given
+	// cognitive load for the elapsed duration
+	Accumulate(fatigue, load float64, elapsed time.Duration) float64
+
+	// Recover returns the new fatigue level after resting for the
+	// elapsed duration
+	Recover(fatigue float64, elapsed time.Duration) float64
+}
+
+// LinearFatigueModel is the original fixed-rate model: fatigue rises and
+// falls at constant rates proportional to load
+type LinearFatigueModel struct {
+	FatigueRate  float64 // fatigue gained per minute at full load
+	RecoveryRate float64 // fatigue lost per minute of rest
+}
+
+// NewLinearFatigueModel creates the default linear model
+func NewLinearFatigueModel() *LinearFatigueModel {
+	return &LinearFatigueModel{
+		FatigueRate:  0.01,
+		RecoveryRate: 0.05,
+	}
+}
+
+func (m *LinearFatigueModel) Name() string { return "linear" }
+
+func (m *LinearFatigueModel) Accumulate(fatigue, load float64, elapsed time.Duration) float64 {
+	return clampFatigue(fatigue + load*m.FatigueRate*elapsed.Minutes())
+}
+
+func (m *LinearFatigueModel) Recover(fatigue float64, elapsed time.Duration) float64 {
+	return clampFatigue(fatigue - m.RecoveryRate*elapsed.Minutes())
+}
+
+// ExponentialSaturationFatigueModel approaches an asymptote instead of
+// rising linearly: fatigue builds quickly when fresh and saturates near
+// 1.0, while recovery decays exponentially toward zero
+type ExponentialSaturationFatigueModel struct {
+	RiseTimeConstant     time.Duration // time constant for build-up
+	RecoveryTimeConstant time.Duration // time constant for decay
+}
+
+// NewExponentialSaturationFatigueModel creates the saturation model with
+// physiologically-inspired defaults
+func NewExponentialSaturationFatigueModel() *ExponentialSaturationFatigueModel {
+	return &ExponentialSaturationFatigueModel{
+		RiseTimeConstant:     2 * time.Hour,
+		RecoveryTimeConstant: 20 * time.Minute,
+	}
+}
+
+func (m *ExponentialSaturationFatigueModel) Name() string { return "exponential" }
+
+func (m *ExponentialSaturationFatigueModel) Accumulate(fatigue, load float64, elapsed time.Duration) float64 {
+	// Asymptote scales with load: idling never drives fatigue to 1.0
+	asymptote := load
+	decay := math.Exp(-elapsed.Seconds() / m.RiseTimeConstant.Seconds())
+	return clampFatigue(asymptote + (fatigue-asymptote)*decay)
+}
+
+func (m *ExponentialSaturationFatigueModel) Recover(fatigue float64, elapsed time.Duration) float64 {
+	decay := math.Exp(-elapsed.Seconds() / m.RecoveryTimeConstant.Seconds())
+	return clampFatigue(fatigue * decay)
+}
+
+// TwoProcessFatigueModel implements the Borbély two-process model:
+// homeostatic sleep pressure (Process S) modulated by a circadian
+// rhythm (Process C). Effective fatigue is S plus the circadian term.
+type TwoProcessFatigueModel struct {
+	RiseTimeConstant     time.Duration // Process S build-up
+	RecoveryTimeConstant time.Duration // Process S decay during rest
+	CircadianAmplitude   float64       // contribution of Process C
+	CircadianPeriod      time.Duration // usually 24h
+	epoch                time.Time     // phase reference for Process C
+}
+
+// NewTwoProcessFatigueModel creates the two-process model with a 24h rhythm
+func NewTwoProcessFatigueModel() *TwoProcessFatigueModel {
+	return &TwoProcessFatigueModel{
+		RiseTimeConstant:     4 * time.Hour,
+		RecoveryTimeConstant: 30 * time.Minute,
+		CircadianAmplitude:   0.15,
+		CircadianPeriod:      24 * time.Hour,
+		epoch:                time.Now(),
+	}
+}
+
+func (m *TwoProcessFatigueModel) Name() string { return "two-process" }
+
+// circadian returns the Process C contribution in [-amplitude, +amplitude]
+func (m *TwoProcessFatigueModel) circadian() float64 {
+	phase := time.Since(m.epoch).Seconds() / m.CircadianPeriod.Seconds()
+	return m.CircadianAmplitude * math.Sin(2*math.Pi*phase)
+}
+
+func (m *TwoProcessFatigueModel) Accumulate(fatigue, load float64, elapsed time.Duration) float64 {
+	// Process S rises toward load-scaled asymptote
+	decay := math.Exp(-elapsed.Seconds() / m.RiseTimeConstant.Seconds())
+	s := load + (fatigue-load)*decay
+	return clampFatigue(s + m.circadian()*elapsed.Minutes()/60.0)
+}
+
+func (m *TwoProcessFatigueModel) Recover(fatigue float64, elapsed time.Duration) float64 {
+	decay := math.Exp(-elapsed.Seconds() / m.RecoveryTimeConstant.Seconds())
+	return clampFatigue(fatigue*decay + m.circadian()*elapsed.Minutes()/60.0)
+}
+
+// NewFatigueModel creates a fatigue model by configured name.
+// Unknown names fall back to the linear model.
+func NewFatigueModel(name string) FatigueModel {
+	switch strings.ToLower(name) {
+	case "exponential", "exponential-saturation":
+		return NewExponentialSaturationFatigueModel()
+	case "two-process", "twoprocess", "borbely":
+		return NewTwoProcessFatigueModel()
+	case "linear", "":
+		return NewLinearFatigueModel()
+	default:
+		fmt.Printf("⚠️  Unknown fatigue model %q, using linear\n", name)
+		return NewLinearFatigueModel()
+	}
+}
+
+func clampFatigue(f float64) float64 {
+	if f > 1.0 {
+		return 1.0
+	}
+	if f < 0.0 {
+		return 0.0
+	}
+	return f
+}
+
+// LoadSnapshot captures load at a moment in time
+type LoadSnapshot struct {
+	Timestamp time.Time
+	Load      float64
+	Fatigue   float64
+}
+
+// CognitiveLoadManager tracks cognitive load and drives fatigue through
+// a pluggable FatigueModel
+type CognitiveLoadManager struct {
+	mu           sync.RWMutex
+	currentLoad  float64
+	loadHistory  []LoadSnapshot
+	fatigueLevel float64
+	fatigueModel FatigueModel
+	maxLoad      float64
+	lastUpdate   time.Time
+	contributors *LoadContributorRegistry
+	nowFunc      func() time.Time
+}
+
+// NewCognitiveLoadManager creates a load manager using the named fatigue
+// model (see NewFatigueModel for recognized names)
+func NewCognitiveLoadManager(modelName string) *CognitiveLoadManager {
+	return &CognitiveLoadManager{
+		currentLoad:  0.0,
+		loadHistory:  make([]LoadSnapshot, 0),
+		fatigueLevel: 0.0,
+		fatigueModel: NewFatigueModel(modelName),
+		maxLoad:      1.0,
+		lastUpdate:   time.Now(),
+		contributors: NewLoadContributorRegistry(),
+		nowFunc:      time.Now,
+	}
+}
+
+// SetTimeSource replaces the wall clock, letting simulations drive
+// fatigue dynamics from a virtual clock. The elapsed-time baseline is
+// reset so the first update after injection sees no spurious gap.
+func (clm *CognitiveLoadManager) SetTimeSource(now func() time.Time) {
+	clm.mu.Lock()
+	defer clm.mu.Unlock()
+
+	clm.nowFunc = now
+	clm.lastUpdate = now()
+}
+
+// RegisterLoadContributor registers a weighted load source with the
+// manager's contributor registry
+func (clm *CognitiveLoadManager) RegisterLoadContributor(name string, weight float64, fn LoadContributorFunc) {
+	clm.contributors.Register(name, weight, fn)
+}
+
+// HasContributors reports whether any load contributors are registered
+func (clm *CognitiveLoadManager) HasContributors() bool {
+	return clm.contributors.Count() > 0
+}
+
+// UpdateFromContributors polls all registered contributors and records
+// the resulting weighted load
+func (clm *CognitiveLoadManager) UpdateFromContributors() float64 {
+	load := clm.contributors.CalculateLoad()
+	clm.UpdateLoad(load)
+	return load
+}
+
+// SetFatigueModel swaps the fatigue model at runtime
+func (clm *CognitiveLoadManager) SetFatigueModel(model FatigueModel) {
+	clm.mu.Lock()
+	defer clm.mu.Unlock()
+
+	clm.fatigueModel = model
+}
+
+// UpdateLoad records the current cognitive load and accumulates fatigue
+// through the active model
+func (clm *CognitiveLoadManager) UpdateLoad(newLoad float64) {
+	clm.mu.Lock()
+	defer clm.mu.Unlock()
+
+	now := clm.nowFunc()
+	elapsed := now.Sub(clm.lastUpdate)
+	clm.lastUpdate = now
+
+	clm.currentLoad = math.Min(newLoad, clm.maxLoad)
+	clm.fatigueLevel = clm.fatigueModel.Accumulate(clm.fatigueLevel, clm.currentLoad, elapsed)
+
+	clm.loadHistory = append(clm.loadHistory, LoadSnapshot{
+		Timestamp: now,
+		Load:      clm.currentLoad,
+		Fatigue:   clm.fatigueLevel,
+	})
+
+	// Keep only recent history (last 1000 snapshots)
+	if len(clm.loadHistory) > 1000 {
+		clm.loadHistory = clm.loadHistory[len(clm.loadHistory)-1000:]
+	}
+}
+
+// Recover applies rest-time recovery through the active model
+func (clm *CognitiveLoadManager) Recover(duration time.Duration) {
+	clm.mu.Lock()
+	defer clm.mu.Unlock()
+
+	clm.fatigueLevel = clm.fatigueModel.Recover(clm.fatigueLevel, duration)
+	clm.lastUpdate = clm.nowFunc()
+}
+
+// GetCurrentLoad returns the most recent cognitive load
+func (clm *CognitiveLoadManager) GetCurrentLoad() float64 {
+	clm.mu.RLock()
+	defer clm.mu.RUnlock()
+
+	return clm.currentLoad
+}
+
+// GetFatigueLevel returns the current fatigue level
+func (clm *CognitiveLoadManager) GetFatigueLevel() float64 {
+	clm.mu.RLock()
+	defer clm.mu.RUnlock()
+
+	return clm.fatigueLevel
+}
+
+// GetMetrics returns current load manager metrics
+func (clm *CognitiveLoadManager) GetMetrics() map[string]interface{} {
+	clm.mu.RLock()
+	defer clm.mu.RUnlock()
+
+	return map[string]interface{}{
+		"current_load":    clm.currentLoad,
+		"fatigue_level":   clm.fatigueLevel,
+		"fatigue_model":   clm.fatigueModel.Name(),
+		"history_samples": len(clm.loadHistory),
+		"load_breakdown":  clm.contributors.GetBreakdown(),
+	}
+}
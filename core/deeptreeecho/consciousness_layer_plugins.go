@@ -0,0 +1,220 @@
+package deeptreeecho
+
+import (
+	"fmt"
+	"time"
+)
+
+// ConsciousnessLayer is the pluggable interface for a consciousness
+// layer. Layers receive messages routed by the communication system
+// and may emit follow-on messages and emergent insights, so new layer
+// stacks can be assembled without editing core code.
+type ConsciousnessLayer interface {
+	// Name identifies the layer
+	Name() string
+
+	// ReceiveMessage handles one routed message and may return
+	// follow-on messages to route onward
+	ReceiveMessage(msg LayerMessage) []LayerMessage
+
+	// ProduceInsights drains any insights the layer has formed since
+	// the last call
+	ProduceInsights() []EmergentInsight
+}
+
+// LayerTopology controls how messages flow between registered layers
+type LayerTopology int
+
+const (
+	// TopologyHierarchy routes messages only between adjacent layers
+	// in registration order (strict bottom-up / top-down)
+	TopologyHierarchy LayerTopology = iota
+
+	// TopologyBlackboard broadcasts every message to every layer
+	// except its source
+	TopologyBlackboard
+)
+
+func (lt LayerTopology) String() string {
+	return [...]string{"Hierarchy", "Blackboard"}[lt]
+}
+
+// RegisterLayer appends a layer to the stack. Registration order
+// defines depth in hierarchy topology: index 0 is the bottom layer.
+func (clc *ConsciousnessLayerCommunication) RegisterLayer(layer ConsciousnessLayer) {
+	clc.mu.Lock()
+	defer clc.mu.Unlock()
+
+	clc.layerStack = append(clc.layerStack, layer)
+	fmt.Printf("🧠 Registered consciousness layer: %s (depth %d)\n", layer.Name(), len(clc.layerStack)-1)
+}
+
+// SetTopology switches the message routing topology
+func (clc *ConsciousnessLayerCommunication) SetTopology(topology LayerTopology) {
+	clc.mu.Lock()
+	defer clc.mu.Unlock()
+
+	clc.topology = topology
+	fmt.Printf("🧠 Layer topology: %s\n", topology)
+}
+
+// DispatchToLayers routes one message through the registered layer
+// stack according to the active topology, following any follow-on
+// messages the layers emit (bounded to avoid runaway cascades)
+func (clc *ConsciousnessLayerCommunication) DispatchToLayers(msg LayerMessage) {
+	pending := []LayerMessage{msg}
+
+	// Bound total deliveries per dispatch so a chatty layer cannot
+	// cascade forever
+	for hops := 0; len(pending) > 0 && hops < 32; hops++ {
+		next := pending[0]
+		pending = pending[1:]
+
+		for _, followOn := range clc.routeMessage(next) {
+			pending = append(pending, followOn...)
+		}
+	}
+}
+
+// routeMessage delivers one message to its recipients under the
+// current topology and returns the follow-on message batches
+func (clc *ConsciousnessLayerCommunication) routeMessage(msg LayerMessage) [][]LayerMessage {
+	clc.mu.RLock()
+	stack := make([]ConsciousnessLayer, len(clc.layerStack))
+	copy(stack, clc.layerStack)
+	topology := clc.topology
+	clc.mu.RUnlock()
+
+	if len(stack) == 0 {
+		return nil
+	}
+
+	clc.mu.Lock()
+	clc.totalMessages++
+	clc.mu.Unlock()
+
+	recipients := make([]ConsciousnessLayer, 0, len(stack))
+	switch topology {
+	case TopologyBlackboard:
+		for _, layer := range stack {
+			if layer.Name() != layerName(stack, msg.SourceLayer) {
+				recipients = append(recipients, layer)
+			}
+		}
+	default: // TopologyHierarchy
+		source := int(msg.SourceLayer)
+		target := int(msg.TargetLayer)
+		if target >= 0 && target < len(stack) && isAdjacent(source, target) {
+			recipients = append(recipients, stack[target])
+		}
+	}
+
+	followOns := make([][]LayerMessage, 0, len(recipients))
+	for _, layer := range recipients {
+		if responses := layer.ReceiveMessage(msg); len(responses) > 0 {
+			followOns = append(followOns, responses)
+		}
+	}
+
+	return followOns
+}
+
+// collectLayerInsights drains insights from every registered layer
+// into the shared emergent insight log
+func (clc *ConsciousnessLayerCommunication) collectLayerInsights() {
+	clc.mu.RLock()
+	stack := make([]ConsciousnessLayer, len(clc.layerStack))
+	copy(stack, clc.layerStack)
+	clc.mu.RUnlock()
+
+	for _, layer := range stack {
+		insights := layer.ProduceInsights()
+		if len(insights) == 0 {
+			continue
+		}
+
+		clc.mu.Lock()
+		clc.emergentInsights = append(clc.emergentInsights, insights...)
+		clc.totalInsights += uint64(len(insights))
+		clc.mu.Unlock()
+
+		for _, insight := range insights {
+			fmt.Printf("💡 Layer %s insight: %s (Significance: %.2f)\n",
+				layer.Name(), insight.Description, insight.Significance)
+		}
+	}
+}
+
+// layerName resolves a LayerType index to the registered layer's name
+func layerName(stack []ConsciousnessLayer, lt LayerType) string {
+	if idx := int(lt); idx >= 0 && idx < len(stack) {
+		return stack[idx].Name()
+	}
+	return ""
+}
+
+func isAdjacent(a, b int) bool {
+	return a-b == 1 || b-a == 1
+}
+
+// Adapters exposing the built-in layers through the pluggable
+// interface, so the default stack uses the same routing path as
+// externally registered layers.
+
+type basicLayerAdapter struct {
+	layer *BasicConsciousnessLayer
+}
+
+func (a *basicLayerAdapter) Name() string { return "basic" }
+
+func (a *basicLayerAdapter) ReceiveMessage(msg LayerMessage) []LayerMessage {
+	a.layer.mu.Lock()
+	defer a.layer.mu.Unlock()
+
+	// Top-down messages steer attention
+	a.layer.attentionFocus = fmt.Sprintf("%v", msg.Content)
+	return nil
+}
+
+func (a *basicLayerAdapter) ProduceInsights() []EmergentInsight { return nil }
+
+type reflectiveLayerAdapter struct {
+	layer *ReflectiveConsciousnessLayer
+}
+
+func (a *reflectiveLayerAdapter) Name() string { return "reflective" }
+
+func (a *reflectiveLayerAdapter) ReceiveMessage(msg LayerMessage) []LayerMessage {
+	a.layer.mu.Lock()
+	defer a.layer.mu.Unlock()
+
+	thought := LayerThought{
+		ID:        fmt.Sprintf("thought_%d", time.Now().UnixNano()),
+		Content:   fmt.Sprintf("Processing: %v", msg.Content),
+		Type:      msg.MessageType.String(),
+		Timestamp: time.Now(),
+		Depth:     1,
+	}
+	a.layer.currentThoughts = append(a.layer.currentThoughts, thought)
+	a.layer.thoughtCount++
+
+	return nil
+}
+
+func (a *reflectiveLayerAdapter) ProduceInsights() []EmergentInsight { return nil }
+
+type metaLayerAdapter struct {
+	layer *MetaCognitiveLayer
+}
+
+func (a *metaLayerAdapter) Name() string { return "meta" }
+
+func (a *metaLayerAdapter) ReceiveMessage(msg LayerMessage) []LayerMessage {
+	a.layer.mu.Lock()
+	defer a.layer.mu.Unlock()
+
+	a.layer.awarenessLevel = min(1.0, a.layer.awarenessLevel+0.01)
+	return nil
+}
+
+func (a *metaLayerAdapter) ProduceInsights() []EmergentInsight { return nil }
@@ -24,6 +24,10 @@ type ConsciousnessLayerCommunication struct {
 	topDownChannel    chan LayerMessage
 	lateralChannel    chan LayerMessage
 	
+	// Pluggable layer stack and routing topology
+	layerStack        []ConsciousnessLayer
+	topology          LayerTopology
+
 	// Emergent insights
 	emergentInsights  []EmergentInsight
 	
@@ -189,7 +193,7 @@ type EmergentInsight struct {
 func NewConsciousnessLayerCommunication() *ConsciousnessLayerCommunication {
 	ctx, cancel := context.WithCancel(context.Background())
 	
-	return &ConsciousnessLayerCommunication{
+	clc := &ConsciousnessLayerCommunication{
 		ctx:              ctx,
 		cancel:           cancel,
 		basicLayer:       newBasicLayer(),
@@ -199,7 +203,18 @@ func NewConsciousnessLayerCommunication() *ConsciousnessLayerCommunication {
 		topDownChannel:   make(chan LayerMessage, 100),
 		lateralChannel:   make(chan LayerMessage, 100),
 		emergentInsights: make([]EmergentInsight, 0),
+		topology:         TopologyHierarchy,
+	}
+
+	// Default stack mirrors the classic Basic → Reflective → Meta
+	// hierarchy through the pluggable routing path
+	clc.layerStack = []ConsciousnessLayer{
+		&basicLayerAdapter{layer: clc.basicLayer},
+		&reflectiveLayerAdapter{layer: clc.reflectiveLayer},
+		&metaLayerAdapter{layer: clc.metaLayer},
 	}
+
+	return clc
 }
 
 func newBasicLayer() *BasicConsciousnessLayer {
@@ -310,6 +325,7 @@ func (clc *ConsciousnessLayerCommunication) runInsightIntegration() {
 			return
 		case <-ticker.C:
 			clc.detectEmergentInsights()
+			clc.collectLayerInsights()
 		}
 	}
 }
@@ -510,5 +526,7 @@ func (clc *ConsciousnessLayerCommunication) GetMetrics() map[string]interface{}
 		"reflective_thoughts": clc.reflectiveLayer.thoughtCount,
 		"meta_awareness":      clc.metaLayer.awarenessLevel,
 		"emergent_insights":   len(clc.emergentInsights),
+		"registered_layers":   len(clc.layerStack),
+		"topology":            clc.topology.String(),
 	}
 }
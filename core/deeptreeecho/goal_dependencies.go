@@ -0,0 +1,209 @@
+package deeptreeecho
+
+import (
+	"fmt"
+)
+
+// AddGoalDependency declares that goalID is blocked until dependsOnID
+// completes. The edge is rejected if either goal is unknown or if it
+// would introduce a cycle in the dependency graph.
+func (go_orch *GoalOrchestrator) AddGoalDependency(goalID, dependsOnID string) error {
+	go_orch.mu.Lock()
+	defer go_orch.mu.Unlock()
+
+	goal, exists := go_orch.activeGoals[goalID]
+	if !exists {
+		return fmt.Errorf("goal not found: %s", goalID)
+	}
+	if _, exists := go_orch.activeGoals[dependsOnID]; !exists {
+		return fmt.Errorf("dependency goal not found: %s", dependsOnID)
+	}
+	if goalID == dependsOnID {
+		return fmt.Errorf("goal cannot depend on itself: %s", goalID)
+	}
+
+	for _, dep := range goal.DependsOn {
+		if dep == dependsOnID {
+			return nil // already recorded
+		}
+	}
+
+	// Reject the edge if dependsOnID is already (transitively) blocked
+	// by goalID — adding it would close a cycle
+	if go_orch.dependsTransitively(dependsOnID, goalID) {
+		return fmt.Errorf("dependency %s -> %s would create a cycle", goalID, dependsOnID)
+	}
+
+	goal.DependsOn = append(goal.DependsOn, dependsOnID)
+	fmt.Printf("🔗 Goal dependency added: %s blocked by %s\n", goalID, dependsOnID)
+
+	return nil
+}
+
+// dependsTransitively reports whether fromID depends (directly or
+// transitively) on targetID. Caller must hold the lock.
+func (go_orch *GoalOrchestrator) dependsTransitively(fromID, targetID string) bool {
+	visited := make(map[string]bool)
+	stack := []string{fromID}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current == targetID {
+			return true
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		if goal, exists := go_orch.activeGoals[current]; exists {
+			stack = append(stack, goal.DependsOn...)
+		}
+	}
+
+	return false
+}
+
+// isGoalBlocked reports whether any of a goal's dependencies are still
+// active (i.e. not yet completed). Caller must hold the lock.
+func (go_orch *GoalOrchestrator) isGoalBlocked(goal *OrchGoal) bool {
+	for _, depID := range goal.DependsOn {
+		if _, stillActive := go_orch.activeGoals[depID]; stillActive {
+			return true
+		}
+	}
+	return false
+}
+
+// GetReadyGoals returns active goals whose dependencies have all
+// completed, in topological order so upstream goals come first.
+func (go_orch *GoalOrchestrator) GetReadyGoals() []*OrchGoal {
+	go_orch.mu.RLock()
+	defer go_orch.mu.RUnlock()
+
+	ready := make([]*OrchGoal, 0)
+	for _, id := range go_orch.topologicalOrder() {
+		goal := go_orch.activeGoals[id]
+		if !go_orch.isGoalBlocked(goal) {
+			ready = append(ready, goal)
+		}
+	}
+
+	return ready
+}
+
+// topologicalOrder returns active goal IDs sorted so that every goal
+// appears after its dependencies (Kahn's algorithm). Goals caught in a
+// cycle — which AddGoalDependency should prevent — are appended last so
+// nothing is silently dropped. Caller must hold the lock.
+func (go_orch *GoalOrchestrator) topologicalOrder() []string {
+	inDegree := make(map[string]int, len(go_orch.activeGoals))
+	dependents := make(map[string][]string)
+
+	for id, goal := range go_orch.activeGoals {
+		if _, seen := inDegree[id]; !seen {
+			inDegree[id] = 0
+		}
+		for _, depID := range goal.DependsOn {
+			if _, stillActive := go_orch.activeGoals[depID]; !stillActive {
+				continue // completed dependencies no longer constrain order
+			}
+			inDegree[id]++
+			dependents[depID] = append(dependents[depID], id)
+		}
+	}
+
+	queue := make([]string, 0, len(inDegree))
+	for id, degree := range inDegree {
+		if degree == 0 {
+			queue = append(queue, id)
+		}
+	}
+
+	order := make([]string, 0, len(inDegree))
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		order = append(order, current)
+
+		for _, next := range dependents[current] {
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	// Anything left over is part of a cycle; keep it visible
+	if len(order) < len(go_orch.activeGoals) {
+		ordered := make(map[string]bool, len(order))
+		for _, id := range order {
+			ordered[id] = true
+		}
+		for id := range go_orch.activeGoals {
+			if !ordered[id] {
+				order = append(order, id)
+			}
+		}
+	}
+
+	return order
+}
+
+// criticalPath finds the longest dependency chain through the active
+// goals, weighting each goal by its remaining work (1 - Progress).
+// Caller must hold the lock.
+func (go_orch *GoalOrchestrator) criticalPath() ([]string, float64) {
+	order := go_orch.topologicalOrder()
+
+	cost := make(map[string]float64, len(order))
+	prev := make(map[string]string, len(order))
+
+	var endID string
+	var maxCost float64
+
+	for _, id := range order {
+		goal := go_orch.activeGoals[id]
+		remaining := 1.0 - goal.Progress
+
+		best := 0.0
+		for _, depID := range goal.DependsOn {
+			if depCost, stillActive := cost[depID]; stillActive && depCost > best {
+				best = depCost
+				prev[id] = depID
+			}
+		}
+
+		cost[id] = best + remaining
+		if cost[id] > maxCost {
+			maxCost = cost[id]
+			endID = id
+		}
+	}
+
+	if endID == "" {
+		return nil, 0
+	}
+
+	// Walk the chain back to its start, then reverse
+	path := make([]string, 0)
+	for id := endID; id != ""; id = prev[id] {
+		path = append(path, id)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, maxCost
+}
+
+// GetCriticalPath returns the current critical path through the goal
+// dependency graph and its total remaining work
+func (go_orch *GoalOrchestrator) GetCriticalPath() ([]string, float64) {
+	go_orch.mu.RLock()
+	defer go_orch.mu.RUnlock()
+
+	return go_orch.criticalPath()
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 	
+	"github.com/EchoCog/echollama/core/events"
 	"github.com/EchoCog/echollama/core/llm"
 )
 
@@ -35,7 +36,14 @@ type GoalOrchestrator struct {
 	// Metrics
 	totalGoalsGenerated uint64
 	totalGoalsCompleted uint64
-	
+
+	// At-risk deadline notifications
+	onAtRisk        func(GoalAtRiskEvent)
+	atRiskCount     uint64
+
+	// Event sourcing (optional)
+	eventStore      *events.EventStore
+
 	// Running state
 	running         bool
 }
@@ -54,6 +62,14 @@ type OrchGoal struct {
 	// Goal decomposition
 	ParentGoalID    string
 	SubGoals        []string
+
+	// Dependencies: IDs of goals that must complete before this one
+	DependsOn       []string
+
+	// Scheduling
+	Deadline        *time.Time
+	Urgency         float64      // 0.0-1.0, recomputed each cycle
+	flaggedAtRisk   bool
 	
 	// Pursuit strategy
 	Strategy        PursuitStrategy
@@ -228,12 +244,24 @@ Generate the goals now:`, identity, values, domains)
 	goals := go_orch.parseGoalsFromLLMResponse(response)
 	
 	go_orch.mu.Lock()
+	store := go_orch.eventStore
 	for _, goal := range goals {
 		go_orch.activeGoals[goal.ID] = goal
 		go_orch.totalGoalsGenerated++
 	}
 	go_orch.lastGeneration = time.Now()
 	go_orch.mu.Unlock()
+
+	if store != nil {
+		for _, goal := range goals {
+			store.Append("goal.generated", "goal_orchestrator", map[string]interface{}{
+				"goal_id":     goal.ID,
+				"description": goal.Description,
+				"type":        goal.Type.String(),
+				"priority":    goal.Priority,
+			})
+		}
+	}
 	
 	fmt.Printf("🎯 Generated %d new goals from identity\n", len(goals))
 	for _, goal := range goals {
@@ -258,6 +286,7 @@ func (go_orch *GoalOrchestrator) parseGoalsFromLLMResponse(response string) []*O
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 		SubGoals:    make([]string, 0),
+		DependsOn:   make([]string, 0),
 		Strategy: PursuitStrategy{
 			Approach: "incremental",
 			Steps:    []string{"Analyze", "Plan", "Execute", "Reflect"},
@@ -275,9 +304,15 @@ func (go_orch *GoalOrchestrator) parseGoalsFromLLMResponse(response string) []*O
 // evaluateAndAdjustGoals reviews active goals and adjusts priorities
 func (go_orch *GoalOrchestrator) evaluateAndAdjustGoals() {
 	go_orch.mu.Lock()
-	defer go_orch.mu.Unlock()
-	
+
+	atRiskEvents := go_orch.reprioritizeGoals()
+
 	for id, goal := range go_orch.activeGoals {
+		// Blocked goals wait for their dependencies
+		if go_orch.isGoalBlocked(goal) {
+			continue
+		}
+
 		// Update progress based on time spent
 		if goal.Progress < 1.0 {
 			goal.Progress += 0.05 // Simulated progress
@@ -291,10 +326,30 @@ func (go_orch *GoalOrchestrator) evaluateAndAdjustGoals() {
 			go_orch.completedGoals = append(go_orch.completedGoals, goal)
 			delete(go_orch.activeGoals, id)
 			go_orch.totalGoalsCompleted++
-			
+
+			if go_orch.eventStore != nil {
+				go_orch.eventStore.Append("goal.completed", "goal_orchestrator", map[string]interface{}{
+					"goal_id":     goal.ID,
+					"description": goal.Description,
+				})
+			}
+
 			fmt.Printf("✅ Goal completed: %s\n", goal.Description)
 		}
 	}
+
+	go_orch.atRiskCount += uint64(len(atRiskEvents))
+	handler := go_orch.onAtRisk
+	go_orch.mu.Unlock()
+
+	// Fire at-risk notifications outside the lock
+	for _, event := range atRiskEvents {
+		fmt.Printf("⚠️  Goal at risk: %s (urgency %.2f, due %s)\n",
+			event.Description, event.Urgency, event.Deadline.Format(time.RFC3339))
+		if handler != nil {
+			handler(event)
+		}
+	}
 }
 
 // DecomposeGoal breaks down a complex goal into sub-goals
@@ -359,6 +414,14 @@ List the sub-goals:`, goal.Description, goal.Type.String())
 	return nil
 }
 
+// SetEventStore attaches an event store so goal lifecycle changes are
+// recorded as append-only events
+func (go_orch *GoalOrchestrator) SetEventStore(store *events.EventStore) {
+	go_orch.mu.Lock()
+	defer go_orch.mu.Unlock()
+	go_orch.eventStore = store
+}
+
 // GetActiveGoals returns all active goals
 func (go_orch *GoalOrchestrator) GetActiveGoals() []*OrchGoal {
 	go_orch.mu.RLock()
@@ -376,9 +439,22 @@ func (go_orch *GoalOrchestrator) GetActiveGoals() []*OrchGoal {
 func (go_orch *GoalOrchestrator) GetMetrics() map[string]interface{} {
 	go_orch.mu.RLock()
 	defer go_orch.mu.RUnlock()
-	
+
+	blocked := 0
+	for _, goal := range go_orch.activeGoals {
+		if go_orch.isGoalBlocked(goal) {
+			blocked++
+		}
+	}
+	criticalPath, criticalWork := go_orch.criticalPath()
+
 	return map[string]interface{}{
 		"active_goals":      len(go_orch.activeGoals),
+		"blocked_goals":     blocked,
+		"critical_path":     criticalPath,
+		"critical_path_work": criticalWork,
+		"aggregate_urgency": go_orch.aggregateUrgencyLocked(),
+		"at_risk_events":    go_orch.atRiskCount,
 		"completed_goals":   len(go_orch.completedGoals),
 		"suspended_goals":   len(go_orch.suspendedGoals),
 		"total_generated":   go_orch.totalGoalsGenerated,
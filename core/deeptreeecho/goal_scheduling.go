@@ -0,0 +1,174 @@
+package deeptreeecho
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// GoalAtRiskEvent describes a goal unlikely to complete by its deadline
+type GoalAtRiskEvent struct {
+	GoalID      string
+	Description string
+	Deadline    time.Time
+	Progress    float64
+	Urgency     float64
+	DetectedAt  time.Time
+}
+
+// SetGoalDeadline attaches a deadline to an active goal
+func (go_orch *GoalOrchestrator) SetGoalDeadline(goalID string, deadline time.Time) error {
+	go_orch.mu.Lock()
+	defer go_orch.mu.Unlock()
+
+	goal, exists := go_orch.activeGoals[goalID]
+	if !exists {
+		return fmt.Errorf("goal not found: %s", goalID)
+	}
+
+	goal.Deadline = &deadline
+	goal.UpdatedAt = time.Now()
+	fmt.Printf("⏰ Goal deadline set: %s due %s\n", goalID, deadline.Format(time.RFC3339))
+
+	return nil
+}
+
+// SetAtRiskHandler registers a callback invoked whenever a goal is
+// newly flagged as at risk of missing its deadline
+func (go_orch *GoalOrchestrator) SetAtRiskHandler(handler func(GoalAtRiskEvent)) {
+	go_orch.mu.Lock()
+	defer go_orch.mu.Unlock()
+
+	go_orch.onAtRisk = handler
+}
+
+// AttachLoadManager feeds aggregate goal urgency into the cognitive
+// load manager as a weighted contributor
+func (go_orch *GoalOrchestrator) AttachLoadManager(clm *CognitiveLoadManager) {
+	clm.RegisterLoadContributor("goal_urgency", 0.3, func() float64 {
+		return go_orch.GetAggregateUrgency()
+	})
+}
+
+// goalUrgency computes how urgent a goal is right now. Goals without a
+// deadline carry a small baseline urgency from their priority; goals
+// with a deadline grow more urgent as remaining time shrinks relative
+// to remaining work. Caller must hold the lock.
+func goalUrgency(goal *OrchGoal, now time.Time) float64 {
+	base := 0.2 * goal.Priority
+
+	if goal.Deadline == nil {
+		return base
+	}
+
+	remaining := goal.Deadline.Sub(now)
+	if remaining <= 0 {
+		return 1.0 // overdue
+	}
+
+	// Expected time to finish the remaining work, assuming progress so
+	// far is representative of the goal's pace
+	elapsed := now.Sub(goal.CreatedAt)
+	remainingWork := 1.0 - goal.Progress
+
+	var pressure float64
+	if goal.Progress > 0 && elapsed > 0 {
+		expectedFinish := time.Duration(float64(elapsed) / goal.Progress * remainingWork)
+		pressure = float64(expectedFinish) / float64(remaining)
+	} else {
+		// No progress yet: urgency driven purely by deadline proximity
+		pressure = float64(elapsed) / float64(elapsed+remaining)
+	}
+
+	urgency := base + pressure*0.8
+	if urgency > 1.0 {
+		urgency = 1.0
+	}
+	return urgency
+}
+
+// reprioritizeGoals recomputes urgency for every active goal, decays
+// stale urgency, reorders effective priorities, and emits at-risk
+// events. Caller must hold the lock; events fire after release.
+func (go_orch *GoalOrchestrator) reprioritizeGoals() []GoalAtRiskEvent {
+	now := time.Now()
+	events := make([]GoalAtRiskEvent, 0)
+
+	for id, goal := range go_orch.activeGoals {
+		previous := goal.Urgency
+		computed := goalUrgency(goal, now)
+
+		// Urgency decays toward the computed value rather than
+		// jumping, so momentary spikes fade between cycles
+		goal.Urgency = previous*0.5 + computed*0.5
+
+		atRisk := goal.Deadline != nil && goal.Urgency >= 0.8 && goal.Progress < 1.0
+		if atRisk && !goal.flaggedAtRisk {
+			goal.flaggedAtRisk = true
+			events = append(events, GoalAtRiskEvent{
+				GoalID:      id,
+				Description: goal.Description,
+				Deadline:    *goal.Deadline,
+				Progress:    goal.Progress,
+				Urgency:     goal.Urgency,
+				DetectedAt:  now,
+			})
+		} else if !atRisk {
+			goal.flaggedAtRisk = false
+		}
+	}
+
+	return events
+}
+
+// GetGoalsByPriority returns active goals ordered by effective
+// priority: declared priority blended with current urgency
+func (go_orch *GoalOrchestrator) GetGoalsByPriority() []*OrchGoal {
+	go_orch.mu.RLock()
+	defer go_orch.mu.RUnlock()
+
+	goals := make([]*OrchGoal, 0, len(go_orch.activeGoals))
+	for _, goal := range go_orch.activeGoals {
+		goals = append(goals, goal)
+	}
+
+	sort.Slice(goals, func(i, j int) bool {
+		return effectivePriority(goals[i]) > effectivePriority(goals[j])
+	})
+
+	return goals
+}
+
+// effectivePriority blends declared priority with current urgency
+func effectivePriority(goal *OrchGoal) float64 {
+	return 0.6*goal.Priority + 0.4*goal.Urgency
+}
+
+// GetAggregateUrgency returns the highest urgency across active goals,
+// softened by the average — one overdue goal dominates, but a wall of
+// moderately urgent goals also registers
+func (go_orch *GoalOrchestrator) GetAggregateUrgency() float64 {
+	go_orch.mu.RLock()
+	defer go_orch.mu.RUnlock()
+
+	return go_orch.aggregateUrgencyLocked()
+}
+
+// aggregateUrgencyLocked is GetAggregateUrgency without locking, for
+// callers that already hold the lock
+func (go_orch *GoalOrchestrator) aggregateUrgencyLocked() float64 {
+	if len(go_orch.activeGoals) == 0 {
+		return 0
+	}
+
+	var maxUrgency, sum float64
+	for _, goal := range go_orch.activeGoals {
+		if goal.Urgency > maxUrgency {
+			maxUrgency = goal.Urgency
+		}
+		sum += goal.Urgency
+	}
+	avg := sum / float64(len(go_orch.activeGoals))
+
+	return 0.7*maxUrgency + 0.3*avg
+}
@@ -0,0 +1,153 @@
+package deeptreeecho
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/EchoCog/echollama/core/memory"
+)
+
+// allNodeTypes enumerates the node types the gap miner sweeps
+var allNodeTypes = []memory.NodeType{
+	memory.NodeConcept,
+	memory.NodeEvent,
+	memory.NodeSkill,
+	memory.NodeGoal,
+	memory.NodePattern,
+	memory.NodeThought,
+	memory.NodeExperience,
+}
+
+// AttachHypergraph connects the learning system to a hypergraph memory
+// so knowledge gaps can be mined from the graph structure itself
+// rather than relying solely on LLM self-reflection
+func (sdl *SelfDirectedLearningSystem) AttachHypergraph(hypergraph *memory.HypergraphMemory) {
+	sdl.mu.Lock()
+	defer sdl.mu.Unlock()
+
+	sdl.hypergraph = hypergraph
+	fmt.Println("📚 Learning system attached to hypergraph memory")
+}
+
+// MineKnowledgeGaps sweeps the hypergraph for structural signs of
+// missing knowledge — dangling nodes, weakly connected concepts, and
+// unanswered questions — and records each as a knowledge gap. Returns
+// the gaps newly created this sweep.
+func (sdl *SelfDirectedLearningSystem) MineKnowledgeGaps() []*KnowledgeGap {
+	sdl.mu.RLock()
+	hypergraph := sdl.hypergraph
+	sdl.mu.RUnlock()
+
+	if hypergraph == nil {
+		return nil
+	}
+
+	newGaps := make([]*KnowledgeGap, 0)
+
+	for _, nodeType := range allNodeTypes {
+		for _, node := range hypergraph.GetNodesByType(nodeType) {
+			if gap := sdl.assessNode(hypergraph, node); gap != nil {
+				if sdl.recordMinedGap(gap) {
+					newGaps = append(newGaps, gap)
+				}
+			}
+		}
+	}
+
+	if len(newGaps) > 0 {
+		fmt.Printf("📚 Mined %d knowledge gap(s) from hypergraph\n", len(newGaps))
+	}
+
+	return newGaps
+}
+
+// assessNode inspects one node's connectivity and content and returns
+// a knowledge gap if it signals missing knowledge, or nil otherwise
+func (sdl *SelfDirectedLearningSystem) assessNode(hypergraph *memory.HypergraphMemory, node *memory.MemoryNode) *KnowledgeGap {
+	outgoing := hypergraph.GetOutgoingEdges(node.ID)
+	incoming := hypergraph.GetIncomingEdges(node.ID)
+	degree := len(outgoing) + len(incoming)
+
+	// Unanswered question: a thought phrased as a question with no
+	// outgoing edges leading anywhere
+	if node.Type == memory.NodeThought && strings.Contains(node.Content, "?") && len(outgoing) == 0 {
+		return &KnowledgeGap{
+			ID:           fmt.Sprintf("gap_question_%s", node.ID),
+			Domain:       string(node.Type),
+			Description:  fmt.Sprintf("Unanswered question: %s", truncateGapText(node.Content, 120)),
+			Severity:     0.4 + 0.4*node.Importance,
+			IdentifiedAt: time.Now(),
+			AddressedBy:  make([]string, 0),
+			Status:       GapStatusIdentified,
+		}
+	}
+
+	// Dangling node: important but completely disconnected
+	if degree == 0 && node.Importance >= 0.5 {
+		return &KnowledgeGap{
+			ID:           fmt.Sprintf("gap_dangling_%s", node.ID),
+			Domain:       string(node.Type),
+			Description:  fmt.Sprintf("Isolated %s with no connections: %s", node.Type, truncateGapText(node.Content, 120)),
+			Severity:     0.3 + 0.5*node.Importance,
+			IdentifiedAt: time.Now(),
+			AddressedBy:  make([]string, 0),
+			Status:       GapStatusIdentified,
+		}
+	}
+
+	// Weak region: connected only by low-confidence edges
+	if degree > 0 && maxEdgeWeight(outgoing, incoming) < 0.3 && node.Importance >= 0.5 {
+		return &KnowledgeGap{
+			ID:           fmt.Sprintf("gap_weak_%s", node.ID),
+			Domain:       string(node.Type),
+			Description:  fmt.Sprintf("Weakly understood %s (only low-confidence connections): %s", node.Type, truncateGapText(node.Content, 120)),
+			Severity:     0.3 + 0.3*node.Importance,
+			IdentifiedAt: time.Now(),
+			AddressedBy:  make([]string, 0),
+			Status:       GapStatusIdentified,
+		}
+	}
+
+	return nil
+}
+
+// recordMinedGap stores a mined gap if it has not been seen before.
+// Returns true when the gap is new.
+func (sdl *SelfDirectedLearningSystem) recordMinedGap(gap *KnowledgeGap) bool {
+	sdl.mu.Lock()
+	defer sdl.mu.Unlock()
+
+	if _, exists := sdl.knowledgeGaps[gap.ID]; exists {
+		return false
+	}
+
+	sdl.knowledgeGaps[gap.ID] = gap
+	sdl.totalGapsIdentified++
+	sdl.totalGapsMined++
+
+	return true
+}
+
+// maxEdgeWeight returns the strongest weight among a node's edges
+func maxEdgeWeight(outgoing, incoming []*memory.MemoryEdge) float64 {
+	best := 0.0
+	for _, edge := range outgoing {
+		if edge.Weight > best {
+			best = edge.Weight
+		}
+	}
+	for _, edge := range incoming {
+		if edge.Weight > best {
+			best = edge.Weight
+		}
+	}
+	return best
+}
+
+func truncateGapText(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max] + "..."
+}
@@ -0,0 +1,104 @@
+package deeptreeecho
+
+import (
+	"math"
+	"sync"
+)
+
+// LoadContributorFunc reports a subsystem's current load contribution in [0, 1]
+type LoadContributorFunc func() float64
+
+// LoadContributor is a registered source of cognitive load
+type LoadContributor struct {
+	Name   string
+	Weight float64
+	fn     LoadContributorFunc
+}
+
+// LoadContributorRegistry lets subsystems (NPU queue depth, active goals,
+// discussion count, introspection scans, ...) register weighted load
+// contributor functions instead of hard-coding load sources
+type LoadContributorRegistry struct {
+	mu           sync.RWMutex
+	contributors []*LoadContributor
+	lastReadings map[string]float64
+}
+
+// NewLoadContributorRegistry creates an empty registry
+func NewLoadContributorRegistry() *LoadContributorRegistry {
+	return &LoadContributorRegistry{
+		contributors: make([]*LoadContributor, 0),
+		lastReadings: make(map[string]float64),
+	}
+}
+
+// Register adds a load contributor. Registering the same name again
+// replaces the previous contributor.
+func (r *LoadContributorRegistry) Register(name string, weight float64, fn LoadContributorFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range r.contributors {
+		if c.Name == name {
+			c.Weight = weight
+			c.fn = fn
+			return
+		}
+	}
+
+	r.contributors = append(r.contributors, &LoadContributor{
+		Name:   name,
+		Weight: weight,
+		fn:     fn,
+	})
+}
+
+// Count returns the number of registered contributors
+func (r *LoadContributorRegistry) Count() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.contributors)
+}
+
+// Unregister removes a contributor by name
+func (r *LoadContributorRegistry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, c := range r.contributors {
+		if c.Name == name {
+			r.contributors = append(r.contributors[:i], r.contributors[i+1:]...)
+			delete(r.lastReadings, name)
+			return
+		}
+	}
+}
+
+// CalculateLoad polls every contributor and returns the weighted total
+// load, clamped to [0, 1]. Per-contributor readings are retained for
+// the metrics breakdown.
+func (r *LoadContributorRegistry) CalculateLoad() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	total := 0.0
+	for _, c := range r.contributors {
+		reading := math.Max(0.0, math.Min(1.0, c.fn()))
+		r.lastReadings[c.Name] = reading
+		total += reading * c.Weight
+	}
+
+	return math.Min(total, 1.0)
+}
+
+// GetBreakdown returns the last per-contributor readings (unweighted)
+func (r *LoadContributorRegistry) GetBreakdown() map[string]float64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	breakdown := make(map[string]float64, len(r.lastReadings))
+	for name, reading := range r.lastReadings {
+		breakdown[name] = reading
+	}
+	return breakdown
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 	
 	"github.com/EchoCog/echollama/core/llm"
+	"github.com/EchoCog/echollama/core/memory"
 )
 
 // SelfDirectedLearningSystem manages autonomous learning and skill development
@@ -34,8 +35,12 @@ type SelfDirectedLearningSystem struct {
 	// Practice sessions
 	practiceSessions []*LearningPracticeSession
 	
+	// Hypergraph memory for structural gap mining (optional)
+	hypergraph      *memory.HypergraphMemory
+
 	// Metrics
 	totalGapsIdentified  uint64
+	totalGapsMined       uint64
 	totalGoalsGenerated  uint64
 	totalPracticeSessions uint64
 	totalSkillsAcquired  uint64
@@ -204,7 +209,10 @@ func (sdl *SelfDirectedLearningSystem) run() {
 			if err := sdl.identifyKnowledgeGaps(); err != nil {
 				fmt.Printf("⚠️  Gap analysis error: %v\n", err)
 			}
-			
+
+			// Mine structural gaps from the hypergraph
+			sdl.MineKnowledgeGaps()
+
 			// Generate learning goals for gaps
 			sdl.generateLearningGoals()
 			
@@ -410,6 +418,7 @@ func (sdl *SelfDirectedLearningSystem) GetMetrics() map[string]interface{} {
 		"skills_in_progress":    len(sdl.skillsInProgress),
 		"practice_sessions":     len(sdl.practiceSessions),
 		"total_gaps_identified": sdl.totalGapsIdentified,
+		"total_gaps_mined":      sdl.totalGapsMined,
 		"total_goals_generated": sdl.totalGoalsGenerated,
 		"total_practice":        sdl.totalPracticeSessions,
 		"total_skills_acquired": sdl.totalSkillsAcquired,
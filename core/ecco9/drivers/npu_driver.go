@@ -197,7 +197,17 @@ func (nd *NPUDriver) GetCapabilities() []string {
 func (nd *NPUDriver) AssessEntelechy() *entelechy.EntelechyGenome {
 	nd.mu.Lock()
 	defer nd.mu.Unlock()
-	
+
+	// Feed the evolutionary dimension from the source scan when one
+	// has been taken (see npu_introspection.go)
+	if report := currentImplementationReport(); report != nil {
+		nd.dimensions.Evolutionary.ImplementationDepth = report.ImplementationDepth
+		nd.dimensions.Evolutionary.CodeHealth = 1.0 - float64(report.TODOCount+report.FIXMECount*2)*0.01
+		if nd.dimensions.Evolutionary.CodeHealth < 0 {
+			nd.dimensions.Evolutionary.CodeHealth = 0
+		}
+	}
+
 	// Assess all dimensions
 	nd.dimensions.Ontological.Assess()
 	nd.dimensions.Teleological.Assess()
@@ -224,17 +224,23 @@ func (d *NPUDevice) assessIntegrativeDimension() IntegrativeHealth {
 // assessEvolutionaryDimension evaluates growth capacity
 func (d *NPUDevice) assessEvolutionaryDimension() EvolutionaryPotential {
 	potential := EvolutionaryPotential{}
-	
-	// Count TODOs and FIXMEs in implementation
-	// For now, estimate based on known stubs
-	potential.TODOCount = 8 // Estimated remaining tasks
-	potential.FIXMECount = 2 // Estimated issues
-	
-	// Implementation depth (how much is real vs stubbed)
-	// Currently ~60% implemented (registers, telemetry, entelechy work)
-	// ~40% stubbed (actual GGUF inference, token streaming, GPU offload)
-	potential.ImplementationDepth = 0.6
-	
+
+	if report := currentImplementationReport(); report != nil {
+		// Real counts from the source scan (see npu_introspection.go)
+		potential.TODOCount = report.TODOCount
+		potential.FIXMECount = report.FIXMECount
+		potential.ImplementationDepth = report.ImplementationDepth
+	} else {
+		// No scan available - fall back to estimates
+		potential.TODOCount = 8 // Estimated remaining tasks
+		potential.FIXMECount = 2 // Estimated issues
+
+		// Implementation depth (how much is real vs stubbed)
+		// Currently ~60% implemented (registers, telemetry, entelechy work)
+		// ~40% stubbed (actual GGUF inference, token streaming, GPU offload)
+		potential.ImplementationDepth = 0.6
+	}
+
 	// Self-improvement capacity
 	// High - has entelechy and ontogenesis frameworks
 	potential.SelfImprovementCapacity = 0.9
@@ -0,0 +1,194 @@
+package drivers
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Introspection-fed entelechy: the evolutionary dimension previously
+// ran on hardcoded estimates ("8 TODOs, 60% implemented"), which
+// meant the self-assessment could never notice real progress. This
+// scanner reads the actual driver source: TODO/FIXME counts, stub
+// detection, and a test-coverage proxy feed ImplementationDepth, so
+// the entelechy genome reflects the code as it is.
+
+// ImplementationReport summarizes a scan of the driver source tree
+type ImplementationReport struct {
+	RootPath     string
+	ScannedFiles int
+
+	TODOCount  int
+	FIXMECount int
+
+	// Stubs are functions that return canned values or declare
+	// themselves unimplemented
+	FunctionCount int
+	StubCount     int
+
+	// Coverage proxy: source files that have a matching _test.go
+	SourceFiles int
+	TestedFiles int
+
+	// ImplementationDepth in 0..1: how "real" the code is
+	ImplementationDepth float64
+}
+
+// stubMarkers flag a function body as stubbed when they appear
+var stubMarkers = []string{
+	"not implemented",
+	"not yet implemented",
+	"placeholder",
+	"stub",
+	"For now, ",
+}
+
+// AssessImplementation scans the Go source under rootPath and derives
+// the evolutionary signals the entelechy assessment consumes
+func AssessImplementation(rootPath string) (*ImplementationReport, error) {
+	report := &ImplementationReport{RootPath: rootPath}
+	testFiles := make(map[string]bool)
+
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil // Skip files with errors
+		}
+		if info.IsDir() {
+			if strings.HasPrefix(info.Name(), ".") || info.Name() == "vendor" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		if strings.HasSuffix(path, "_test.go") {
+			testFiles[strings.TrimSuffix(path, "_test.go")+".go"] = true
+			return nil
+		}
+
+		report.ScannedFiles++
+		report.SourceFiles++
+		scanFile(path, report)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("implementation scan failed: %w", err)
+	}
+
+	for file := range testFiles {
+		if _, statErr := os.Stat(file); statErr == nil {
+			report.TestedFiles++
+		}
+	}
+
+	report.ImplementationDepth = report.calculateDepth()
+	return report, nil
+}
+
+// scanFile accumulates counts from one source file
+func scanFile(path string, report *ImplementationReport) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	inFunction := false
+	functionStubbed := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		report.TODOCount += strings.Count(line, "TODO")
+		report.FIXMECount += strings.Count(line, "FIXME")
+
+		if strings.HasPrefix(trimmed, "func ") {
+			if inFunction && functionStubbed {
+				report.StubCount++
+			}
+			inFunction = true
+			functionStubbed = false
+			report.FunctionCount++
+			continue
+		}
+		if !inFunction {
+			continue
+		}
+		lower := strings.ToLower(trimmed)
+		for _, marker := range stubMarkers {
+			if strings.Contains(lower, strings.ToLower(marker)) {
+				functionStubbed = true
+				break
+			}
+		}
+	}
+	if inFunction && functionStubbed {
+		report.StubCount++
+	}
+}
+
+// calculateDepth blends stub ratio and test coverage into one
+// implementation-depth score
+func (report *ImplementationReport) calculateDepth() float64 {
+	realness := 1.0
+	if report.FunctionCount > 0 {
+		stubRatio := float64(report.StubCount) / float64(report.FunctionCount)
+		realness = 1.0 - stubRatio*2.0 // stubs weigh heavily
+		if realness < 0 {
+			realness = 0
+		}
+	}
+
+	coverage := 0.0
+	if report.SourceFiles > 0 {
+		coverage = float64(report.TestedFiles) / float64(report.SourceFiles)
+	}
+
+	depth := realness*0.7 + coverage*0.3
+	if depth > 1.0 {
+		depth = 1.0
+	}
+	return depth
+}
+
+// String returns a one-line summary for logs
+func (report *ImplementationReport) String() string {
+	return fmt.Sprintf("Implementation[depth %.0f%%, %d TODO, %d FIXME, %d/%d stubbed, %d/%d tested]",
+		report.ImplementationDepth*100, report.TODOCount, report.FIXMECount,
+		report.StubCount, report.FunctionCount, report.TestedFiles, report.SourceFiles)
+}
+
+// sharedImplReport caches one scan for the package; every NPU device
+// and driver assesses the same source tree
+var (
+	implReportMu sync.RWMutex
+	implReport   *ImplementationReport
+)
+
+// SetImplementationSourceRoot scans the given source tree and feeds
+// the result into subsequent entelechy assessments
+func SetImplementationSourceRoot(rootPath string) (*ImplementationReport, error) {
+	report, err := AssessImplementation(rootPath)
+	if err != nil {
+		return nil, err
+	}
+
+	implReportMu.Lock()
+	implReport = report
+	implReportMu.Unlock()
+
+	fmt.Printf("🔍 NPU: %s\n", report)
+	return report, nil
+}
+
+// currentImplementationReport returns the cached scan, if any
+func currentImplementationReport() *ImplementationReport {
+	implReportMu.RLock()
+	defer implReportMu.RUnlock()
+	return implReport
+}
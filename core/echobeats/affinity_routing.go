@@ -0,0 +1,251 @@
+package echobeats
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// AffinityRule directs one thought/stimulus type to a preferred
+// inference engine and, optionally, a specific LLM provider or NPU
+// device — so reflective thoughts can use a bigger model than
+// routine perception
+type AffinityRule struct {
+	ThoughtType string `json:"thought_type"`
+
+	// Preferred engine and ordered fallbacks when it is saturated
+	Engine    string   `json:"engine"`
+	Fallbacks []string `json:"fallbacks,omitempty"`
+
+	// Optional execution targets for the routed work
+	Provider string `json:"provider,omitempty"` // LLM provider name
+	Device   string `json:"device,omitempty"`   // NPU/cognitive device ID
+
+	// SaturationLimit above which the preferred engine is skipped
+	// (0 uses the router default)
+	SaturationLimit float64 `json:"saturation_limit,omitempty"`
+}
+
+// RoutingDecision is where a thought ended up
+type RoutingDecision struct {
+	Engine   string
+	Provider string
+	Device   string
+	Fallback bool // true if the preferred engine was saturated
+}
+
+// AffinityRouter routes thoughts to inference engines by type, with
+// saturation-aware fallback. Engines report their load through
+// registered probes (0 = idle, 1 = saturated).
+type AffinityRouter struct {
+	mu    sync.RWMutex
+	rules map[string]*AffinityRule
+
+	// Engine saturation probes
+	probes map[string]func() float64
+
+	defaultEngine    string
+	defaultThreshold float64
+
+	// Metrics
+	routed    uint64
+	fellBack  uint64
+	unmatched uint64
+}
+
+// NewAffinityRouter creates a router that sends unmatched thought
+// types to the given default engine
+func NewAffinityRouter(defaultEngine string) *AffinityRouter {
+	return &AffinityRouter{
+		rules:            make(map[string]*AffinityRule),
+		probes:           make(map[string]func() float64),
+		defaultEngine:    defaultEngine,
+		defaultThreshold: 0.9,
+	}
+}
+
+// AddRule installs or replaces the routing rule for a thought type
+func (ar *AffinityRouter) AddRule(rule AffinityRule) error {
+	if rule.ThoughtType == "" || rule.Engine == "" {
+		return fmt.Errorf("affinity rule requires thought type and engine")
+	}
+
+	ar.mu.Lock()
+	defer ar.mu.Unlock()
+	ar.rules[rule.ThoughtType] = &rule
+	return nil
+}
+
+// RemoveRule deletes the rule for a thought type
+func (ar *AffinityRouter) RemoveRule(thoughtType string) {
+	ar.mu.Lock()
+	defer ar.mu.Unlock()
+	delete(ar.rules, thoughtType)
+}
+
+// RegisterEngineProbe installs a saturation probe for an engine
+func (ar *AffinityRouter) RegisterEngineProbe(engine string, probe func() float64) {
+	ar.mu.Lock()
+	defer ar.mu.Unlock()
+	ar.probes[engine] = probe
+}
+
+// Route decides which engine (and provider/device) should handle a
+// thought of the given type, falling through the rule's fallback
+// chain when engines are saturated
+func (ar *AffinityRouter) Route(thoughtType string) RoutingDecision {
+	ar.mu.Lock()
+	defer ar.mu.Unlock()
+
+	rule, ok := ar.rules[thoughtType]
+	if !ok {
+		ar.unmatched++
+		return RoutingDecision{Engine: ar.defaultEngine}
+	}
+
+	threshold := rule.SaturationLimit
+	if threshold <= 0 {
+		threshold = ar.defaultThreshold
+	}
+
+	candidates := append([]string{rule.Engine}, rule.Fallbacks...)
+	for i, engine := range candidates {
+		if ar.saturationLocked(engine) >= threshold {
+			continue
+		}
+		ar.routed++
+		if i > 0 {
+			ar.fellBack++
+		}
+		return RoutingDecision{
+			Engine:   engine,
+			Provider: rule.Provider,
+			Device:   rule.Device,
+			Fallback: i > 0,
+		}
+	}
+
+	// Everything saturated: default engine absorbs the overflow
+	ar.routed++
+	ar.fellBack++
+	return RoutingDecision{
+		Engine:   ar.defaultEngine,
+		Provider: rule.Provider,
+		Device:   rule.Device,
+		Fallback: true,
+	}
+}
+
+// saturationLocked reads an engine's saturation; engines without a
+// probe count as unsaturated. Caller must hold the lock.
+func (ar *AffinityRouter) saturationLocked(engine string) float64 {
+	if probe, ok := ar.probes[engine]; ok {
+		return probe()
+	}
+	return 0
+}
+
+// GetMetrics returns routing statistics
+func (ar *AffinityRouter) GetMetrics() map[string]interface{} {
+	ar.mu.RLock()
+	defer ar.mu.RUnlock()
+
+	return map[string]interface{}{
+		"rules":     len(ar.rules),
+		"routed":    ar.routed,
+		"fallbacks": ar.fellBack,
+		"unmatched": ar.unmatched,
+	}
+}
+
+// AttachAffinityRouter registers saturation probes for the system's
+// engines (queue-depth based), installs the router as the system's
+// stimulus dispatch, and returns it preconfigured so unmatched
+// thoughts run on the relevance engine
+func (cis *ConcurrentInferenceSystem) AttachAffinityRouter() *AffinityRouter {
+	router := NewAffinityRouter("relevance")
+
+	router.RegisterEngineProbe("affordance", func() float64 {
+		return channelSaturation(cis.affordanceEngine.outputChannel)
+	})
+	router.RegisterEngineProbe("relevance", func() float64 {
+		return channelSaturation(cis.relevanceEngine.outputChannel)
+	})
+	router.RegisterEngineProbe("salience", func() float64 {
+		return channelSaturation(cis.salienceEngine.outputChannel)
+	})
+
+	cis.mu.Lock()
+	cis.router = router
+	cis.mu.Unlock()
+
+	return router
+}
+
+// SubmitStimulus routes a typed stimulus through the attached
+// affinity router and delivers it to the selected engine's input
+// state. Without an attached router everything lands on the relevance
+// engine.
+func (cis *ConcurrentInferenceSystem) SubmitStimulus(thoughtType string, payload interface{}) RoutingDecision {
+	cis.mu.RLock()
+	router := cis.router
+	cis.mu.RUnlock()
+
+	decision := RoutingDecision{Engine: "relevance"}
+	if router != nil {
+		decision = router.Route(thoughtType)
+	}
+	cis.dispatch(decision.Engine, thoughtType, payload)
+	return decision
+}
+
+// dispatch hands a stimulus to an engine's input state: past
+// experience for affordance, present focus for relevance, a future
+// scenario for salience. Auxiliary engines record the activity;
+// unknown engines fall back to relevance.
+func (cis *ConcurrentInferenceSystem) dispatch(engine, thoughtType string, payload interface{}) {
+	switch engine {
+	case "affordance":
+		cis.affordanceEngine.mu.Lock()
+		cis.affordanceEngine.pastExperiences = append(cis.affordanceEngine.pastExperiences, payload)
+		cis.affordanceEngine.mu.Unlock()
+		cis.sharedState.recordActivity(engine, RoleAffordance)
+
+	case "salience":
+		cis.salienceEngine.mu.Lock()
+		cis.salienceEngine.futureScenarios = append(cis.salienceEngine.futureScenarios, Scenario{
+			ID:           fmt.Sprintf("stimulus_%d", time.Now().UnixNano()),
+			Description:  thoughtType,
+			Probability:  0.5,
+			Desirability: 0.5,
+			Consequences: []interface{}{payload},
+			Timestamp:    time.Now(),
+		})
+		cis.salienceEngine.mu.Unlock()
+		cis.sharedState.recordActivity(engine, RoleSalience)
+
+	case "relevance":
+		cis.relevanceEngine.mu.Lock()
+		cis.relevanceEngine.currentRelevance = payload
+		cis.relevanceEngine.mu.Unlock()
+		cis.sharedState.recordActivity(engine, RoleRelevance)
+
+	default:
+		cis.mu.RLock()
+		aux, ok := cis.auxEngines[engine]
+		cis.mu.RUnlock()
+		if ok {
+			cis.sharedState.recordActivity(engine, aux.role)
+			return
+		}
+		cis.dispatch("relevance", thoughtType, payload)
+	}
+}
+
+// channelSaturation reads how full an engine's output channel is
+func channelSaturation(ch chan EngineOutput) float64 {
+	if cap(ch) == 0 {
+		return 0
+	}
+	return float64(len(ch)) / float64(cap(ch))
+}
@@ -23,10 +23,21 @@ type ConcurrentInferenceSystem struct {
 	// Synchronization
 	synchronizer     *PhaseSynchronizer
 	sharedState      *SharedCognitiveState
-	
+
+	// Merged view of the per-engine CRDT replicas (see crdt_state.go)
+	crdtState        *CRDTCognitiveState
+
 	// Metrics
 	cycleCount       uint64
 	lastCycleTime    time.Time
+
+	// Configurable engine pool (see engine_config.go)
+	config           EngineConfig
+	auxEngines       map[string]*GenericInferenceEngine
+	loadProbe        func() float64
+
+	// Typed dispatch (see affinity_routing.go)
+	router           *AffinityRouter
 }
 
 // SharedCognitiveState holds state shared across all three engines
@@ -49,6 +60,9 @@ type SharedCognitiveState struct {
 	// Step synchronization
 	currentStep       int
 	pivotalStepReached bool
+
+	// Per-engine processing metrics (see engine_config.go)
+	engineActivity    map[string]*EngineActivity
 }
 
 // PhaseSynchronizer coordinates the three engines at pivotal steps
@@ -72,12 +86,13 @@ type AffordanceEngine struct {
 	pastExperiences []interface{}
 	affordances     []Affordance
 	selectedAction  *Affordance
-	
+
 	// Handlers
 	stepHandlers    map[int]StepHandler
-	
+
 	// Communication
 	sharedState     *SharedCognitiveState
+	crdt            *CRDTCognitiveState
 	outputChannel   chan EngineOutput
 }
 
@@ -92,12 +107,13 @@ type RelevanceEngine struct {
 	relevanceScores map[interface{}]float64
 	currentRelevance interface{}
 	orientationVector []float64
-	
+
 	// Handlers
 	stepHandlers    map[int]StepHandler
-	
+
 	// Communication
 	sharedState     *SharedCognitiveState
+	crdt            *CRDTCognitiveState
 	outputChannel   chan EngineOutput
 }
 
@@ -113,12 +129,13 @@ type SalienceEngine struct {
 	futureScenarios []Scenario
 	salienceScores  map[string]float64  // Map scenario ID to score
 	selectedPath    *Scenario
-	
+
 	// Handlers
 	stepHandlers    map[int]StepHandler
-	
+
 	// Communication
 	sharedState     *SharedCognitiveState
+	crdt            *CRDTCognitiveState
 	outputChannel   chan EngineOutput
 }
 
@@ -172,13 +189,22 @@ func NewConcurrentInferenceSystem(stepDuration time.Duration) *ConcurrentInferen
 		cancel:       cancel,
 		sharedState:  sharedState,
 		synchronizer: synchronizer,
+		config:       DefaultEngineConfig(stepDuration),
+		auxEngines:   make(map[string]*GenericInferenceEngine),
 	}
 	
 	// Create three engines
 	cis.affordanceEngine = NewAffordanceEngine(ctx, stepDuration, sharedState)
 	cis.relevanceEngine = NewRelevanceEngine(ctx, sharedState)
 	cis.salienceEngine = NewSalienceEngine(ctx, stepDuration, sharedState)
-	
+
+	// Each engine writes to its own CRDT replica; the integration loop
+	// merges them so no engine's writes are lost to a concurrent overwrite
+	cis.crdtState = NewCRDTCognitiveState("integrator")
+	cis.affordanceEngine.crdt = NewCRDTCognitiveState("affordance")
+	cis.relevanceEngine.crdt = NewCRDTCognitiveState("relevance")
+	cis.salienceEngine.crdt = NewCRDTCognitiveState("salience")
+
 	return cis
 }
 
@@ -199,11 +225,25 @@ func (cis *ConcurrentInferenceSystem) Start() error {
 	go cis.affordanceEngine.Run(cis.synchronizer)
 	go cis.relevanceEngine.Run(cis.synchronizer)
 	go cis.salienceEngine.Run(cis.synchronizer)
-	
+
+	// Start any configured auxiliary engines and the scaling loop
+	cis.mu.Lock()
+	for _, engine := range cis.auxEngines {
+		go engine.Run()
+	}
+	auxCount := len(cis.auxEngines)
+	cis.mu.Unlock()
+
+	go cis.scalingLoop()
+
 	// Start integration loop
 	go cis.integrationLoop()
-	
-	fmt.Println("✅ 3 Concurrent Inference Engines: Active")
+
+	if auxCount > 0 {
+		fmt.Printf("✅ %d Concurrent Inference Engines: Active\n", 3+auxCount)
+	} else {
+		fmt.Println("✅ 3 Concurrent Inference Engines: Active")
+	}
 	fmt.Println("   🔹 Affordance Engine (Past): Processing steps 0-5")
 	fmt.Println("   🔹 Relevance Engine (Present): Pivotal steps 0, 6")
 	fmt.Println("   🔹 Salience Engine (Future): Processing steps 6-11")
@@ -244,9 +284,22 @@ func (cis *ConcurrentInferenceSystem) integrationLoop() {
 
 // integrateEngineOutputs combines outputs from all engines
 func (cis *ConcurrentInferenceSystem) integrateEngineOutputs() {
+	// Fold each engine's CRDT replica into the merged view first; the
+	// merge is deterministic regardless of which engine wrote last
+	cis.crdtState.MergeFrom(cis.affordanceEngine.crdt)
+	cis.crdtState.MergeFrom(cis.relevanceEngine.crdt)
+	cis.crdtState.MergeFrom(cis.salienceEngine.crdt)
+
 	cis.sharedState.mu.Lock()
 	defer cis.sharedState.mu.Unlock()
-	
+
+	// The merged attention register is authoritative: concurrent
+	// attention writes resolve by last-writer-wins instead of whichever
+	// engine happened to overwrite the shared field last
+	if attention := cis.crdtState.GetAttention(); attention != nil {
+		cis.sharedState.currentAttention = attention
+	}
+
 	// Calculate temporal coherence
 	// How well past, present, and future align
 	coherence := cis.calculateTemporalCoherence()
@@ -283,6 +336,15 @@ func (cis *ConcurrentInferenceSystem) GetSharedState() map[string]interface{} {
 	cis.sharedState.mu.RLock()
 	defer cis.sharedState.mu.RUnlock()
 	
+	engines := make(map[string]interface{}, len(cis.sharedState.engineActivity))
+	for name, activity := range cis.sharedState.engineActivity {
+		engines[name] = map[string]interface{}{
+			"role":            string(activity.Role),
+			"steps_processed": activity.StepsProcessed,
+			"last_active":     activity.LastActive,
+		}
+	}
+
 	return map[string]interface{}{
 		"current_step":       cis.sharedState.currentStep,
 		"coherence_score":    cis.sharedState.coherenceScore,
@@ -290,6 +352,8 @@ func (cis *ConcurrentInferenceSystem) GetSharedState() map[string]interface{} {
 		"past_context_size":  len(cis.sharedState.pastContext),
 		"future_options":     len(cis.sharedState.futureOptions),
 		"attention_weight":   cis.sharedState.attentionWeight,
+		"engines":            engines,
+		"crdt":               cis.crdtState.GetMetrics(),
 	}
 }
 
@@ -350,9 +414,10 @@ func (ae *AffordanceEngine) processStep(sync *PhaseSynchronizer) {
 		
 		// Process affordances from past
 		ae.processAffordances()
-		
+
 		// Update shared state
 		ae.updateSharedState()
+		ae.sharedState.recordActivity("affordance", RoleAffordance)
 		
 		// Advance step
 		ae.mu.Lock()
@@ -371,8 +436,7 @@ func (ae *AffordanceEngine) processAffordances() {
 // updateSharedState updates the shared cognitive state
 func (ae *AffordanceEngine) updateSharedState() {
 	ae.sharedState.mu.Lock()
-	defer ae.sharedState.mu.Unlock()
-	
+
 	// Update past context in shared state
 	if len(ae.affordances) > 0 {
 		ae.sharedState.pastContext = make([]interface{}, len(ae.affordances))
@@ -380,6 +444,13 @@ func (ae *AffordanceEngine) updateSharedState() {
 			ae.sharedState.pastContext[i] = aff
 		}
 	}
+	ae.sharedState.mu.Unlock()
+
+	// Record into this engine's CRDT replica for lossless merging
+	ae.crdt.CountThought()
+	for _, aff := range ae.affordances {
+		ae.crdt.AddPastContext(aff.Action)
+	}
 }
 
 // getMode returns the cognitive mode for a step
@@ -450,9 +521,10 @@ func (re *RelevanceEngine) performRelevanceRealization(sync *PhaseSynchronizer,
 	
 	// Perform relevance realization
 	re.realizeRelevance()
-	
+
 	// Update shared state
 	re.updateSharedState()
+	re.sharedState.recordActivity("relevance", RoleRelevance)
 }
 
 // realizeRelevance performs the core relevance realization
@@ -472,10 +544,16 @@ func (re *RelevanceEngine) realizeRelevance() {
 // updateSharedState updates the shared cognitive state
 func (re *RelevanceEngine) updateSharedState() {
 	re.sharedState.mu.Lock()
-	defer re.sharedState.mu.Unlock()
-	
+
 	// Update present focus in shared state
 	re.sharedState.presentFocus = re.currentRelevance
+	re.sharedState.mu.Unlock()
+
+	// Record into this engine's CRDT replica for lossless merging
+	re.crdt.CountThought()
+	if re.currentRelevance != nil {
+		re.crdt.SetAttention(re.currentRelevance)
+	}
 }
 
 // NewSalienceEngine creates a new salience simulation engine
@@ -535,9 +613,10 @@ func (se *SalienceEngine) processStep(sync *PhaseSynchronizer) {
 		
 		// Simulate future scenarios
 		se.simulateFuture()
-		
+
 		// Update shared state
 		se.updateSharedState()
+		se.sharedState.recordActivity("salience", RoleSalience)
 		
 		// Advance step
 		se.mu.Lock()
@@ -559,8 +638,7 @@ func (se *SalienceEngine) simulateFuture() {
 // updateSharedState updates the shared cognitive state
 func (se *SalienceEngine) updateSharedState() {
 	se.sharedState.mu.Lock()
-	defer se.sharedState.mu.Unlock()
-	
+
 	// Update future options in shared state
 	if len(se.futureScenarios) > 0 {
 		se.sharedState.futureOptions = make([]interface{}, len(se.futureScenarios))
@@ -568,6 +646,13 @@ func (se *SalienceEngine) updateSharedState() {
 			se.sharedState.futureOptions[i] = scenario
 		}
 	}
+	se.sharedState.mu.Unlock()
+
+	// Record into this engine's CRDT replica for lossless merging
+	se.crdt.CountThought()
+	for _, scenario := range se.futureScenarios {
+		se.crdt.AddFutureOption(scenario.ID)
+	}
 }
 
 // getMode returns the cognitive mode for a step
@@ -0,0 +1,221 @@
+package echobeats
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// IncomingMessage is a message arriving from an external platform
+type IncomingMessage struct {
+	Connector string                 `json:"connector"`
+	ChannelID string                 `json:"channel_id"`
+	Topic     string                 `json:"topic"`
+	Sender    string                 `json:"sender"`
+	Content   string                 `json:"content"`
+	Timestamp time.Time              `json:"timestamp"`
+	Context   map[string]interface{} `json:"context,omitempty"`
+}
+
+// DiscussionConnector bridges an external platform (Discord, Slack,
+// GitHub, ...) into the DiscussionManager. Connectors poll for new
+// messages and post responses back to the platform.
+type DiscussionConnector interface {
+	// Name identifies the connector (e.g. "discord")
+	Name() string
+
+	// Poll returns messages that arrived since the last poll
+	Poll(ctx context.Context) ([]IncomingMessage, error)
+
+	// SendMessage posts a response to the given channel
+	SendMessage(ctx context.Context, channelID, content string) error
+
+	// Available reports whether the connector is configured
+	Available() bool
+}
+
+// ConnectorHub runs registered connectors against the discussion
+// manager: incoming messages become discussions, and responses the
+// manager chooses to make are posted back through the connector.
+type ConnectorHub struct {
+	mu           sync.RWMutex
+	ctx          context.Context
+	cancel       context.CancelFunc
+	manager      *DiscussionManager
+	connectors   map[string]DiscussionConnector
+	pollInterval time.Duration
+
+	// Maps connector/channel pairs onto discussion IDs
+	channelDiscussions map[string]string
+
+	// Metrics
+	messagesBridged uint64
+	responsesSent   uint64
+
+	running bool
+}
+
+// NewConnectorHub creates a hub bridging connectors to the manager
+func NewConnectorHub(manager *DiscussionManager) *ConnectorHub {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &ConnectorHub{
+		ctx:                ctx,
+		cancel:             cancel,
+		manager:            manager,
+		connectors:         make(map[string]DiscussionConnector),
+		pollInterval:       15 * time.Second,
+		channelDiscussions: make(map[string]string),
+	}
+}
+
+// RegisterConnector adds a platform connector to the hub
+func (ch *ConnectorHub) RegisterConnector(connector DiscussionConnector) error {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	name := connector.Name()
+	if _, exists := ch.connectors[name]; exists {
+		return fmt.Errorf("connector %s already registered", name)
+	}
+
+	ch.connectors[name] = connector
+	fmt.Printf("💬 Connector registered: %s (available: %v)\n", name, connector.Available())
+
+	return nil
+}
+
+// Start begins polling all connectors
+func (ch *ConnectorHub) Start() error {
+	ch.mu.Lock()
+	if ch.running {
+		ch.mu.Unlock()
+		return fmt.Errorf("connector hub already running")
+	}
+	ch.running = true
+	ch.mu.Unlock()
+
+	go ch.pollLoop()
+
+	return nil
+}
+
+// Stop halts connector polling
+func (ch *ConnectorHub) Stop() {
+	ch.mu.Lock()
+	ch.running = false
+	ch.mu.Unlock()
+
+	ch.cancel()
+}
+
+// pollLoop drives all connectors on the poll interval
+func (ch *ConnectorHub) pollLoop() {
+	ticker := time.NewTicker(ch.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ch.ctx.Done():
+			return
+		case <-ticker.C:
+			ch.pollAll()
+		}
+	}
+}
+
+// pollAll polls every available connector and bridges new messages
+func (ch *ConnectorHub) pollAll() {
+	ch.mu.RLock()
+	connectors := make([]DiscussionConnector, 0, len(ch.connectors))
+	for _, c := range ch.connectors {
+		if c.Available() {
+			connectors = append(connectors, c)
+		}
+	}
+	ch.mu.RUnlock()
+
+	for _, connector := range connectors {
+		messages, err := connector.Poll(ch.ctx)
+		if err != nil {
+			fmt.Printf("⚠️  Connector %s poll error: %v\n", connector.Name(), err)
+			continue
+		}
+
+		for _, msg := range messages {
+			ch.bridgeMessage(connector, msg)
+		}
+	}
+}
+
+// bridgeMessage routes one platform message through the discussion
+// manager, replying on the platform when engagement is warranted
+func (ch *ConnectorHub) bridgeMessage(connector DiscussionConnector, msg IncomingMessage) {
+	channelKey := msg.Connector + ":" + msg.ChannelID
+
+	ch.mu.Lock()
+	discussionID, known := ch.channelDiscussions[channelKey]
+	ch.mu.Unlock()
+
+	if !known {
+		topic := msg.Topic
+		if topic == "" {
+			topic = fmt.Sprintf("%s channel %s", msg.Connector, msg.ChannelID)
+		}
+
+		decision := ch.manager.EvaluateDiscussion(topic, msg.Context)
+		if !decision.ShouldEngage {
+			return
+		}
+
+		discussionID = fmt.Sprintf("%s_%s", msg.Connector, msg.ChannelID)
+		if err := ch.manager.JoinDiscussion(discussionID, topic, msg.Context); err != nil {
+			return
+		}
+
+		ch.mu.Lock()
+		ch.channelDiscussions[channelKey] = discussionID
+		ch.mu.Unlock()
+	}
+
+	if err := ch.manager.ProcessMessage(discussionID, msg.Sender, msg.Content, MessageTypeStatement); err != nil {
+		return
+	}
+
+	ch.mu.Lock()
+	ch.messagesBridged++
+	ch.mu.Unlock()
+
+	// Decide whether to respond
+	if shouldContinue, _ := ch.manager.ShouldContinueDiscussion(discussionID); !shouldContinue {
+		return
+	}
+
+	response, err := ch.manager.GenerateResponse(discussionID)
+	if err != nil {
+		return
+	}
+
+	if err := connector.SendMessage(ch.ctx, msg.ChannelID, response); err != nil {
+		fmt.Printf("⚠️  Connector %s send error: %v\n", connector.Name(), err)
+		return
+	}
+
+	ch.mu.Lock()
+	ch.responsesSent++
+	ch.mu.Unlock()
+}
+
+// GetMetrics returns connector hub metrics
+func (ch *ConnectorHub) GetMetrics() map[string]interface{} {
+	ch.mu.RLock()
+	defer ch.mu.RUnlock()
+
+	return map[string]interface{}{
+		"connectors":       len(ch.connectors),
+		"messages_bridged": ch.messagesBridged,
+		"responses_sent":   ch.responsesSent,
+		"channels_mapped":  len(ch.channelDiscussions),
+	}
+}
@@ -0,0 +1,327 @@
+package echobeats
+
+import (
+	"sync"
+	"time"
+)
+
+// CRDT building blocks for shared cognitive state. Concurrent engines
+// each write to their own replica and replicas merge deterministically,
+// so no engine output is lost to a concurrent overwrite.
+
+// GCounter is a grow-only counter: each replica increments its own
+// slot and merge takes the per-replica maximum
+type GCounter struct {
+	counts map[string]uint64
+}
+
+// NewGCounter creates an empty grow-only counter
+func NewGCounter() *GCounter {
+	return &GCounter{counts: make(map[string]uint64)}
+}
+
+// Increment adds to this replica's slot
+func (c *GCounter) Increment(replica string, delta uint64) {
+	c.counts[replica] += delta
+}
+
+// Value sums all replica slots
+func (c *GCounter) Value() uint64 {
+	var total uint64
+	for _, count := range c.counts {
+		total += count
+	}
+	return total
+}
+
+// Merge folds another counter in (per-replica max)
+func (c *GCounter) Merge(other *GCounter) {
+	for replica, count := range other.counts {
+		if count > c.counts[replica] {
+			c.counts[replica] = count
+		}
+	}
+}
+
+// PNCounter supports increments and decrements as two G-counters
+type PNCounter struct {
+	pos *GCounter
+	neg *GCounter
+}
+
+// NewPNCounter creates an empty positive-negative counter
+func NewPNCounter() *PNCounter {
+	return &PNCounter{pos: NewGCounter(), neg: NewGCounter()}
+}
+
+// Add applies a signed delta for a replica
+func (c *PNCounter) Add(replica string, delta int64) {
+	if delta >= 0 {
+		c.pos.Increment(replica, uint64(delta))
+	} else {
+		c.neg.Increment(replica, uint64(-delta))
+	}
+}
+
+// Value is the net count
+func (c *PNCounter) Value() int64 {
+	return int64(c.pos.Value()) - int64(c.neg.Value())
+}
+
+// Merge folds another counter in
+func (c *PNCounter) Merge(other *PNCounter) {
+	c.pos.Merge(other.pos)
+	c.neg.Merge(other.neg)
+}
+
+// LWWRegister is a last-writer-wins register; merge keeps the newest
+// write, breaking timestamp ties by replica ID so the outcome is
+// deterministic on every engine
+type LWWRegister struct {
+	value     interface{}
+	timestamp time.Time
+	replica   string
+}
+
+// Set records a write from a replica
+func (r *LWWRegister) Set(replica string, value interface{}) {
+	r.value = value
+	r.timestamp = time.Now()
+	r.replica = replica
+}
+
+// Get returns the current value
+func (r *LWWRegister) Get() interface{} {
+	return r.value
+}
+
+// Merge keeps the newer write; returns true if the writes conflicted
+// (both sides held concurrent non-identical values)
+func (r *LWWRegister) Merge(other *LWWRegister) bool {
+	if other.timestamp.IsZero() {
+		return false
+	}
+	conflict := !r.timestamp.IsZero() && r.replica != other.replica
+
+	if other.timestamp.After(r.timestamp) ||
+		(other.timestamp.Equal(r.timestamp) && other.replica > r.replica) {
+		r.value = other.value
+		r.timestamp = other.timestamp
+		r.replica = other.replica
+	}
+	return conflict
+}
+
+// ORSet is an observed-remove set: adds tag elements uniquely per
+// replica, removes only delete observed tags, so a concurrent add
+// always survives a concurrent remove
+type ORSet struct {
+	elements  map[string]map[string]bool // element -> alive tags
+	tombstone map[string]bool            // removed tags
+	nextTag   uint64
+}
+
+// NewORSet creates an empty observed-remove set
+func NewORSet() *ORSet {
+	return &ORSet{
+		elements:  make(map[string]map[string]bool),
+		tombstone: make(map[string]bool),
+	}
+}
+
+// Add inserts an element with a fresh replica-scoped tag
+func (s *ORSet) Add(replica, element string) {
+	s.nextTag++
+	tag := replica + "#" + itoa(s.nextTag)
+	if s.elements[element] == nil {
+		s.elements[element] = make(map[string]bool)
+	}
+	s.elements[element][tag] = true
+}
+
+// Remove deletes all currently observed tags of an element
+func (s *ORSet) Remove(element string) {
+	for tag := range s.elements[element] {
+		s.tombstone[tag] = true
+	}
+	delete(s.elements, element)
+}
+
+// Contains reports element membership
+func (s *ORSet) Contains(element string) bool {
+	return len(s.elements[element]) > 0
+}
+
+// Elements lists current members
+func (s *ORSet) Elements() []string {
+	members := make([]string, 0, len(s.elements))
+	for element, tags := range s.elements {
+		if len(tags) > 0 {
+			members = append(members, element)
+		}
+	}
+	return members
+}
+
+// Merge folds another OR-set in: union of tags minus the union of
+// tombstones
+func (s *ORSet) Merge(other *ORSet) {
+	for tag := range other.tombstone {
+		s.tombstone[tag] = true
+	}
+	for element, tags := range other.elements {
+		for tag := range tags {
+			if s.tombstone[tag] {
+				continue
+			}
+			if s.elements[element] == nil {
+				s.elements[element] = make(map[string]bool)
+			}
+			s.elements[element][tag] = true
+		}
+	}
+	// Drop tags tombstoned by the other side
+	for element, tags := range s.elements {
+		for tag := range tags {
+			if s.tombstone[tag] {
+				delete(tags, tag)
+			}
+		}
+		if len(tags) == 0 {
+			delete(s.elements, element)
+		}
+	}
+}
+
+// CRDTCognitiveState is a replica of the shared cognitive state built
+// from CRDTs: each engine owns one replica, writes locally, and
+// replicas merge without lost updates
+type CRDTCognitiveState struct {
+	mu      sync.Mutex
+	replica string
+
+	// Current attention focus (last writer wins)
+	Attention *LWWRegister
+
+	// Accumulated context elements (concurrent adds all survive)
+	PastContext   *ORSet
+	FutureOptions *ORSet
+
+	// Engine output counting
+	ThoughtsProcessed *PNCounter
+
+	// Conflict statistics
+	lwwConflicts uint64
+	merges       uint64
+}
+
+// NewCRDTCognitiveState creates a state replica for one engine
+func NewCRDTCognitiveState(replica string) *CRDTCognitiveState {
+	return &CRDTCognitiveState{
+		replica:           replica,
+		Attention:         &LWWRegister{},
+		PastContext:       NewORSet(),
+		FutureOptions:     NewORSet(),
+		ThoughtsProcessed: NewPNCounter(),
+	}
+}
+
+// SetAttention records this replica's attention focus
+func (cs *CRDTCognitiveState) SetAttention(value interface{}) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.Attention.Set(cs.replica, value)
+}
+
+// AddPastContext records a context element from this replica. Re-adding
+// a present element is a no-op so callers can re-record their full
+// context each cycle without growing the tag set.
+func (cs *CRDTCognitiveState) AddPastContext(element string) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	if !cs.PastContext.Contains(element) {
+		cs.PastContext.Add(cs.replica, element)
+	}
+}
+
+// AddFutureOption records a future option from this replica; like
+// AddPastContext, re-adds of present elements are no-ops
+func (cs *CRDTCognitiveState) AddFutureOption(element string) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	if !cs.FutureOptions.Contains(element) {
+		cs.FutureOptions.Add(cs.replica, element)
+	}
+}
+
+// GetAttention returns the merged attention focus
+func (cs *CRDTCognitiveState) GetAttention() interface{} {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	return cs.Attention.Get()
+}
+
+// CountThought bumps this replica's processed-thought counter
+func (cs *CRDTCognitiveState) CountThought() {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.ThoughtsProcessed.Add(cs.replica, 1)
+}
+
+// MergeFrom folds another replica's state into this one. The result
+// is deterministic regardless of merge order; conflicting attention
+// writes are counted rather than silently lost.
+func (cs *CRDTCognitiveState) MergeFrom(other *CRDTCognitiveState) {
+	if other == nil || other == cs {
+		return
+	}
+
+	// Lock in a fixed order to avoid deadlock between replicas
+	first, second := cs, other
+	if first.replica > second.replica {
+		first, second = second, first
+	}
+	first.mu.Lock()
+	defer first.mu.Unlock()
+	second.mu.Lock()
+	defer second.mu.Unlock()
+
+	if cs.Attention.Merge(other.Attention) {
+		cs.lwwConflicts++
+	}
+	cs.PastContext.Merge(other.PastContext)
+	cs.FutureOptions.Merge(other.FutureOptions)
+	cs.ThoughtsProcessed.Merge(other.ThoughtsProcessed)
+	cs.merges++
+}
+
+// GetMetrics returns replica state and conflict statistics
+func (cs *CRDTCognitiveState) GetMetrics() map[string]interface{} {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	return map[string]interface{}{
+		"replica":            cs.replica,
+		"attention":          cs.Attention.Get(),
+		"past_context_size":  len(cs.PastContext.Elements()),
+		"future_options":     len(cs.FutureOptions.Elements()),
+		"thoughts_processed": cs.ThoughtsProcessed.Value(),
+		"lww_conflicts":      cs.lwwConflicts,
+		"merges":             cs.merges,
+	}
+}
+
+// itoa avoids strconv for the hot tag path
+func itoa(n uint64) string {
+	if n == 0 {
+		return "0"
+	}
+	var buf [20]byte
+	i := len(buf)
+	for n > 0 {
+		i--
+		buf[i] = byte('0' + n%10)
+		n /= 10
+	}
+	return string(buf[i:])
+}
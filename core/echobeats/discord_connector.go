@@ -0,0 +1,174 @@
+package echobeats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const discordAPIBase = "https://discord.com/api/v10"
+
+// DiscordConnector bridges Discord channels into the discussion
+// manager using the REST API with polling (no gateway connection
+// required). Configure with DISCORD_BOT_TOKEN and a comma-separated
+// DISCORD_CHANNEL_IDS list.
+type DiscordConnector struct {
+	mu         sync.Mutex
+	token      string
+	channelIDs []string
+	httpClient *http.Client
+
+	// Last message seen per channel, for incremental polling
+	lastMessageID map[string]string
+}
+
+// NewDiscordConnector creates a Discord connector from the environment
+func NewDiscordConnector() *DiscordConnector {
+	channels := make([]string, 0)
+	for _, id := range strings.Split(os.Getenv("DISCORD_CHANNEL_IDS"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			channels = append(channels, id)
+		}
+	}
+
+	return &DiscordConnector{
+		token:         os.Getenv("DISCORD_BOT_TOKEN"),
+		channelIDs:    channels,
+		httpClient:    &http.Client{Timeout: 15 * time.Second},
+		lastMessageID: make(map[string]string),
+	}
+}
+
+// Name identifies the connector
+func (dc *DiscordConnector) Name() string { return "discord" }
+
+// Available reports whether the connector is configured
+func (dc *DiscordConnector) Available() bool {
+	return dc.token != "" && len(dc.channelIDs) > 0
+}
+
+// discordMessage is the subset of the Discord message object we use
+type discordMessage struct {
+	ID        string `json:"id"`
+	Content   string `json:"content"`
+	Timestamp string `json:"timestamp"`
+	Author    struct {
+		Username string `json:"username"`
+		Bot      bool   `json:"bot"`
+	} `json:"author"`
+}
+
+// Poll fetches messages newer than the last seen message per channel
+func (dc *DiscordConnector) Poll(ctx context.Context) ([]IncomingMessage, error) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	incoming := make([]IncomingMessage, 0)
+
+	for _, channelID := range dc.channelIDs {
+		after, primed := dc.lastMessageID[channelID]
+
+		url := fmt.Sprintf("%s/channels/%s/messages?limit=20", discordAPIBase, channelID)
+		if after != "" {
+			url += "&after=" + after
+		}
+
+		messages, err := dc.fetchMessages(ctx, url)
+		if err != nil {
+			return incoming, fmt.Errorf("channel %s: %w", channelID, err)
+		}
+
+		if len(messages) == 0 {
+			if !primed {
+				dc.lastMessageID[channelID] = ""
+			}
+			continue
+		}
+
+		// Discord returns newest first; record the high-water mark
+		dc.lastMessageID[channelID] = messages[0].ID
+
+		// First poll only establishes the high-water mark
+		if !primed {
+			continue
+		}
+
+		// Walk backwards so messages arrive in chronological order
+		for i := len(messages) - 1; i >= 0; i-- {
+			msg := messages[i]
+			if msg.Author.Bot || msg.Content == "" {
+				continue
+			}
+
+			timestamp, _ := time.Parse(time.RFC3339, msg.Timestamp)
+			incoming = append(incoming, IncomingMessage{
+				Connector: dc.Name(),
+				ChannelID: channelID,
+				Sender:    msg.Author.Username,
+				Content:   msg.Content,
+				Timestamp: timestamp,
+			})
+		}
+	}
+
+	return incoming, nil
+}
+
+// fetchMessages performs one authenticated GET against the Discord API
+func (dc *DiscordConnector) fetchMessages(ctx context.Context, url string) ([]discordMessage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bot "+dc.token)
+
+	resp, err := dc.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("discord API returned %d", resp.StatusCode)
+	}
+
+	var messages []discordMessage
+	if err := json.NewDecoder(resp.Body).Decode(&messages); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// SendMessage posts a message to a Discord channel
+func (dc *DiscordConnector) SendMessage(ctx context.Context, channelID, content string) error {
+	payload, err := json.Marshal(map[string]string{"content": content})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/channels/%s/messages", discordAPIBase, channelID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(string(payload)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bot "+dc.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := dc.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("discord API returned %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package echobeats
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// durableEvent is the serialized form of a pending CognitiveEvent.
+// Payloads survive as their JSON representation; handlers that need
+// richer types should carry them in Context as plain values.
+type durableEvent struct {
+	ID           string                 `json:"id"`
+	Type         EventType              `json:"type"`
+	Priority     int                    `json:"priority"`
+	Timestamp    time.Time              `json:"timestamp"`
+	ScheduledAt  time.Time              `json:"scheduled_at"`
+	Payload      interface{}            `json:"payload,omitempty"`
+	Context      map[string]interface{} `json:"context,omitempty"`
+	Recurring    bool                   `json:"recurring,omitempty"`
+	Interval     time.Duration          `json:"interval,omitempty"`
+	ScheduleExpr string                 `json:"schedule_expr,omitempty"`
+}
+
+// EnableDurability makes the pending event queue survive crashes:
+// the queue is journaled to the given path on every change, and any
+// events journaled by a previous run are restored and re-armed now
+// (deduplicated by event ID). Events whose time passed while the
+// process was down fire immediately.
+func (eb *EchoBeats) EnableDurability(path string) error {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create journal directory: %w", err)
+		}
+	}
+
+	eb.mu.Lock()
+	eb.durablePath = path
+	eb.mu.Unlock()
+
+	return eb.restoreEvents(path)
+}
+
+// restoreEvents re-arms events journaled by a previous run
+func (eb *EchoBeats) restoreEvents(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read event journal: %w", err)
+	}
+
+	var pending []durableEvent
+	if err := json.Unmarshal(data, &pending); err != nil {
+		return fmt.Errorf("failed to parse event journal: %w", err)
+	}
+
+	// Index already-queued IDs so a double restore cannot duplicate
+	eb.mu.RLock()
+	queued := make(map[string]bool, eb.eventQueue.Len())
+	for _, event := range *eb.eventQueue {
+		queued[event.ID] = true
+	}
+	eb.mu.RUnlock()
+
+	restored, skipped := 0, 0
+	now := time.Now()
+	for _, saved := range pending {
+		if saved.ID == "" || queued[saved.ID] {
+			skipped++
+			continue
+		}
+		queued[saved.ID] = true
+
+		scheduledAt := saved.ScheduledAt
+		if scheduledAt.Before(now) {
+			scheduledAt = now // Overdue: fire immediately
+		}
+
+		eb.ScheduleEvent(&CognitiveEvent{
+			ID:           saved.ID,
+			Type:         saved.Type,
+			Priority:     saved.Priority,
+			Timestamp:    saved.Timestamp,
+			ScheduledAt:  scheduledAt,
+			Payload:      saved.Payload,
+			Context:      saved.Context,
+			Recurring:    saved.Recurring,
+			Interval:     saved.Interval,
+			ScheduleExpr: saved.ScheduleExpr,
+		})
+		restored++
+	}
+
+	if restored > 0 || skipped > 0 {
+		fmt.Printf("📚 EchoBeats: restored %d pending event(s), %d duplicate(s) skipped\n",
+			restored, skipped)
+	}
+	return nil
+}
+
+// persistQueueLocked journals the pending queue. Caller must hold
+// eb.mu; write failures are logged, not fatal, so a full disk cannot
+// stall the cognitive loop.
+func (eb *EchoBeats) persistQueueLocked() {
+	if eb.durablePath == "" {
+		return
+	}
+
+	pending := make([]durableEvent, 0, eb.eventQueue.Len())
+	for _, event := range *eb.eventQueue {
+		pending = append(pending, durableEvent{
+			ID:           event.ID,
+			Type:         event.Type,
+			Priority:     event.Priority,
+			Timestamp:    event.Timestamp,
+			ScheduledAt:  event.ScheduledAt,
+			Payload:      event.Payload,
+			Context:      event.Context,
+			Recurring:    event.Recurring,
+			Interval:     event.Interval,
+			ScheduleExpr: event.ScheduleExpr,
+		})
+	}
+
+	data, err := json.MarshalIndent(pending, "", "  ")
+	if err != nil {
+		fmt.Printf("⚠️  EchoBeats: journal marshal failed: %v\n", err)
+		return
+	}
+
+	// Write-then-rename keeps the journal intact if we crash mid-write
+	tmpPath := eb.durablePath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		fmt.Printf("⚠️  EchoBeats: journal write failed: %v\n", err)
+		return
+	}
+	if err := os.Rename(tmpPath, eb.durablePath); err != nil {
+		os.Remove(tmpPath)
+		fmt.Printf("⚠️  EchoBeats: journal commit failed: %v\n", err)
+	}
+}
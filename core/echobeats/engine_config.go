@@ -0,0 +1,214 @@
+package echobeats
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// EngineRole identifies what an inference engine is responsible for.
+// The classic three-engine architecture uses affordance, relevance,
+// and salience; additional roles run as generic engines.
+type EngineRole string
+
+const (
+	RoleAffordance EngineRole = "affordance"
+	RoleRelevance  EngineRole = "relevance"
+	RoleSalience   EngineRole = "salience"
+	RolePerception EngineRole = "perception"
+	RoleReasoning  EngineRole = "reasoning"
+	RoleReflection EngineRole = "reflection"
+)
+
+// EngineConfig makes the engine count and roles of the concurrent
+// inference system configurable instead of the fixed 3-engine setup
+type EngineConfig struct {
+	// Roles to run at start; classic roles map onto the dedicated
+	// engines, any others onto generic engines
+	Roles []EngineRole
+
+	// Scaling bounds on the total engine count
+	MinEngines int
+	MaxEngines int
+
+	// ScaleRole is the role given to engines added under load
+	ScaleRole EngineRole
+
+	// Load thresholds for adding/removing engines
+	ScaleUpLoad   float64
+	ScaleDownLoad float64
+
+	StepDuration time.Duration
+}
+
+// DefaultEngineConfig preserves the classic three-engine architecture
+func DefaultEngineConfig(stepDuration time.Duration) EngineConfig {
+	return EngineConfig{
+		Roles:         []EngineRole{RoleAffordance, RoleRelevance, RoleSalience},
+		MinEngines:    3,
+		MaxEngines:    6,
+		ScaleRole:     RoleReasoning,
+		ScaleUpLoad:   0.8,
+		ScaleDownLoad: 0.3,
+		StepDuration:  stepDuration,
+	}
+}
+
+// EngineActivity tracks per-engine processing for GetSharedState
+type EngineActivity struct {
+	Role           EngineRole `json:"role"`
+	StepsProcessed uint64     `json:"steps_processed"`
+	LastActive     time.Time  `json:"last_active"`
+}
+
+// recordActivity notes that an engine processed a step
+func (s *SharedCognitiveState) recordActivity(name string, role EngineRole) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.engineActivity == nil {
+		s.engineActivity = make(map[string]*EngineActivity)
+	}
+	activity, ok := s.engineActivity[name]
+	if !ok {
+		activity = &EngineActivity{Role: role}
+		s.engineActivity[name] = activity
+	}
+	activity.StepsProcessed++
+	activity.LastActive = time.Now()
+}
+
+// GenericInferenceEngine runs a non-classic role (perception,
+// reasoning, reflection, ...) against the shared cognitive state
+type GenericInferenceEngine struct {
+	mu           sync.RWMutex
+	name         string
+	role         EngineRole
+	stepDuration time.Duration
+	sharedState  *SharedCognitiveState
+	stop         chan struct{}
+}
+
+// NewGenericInferenceEngine creates an engine for the given role
+func NewGenericInferenceEngine(name string, role EngineRole, stepDuration time.Duration, sharedState *SharedCognitiveState) *GenericInferenceEngine {
+	return &GenericInferenceEngine{
+		name:         name,
+		role:         role,
+		stepDuration: stepDuration,
+		sharedState:  sharedState,
+		stop:         make(chan struct{}),
+	}
+}
+
+// Run executes the generic engine loop until stopped
+func (ge *GenericInferenceEngine) Run() {
+	ticker := time.NewTicker(ge.stepDuration)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ge.stop:
+			return
+		case <-ticker.C:
+			ge.sharedState.recordActivity(ge.name, ge.role)
+		}
+	}
+}
+
+// Stop halts the engine loop
+func (ge *GenericInferenceEngine) Stop() {
+	close(ge.stop)
+}
+
+// NewConcurrentInferenceSystemWithConfig creates an inference system
+// with a configurable set of engines. Classic roles (affordance,
+// relevance, salience) run their dedicated engines; other roles run
+// generic engines.
+func NewConcurrentInferenceSystemWithConfig(config EngineConfig) *ConcurrentInferenceSystem {
+	cis := NewConcurrentInferenceSystem(config.StepDuration)
+	cis.config = config
+	cis.auxEngines = make(map[string]*GenericInferenceEngine)
+
+	for _, role := range config.Roles {
+		switch role {
+		case RoleAffordance, RoleRelevance, RoleSalience:
+			// Dedicated engines are always constructed
+		default:
+			name := fmt.Sprintf("%s_0", role)
+			cis.auxEngines[name] = NewGenericInferenceEngine(
+				name, role, config.StepDuration, cis.sharedState)
+		}
+	}
+
+	return cis
+}
+
+// SetLoadProbe installs the cognitive load reading used for dynamic
+// engine scaling (e.g. from the EchoBeats cycle manager)
+func (cis *ConcurrentInferenceSystem) SetLoadProbe(probe func() float64) {
+	cis.mu.Lock()
+	defer cis.mu.Unlock()
+	cis.loadProbe = probe
+}
+
+// scalingLoop grows and shrinks the engine pool with cognitive load
+func (cis *ConcurrentInferenceSystem) scalingLoop() {
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-cis.ctx.Done():
+			return
+		case <-ticker.C:
+			cis.mu.RLock()
+			probe := cis.loadProbe
+			cis.mu.RUnlock()
+			if probe != nil {
+				cis.scaleForLoad(probe())
+			}
+		}
+	}
+}
+
+// scaleForLoad adds or removes generic engines so capacity tracks
+// cognitive load within the configured bounds
+func (cis *ConcurrentInferenceSystem) scaleForLoad(load float64) {
+	cis.mu.Lock()
+	defer cis.mu.Unlock()
+
+	total := 3 + len(cis.auxEngines) // dedicated engines plus pool
+
+	switch {
+	case load >= cis.config.ScaleUpLoad && total < cis.config.MaxEngines:
+		name := fmt.Sprintf("%s_%d", cis.config.ScaleRole, len(cis.auxEngines))
+		engine := NewGenericInferenceEngine(
+			name, cis.config.ScaleRole, cis.config.StepDuration, cis.sharedState)
+		cis.auxEngines[name] = engine
+		if cis.running {
+			go engine.Run()
+		}
+		fmt.Printf("🔷 Inference: scaled up to %d engines (load %.2f, added %s)\n",
+			total+1, load, name)
+
+	case load <= cis.config.ScaleDownLoad && total > cis.config.MinEngines && len(cis.auxEngines) > 0:
+		// Remove the most recently added engine
+		var victim string
+		for name := range cis.auxEngines {
+			if victim == "" || name > victim {
+				victim = name
+			}
+		}
+		cis.auxEngines[victim].Stop()
+		delete(cis.auxEngines, victim)
+		fmt.Printf("🔷 Inference: scaled down to %d engines (load %.2f, removed %s)\n",
+			total-1, load, victim)
+	}
+}
+
+// EngineCount returns the current total engine count
+func (cis *ConcurrentInferenceSystem) EngineCount() int {
+	cis.mu.RLock()
+	defer cis.mu.RUnlock()
+	return 3 + len(cis.auxEngines)
+}
@@ -0,0 +1,282 @@
+package echobeats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const githubAPIBase = "https://api.github.com"
+
+// GitHubConnector watches a repository's issues and pull requests as
+// discussions. Drafted comments and triage labels go through an
+// approval queue, and dry-run mode (the default) never posts anything.
+// Configure with GITHUB_TOKEN and GITHUB_WATCH_REPO ("owner/repo").
+type GitHubConnector struct {
+	mu         sync.Mutex
+	token      string
+	repo       string // "owner/repo"
+	httpClient *http.Client
+
+	// DryRun prevents any write to GitHub even after approval
+	DryRun bool
+
+	// Pending drafts awaiting approval before posting
+	approvalQueue []*DraftAction
+
+	// Incremental polling watermark
+	lastSeen time.Time
+}
+
+// DraftActionType classifies what a draft would do
+type DraftActionType string
+
+const (
+	DraftActionComment DraftActionType = "comment"
+	DraftActionLabel   DraftActionType = "label"
+)
+
+// DraftAction is a proposed comment or label change held for approval
+type DraftAction struct {
+	ID          string          `json:"id"`
+	Type        DraftActionType `json:"type"`
+	IssueNumber int             `json:"issue_number"`
+	Body        string          `json:"body,omitempty"`
+	Labels      []string        `json:"labels,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	Approved    bool            `json:"approved"`
+}
+
+// NewGitHubConnector creates a GitHub connector from the environment.
+// Dry-run mode is on unless GITHUB_CONNECTOR_LIVE=true.
+func NewGitHubConnector() *GitHubConnector {
+	return &GitHubConnector{
+		token:         os.Getenv("GITHUB_TOKEN"),
+		repo:          os.Getenv("GITHUB_WATCH_REPO"),
+		httpClient:    &http.Client{Timeout: 15 * time.Second},
+		DryRun:        os.Getenv("GITHUB_CONNECTOR_LIVE") != "true",
+		approvalQueue: make([]*DraftAction, 0),
+		lastSeen:      time.Now(),
+	}
+}
+
+// Name identifies the connector
+func (gc *GitHubConnector) Name() string { return "github" }
+
+// Available reports whether the connector is configured
+func (gc *GitHubConnector) Available() bool {
+	return gc.token != "" && strings.Contains(gc.repo, "/")
+}
+
+// githubIssue is the subset of the issues API we use (PRs included)
+type githubIssue struct {
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	UpdatedAt string `json:"updated_at"`
+	User      struct {
+		Login string `json:"login"`
+		Type  string `json:"type"`
+	} `json:"user"`
+	PullRequest *struct{} `json:"pull_request,omitempty"`
+}
+
+// Poll fetches issues and PRs updated since the last poll
+func (gc *GitHubConnector) Poll(ctx context.Context) ([]IncomingMessage, error) {
+	gc.mu.Lock()
+	since := gc.lastSeen
+	gc.mu.Unlock()
+
+	url := fmt.Sprintf("%s/repos/%s/issues?state=open&sort=updated&since=%s",
+		githubAPIBase, gc.repo, since.UTC().Format(time.RFC3339))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+gc.token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := gc.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("github API returned %d", resp.StatusCode)
+	}
+
+	var issues []githubIssue
+	if err := json.NewDecoder(resp.Body).Decode(&issues); err != nil {
+		return nil, err
+	}
+
+	gc.mu.Lock()
+	gc.lastSeen = time.Now()
+	gc.mu.Unlock()
+
+	incoming := make([]IncomingMessage, 0, len(issues))
+	for _, issue := range issues {
+		if issue.User.Type == "Bot" {
+			continue
+		}
+
+		kind := "issue"
+		if issue.PullRequest != nil {
+			kind = "pull request"
+		}
+
+		timestamp, _ := time.Parse(time.RFC3339, issue.UpdatedAt)
+		incoming = append(incoming, IncomingMessage{
+			Connector: gc.Name(),
+			ChannelID: fmt.Sprintf("%d", issue.Number),
+			Topic:     issue.Title,
+			Sender:    issue.User.Login,
+			Content:   issue.Body,
+			Timestamp: timestamp,
+			Context: map[string]interface{}{
+				"repo": gc.repo,
+				"kind": kind,
+			},
+		})
+	}
+
+	return incoming, nil
+}
+
+// SendMessage drafts a comment on the issue/PR and places it in the
+// approval queue rather than posting directly
+func (gc *GitHubConnector) SendMessage(ctx context.Context, channelID, content string) error {
+	var issueNumber int
+	if _, err := fmt.Sscanf(channelID, "%d", &issueNumber); err != nil {
+		return fmt.Errorf("invalid issue number %q", channelID)
+	}
+
+	gc.enqueueDraft(&DraftAction{
+		ID:          fmt.Sprintf("draft_%d", time.Now().UnixNano()),
+		Type:        DraftActionComment,
+		IssueNumber: issueNumber,
+		Body:        content,
+		CreatedAt:   time.Now(),
+	})
+
+	return nil
+}
+
+// DraftLabels queues a triage label suggestion for an issue
+func (gc *GitHubConnector) DraftLabels(issueNumber int, labels []string) {
+	gc.enqueueDraft(&DraftAction{
+		ID:          fmt.Sprintf("draft_%d", time.Now().UnixNano()),
+		Type:        DraftActionLabel,
+		IssueNumber: issueNumber,
+		Labels:      labels,
+		CreatedAt:   time.Now(),
+	})
+}
+
+func (gc *GitHubConnector) enqueueDraft(draft *DraftAction) {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	gc.approvalQueue = append(gc.approvalQueue, draft)
+	fmt.Printf("📋 GitHub draft queued for approval: %s on #%d\n", draft.Type, draft.IssueNumber)
+}
+
+// GetApprovalQueue returns all drafts awaiting approval
+func (gc *GitHubConnector) GetApprovalQueue() []*DraftAction {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	queue := make([]*DraftAction, len(gc.approvalQueue))
+	copy(queue, gc.approvalQueue)
+	return queue
+}
+
+// ApproveDraft approves a queued draft by ID and posts it to GitHub
+// (unless dry-run mode is active, in which case it is only logged)
+func (gc *GitHubConnector) ApproveDraft(ctx context.Context, draftID string) error {
+	gc.mu.Lock()
+	var draft *DraftAction
+	for i, d := range gc.approvalQueue {
+		if d.ID == draftID {
+			draft = d
+			gc.approvalQueue = append(gc.approvalQueue[:i], gc.approvalQueue[i+1:]...)
+			break
+		}
+	}
+	dryRun := gc.DryRun
+	gc.mu.Unlock()
+
+	if draft == nil {
+		return fmt.Errorf("draft %s not found", draftID)
+	}
+
+	draft.Approved = true
+
+	if dryRun {
+		fmt.Printf("🔍 Dry-run: would post %s to #%d\n", draft.Type, draft.IssueNumber)
+		return nil
+	}
+
+	switch draft.Type {
+	case DraftActionComment:
+		return gc.postJSON(ctx,
+			fmt.Sprintf("%s/repos/%s/issues/%d/comments", githubAPIBase, gc.repo, draft.IssueNumber),
+			map[string]interface{}{"body": draft.Body})
+	case DraftActionLabel:
+		return gc.postJSON(ctx,
+			fmt.Sprintf("%s/repos/%s/issues/%d/labels", githubAPIBase, gc.repo, draft.IssueNumber),
+			map[string]interface{}{"labels": draft.Labels})
+	}
+
+	return fmt.Errorf("unknown draft type %s", draft.Type)
+}
+
+// RejectDraft discards a queued draft by ID
+func (gc *GitHubConnector) RejectDraft(draftID string) error {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	for i, d := range gc.approvalQueue {
+		if d.ID == draftID {
+			gc.approvalQueue = append(gc.approvalQueue[:i], gc.approvalQueue[i+1:]...)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("draft %s not found", draftID)
+}
+
+// postJSON performs one authenticated POST against the GitHub API
+func (gc *GitHubConnector) postJSON(ctx context.Context, url string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(string(data)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+gc.token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := gc.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("github API returned %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package echobeats
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// MergePolicy decides how conflicting interests are reconciled when
+// two interest landscapes are combined
+type MergePolicy int
+
+const (
+	// MergePreferLocal keeps the local interest when names collide
+	MergePreferLocal MergePolicy = iota
+	// MergePreferImported takes the imported interest on collision
+	MergePreferImported
+	// MergeCombine blends colliding interests: strongest signal wins
+	// per field, engagement history is summed
+	MergeCombine
+)
+
+func (mp MergePolicy) String() string {
+	return [...]string{"PreferLocal", "PreferImported", "Combine"}[mp]
+}
+
+// ExportToFile writes the interest landscape to a JSON file
+func (ips *InterestPatternSystem) ExportToFile(path string) error {
+	data, err := ips.ExportState()
+	if err != nil {
+		return fmt.Errorf("failed to export interests: %w", err)
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create export directory: %w", err)
+		}
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write interest export: %w", err)
+	}
+
+	fmt.Printf("💾 Interest Patterns: exported to %s\n", path)
+	return nil
+}
+
+// ImportFromFile replaces the interest landscape from a JSON export
+func (ips *InterestPatternSystem) ImportFromFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read interest export: %w", err)
+	}
+	return ips.ImportState(data)
+}
+
+// MergeFromFile combines an exported interest landscape into this one
+// under the given conflict policy
+func (ips *InterestPatternSystem) MergeFromFile(path string, policy MergePolicy) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read interest export: %w", err)
+	}
+	return ips.Merge(data, policy)
+}
+
+// Merge combines serialized interest state (from ExportState) into the
+// local landscape. Interests are matched by name; the policy decides
+// what happens on collision.
+func (ips *InterestPatternSystem) Merge(data []byte, policy MergePolicy) error {
+	var imported interestExportState
+	if err := json.Unmarshal(data, &imported); err != nil {
+		return fmt.Errorf("failed to unmarshal interest state: %w", err)
+	}
+
+	ips.mu.Lock()
+	defer ips.mu.Unlock()
+
+	// Index local interests by name for collision detection
+	byName := make(map[string]*Interest, len(ips.interests))
+	for _, interest := range ips.interests {
+		byName[interest.Name] = interest
+	}
+
+	added, merged, kept := 0, 0, 0
+	for id, incoming := range imported.Interests {
+		local, exists := byName[incoming.Name]
+		if !exists {
+			copied := *incoming
+			ips.interests[id] = &copied
+			added++
+			continue
+		}
+
+		switch policy {
+		case MergePreferImported:
+			*local = *incoming
+			merged++
+		case MergeCombine:
+			combineInterests(local, incoming)
+			merged++
+		default: // MergePreferLocal
+			kept++
+		}
+	}
+
+	// Curiosity parameters: combine takes the more curious of the two
+	if policy == MergeCombine || policy == MergePreferImported {
+		if imported.CuriosityLevel > ips.curiosityLevel {
+			ips.curiosityLevel = imported.CuriosityLevel
+		}
+	}
+
+	fmt.Printf("🔀 Interest merge (%s): %d added, %d merged, %d kept\n",
+		policy, added, merged, kept)
+
+	return nil
+}
+
+// combineInterests blends an imported interest into the local one:
+// the strongest signal wins per field and engagement history is summed
+func combineInterests(local, incoming *Interest) {
+	if incoming.Strength > local.Strength {
+		local.Strength = incoming.Strength
+	}
+	if incoming.Salience > local.Salience {
+		local.Salience = incoming.Salience
+	}
+	if incoming.Competence > local.Competence {
+		local.Competence = incoming.Competence
+	}
+	if incoming.Familiarity > local.Familiarity {
+		local.Familiarity = incoming.Familiarity
+	}
+	if incoming.LastEngaged.After(local.LastEngaged) {
+		local.LastEngaged = incoming.LastEngaged
+	}
+
+	local.TotalEngagement += incoming.TotalEngagement
+	local.EngagementCount += incoming.EngagementCount
+
+	// Union related topics without duplicates
+	seen := make(map[string]bool, len(local.RelatedTopics))
+	for _, topic := range local.RelatedTopics {
+		seen[topic] = true
+	}
+	for _, topic := range incoming.RelatedTopics {
+		if !seen[topic] {
+			local.RelatedTopics = append(local.RelatedTopics, topic)
+			seen[topic] = true
+		}
+	}
+}
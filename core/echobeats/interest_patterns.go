@@ -525,6 +525,58 @@ func (ips *InterestPatternSystem) PersistState() {
 	ips.persistState()
 }
 
+// interestExportState is the serialized form of the interest system
+type interestExportState struct {
+	Interests       map[string]*Interest `json:"interests"`
+	CuriosityLevel  float64              `json:"curiosity_level"`
+	ExplorationRate float64              `json:"exploration_rate"`
+	ExportedAt      time.Time            `json:"exported_at"`
+}
+
+// ExportState serializes the full interest landscape for archives and
+// transfer between hosts
+func (ips *InterestPatternSystem) ExportState() ([]byte, error) {
+	ips.mu.RLock()
+	state := interestExportState{
+		Interests:       make(map[string]*Interest, len(ips.interests)),
+		CuriosityLevel:  ips.curiosityLevel,
+		ExplorationRate: ips.explorationRate,
+		ExportedAt:      time.Now(),
+	}
+	for id, interest := range ips.interests {
+		copied := *interest
+		state.Interests[id] = &copied
+	}
+	ips.mu.RUnlock()
+
+	return json.MarshalIndent(state, "", "  ")
+}
+
+// ImportState replaces the interest landscape with serialized state
+// produced by ExportState
+func (ips *InterestPatternSystem) ImportState(data []byte) error {
+	var state interestExportState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("failed to unmarshal interest state: %w", err)
+	}
+
+	ips.mu.Lock()
+	defer ips.mu.Unlock()
+
+	if state.Interests != nil {
+		ips.interests = state.Interests
+	}
+	if state.CuriosityLevel > 0 {
+		ips.curiosityLevel = state.CuriosityLevel
+	}
+	if state.ExplorationRate > 0 {
+		ips.explorationRate = state.ExplorationRate
+	}
+
+	fmt.Printf("💾 Interest Patterns: imported %d interest(s)\n", len(state.Interests))
+	return nil
+}
+
 // Helper functions
 
 func generateInterestID(name string) string {
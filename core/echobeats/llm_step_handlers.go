@@ -3,8 +3,9 @@ package echobeats
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
-	
+
 	"github.com/EchoCog/echollama/core/llm"
 )
 
@@ -141,6 +142,10 @@ type TwelveStepCognitiveLoop struct {
 	// Metrics
 	cycleCount        uint64
 	lastCycleTime     time.Time
+
+	// Scheme-defined step overrides (hot-swappable at runtime)
+	overridesMu       sync.RWMutex
+	stepOverrides     map[int]schemeStepOverride
 }
 
 // NewTwelveStepCognitiveLoop creates a new 12-step cognitive loop
@@ -158,6 +163,7 @@ func NewTwelveStepCognitiveLoop(llmProvider llm.LLMProvider, identity string, st
 		salienceOutputs:   make(map[int]string),
 		pastContext:       make([]interface{}, 0),
 		futureOptions:     make([]interface{}, 0),
+		stepOverrides:     make(map[int]schemeStepOverride),
 	}
 }
 
@@ -212,7 +218,14 @@ func (loop *TwelveStepCognitiveLoop) run() {
 // processCurrentStep processes the current step
 func (loop *TwelveStepCognitiveLoop) processCurrentStep() {
 	step := loop.currentStep
-	
+
+	// A Scheme-defined procedure takes precedence over the built-in
+	// LLM handler for this step
+	if output, ok := loop.runSchemeStep(step); ok {
+		loop.recordStepOutput(step, output)
+		return
+	}
+
 	switch {
 	case step == 0:
 		// Pivotal relevance realization (step 0)
@@ -0,0 +1,177 @@
+package echobeats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MatrixConnector bridges Matrix rooms into the discussion manager via
+// the client-server API. Configure with MATRIX_HOMESERVER (e.g.
+// https://matrix.org), MATRIX_ACCESS_TOKEN, and a comma-separated
+// MATRIX_ROOM_IDS list.
+type MatrixConnector struct {
+	mu         sync.Mutex
+	homeserver string
+	token      string
+	userID     string
+	roomIDs    []string
+	httpClient *http.Client
+
+	// Pagination token per room for incremental polling
+	sinceTokens map[string]string
+	txnCounter  uint64
+}
+
+// NewMatrixConnector creates a Matrix connector from the environment
+func NewMatrixConnector() *MatrixConnector {
+	rooms := make([]string, 0)
+	for _, id := range strings.Split(os.Getenv("MATRIX_ROOM_IDS"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			rooms = append(rooms, id)
+		}
+	}
+
+	return &MatrixConnector{
+		homeserver:  strings.TrimSuffix(os.Getenv("MATRIX_HOMESERVER"), "/"),
+		token:       os.Getenv("MATRIX_ACCESS_TOKEN"),
+		userID:      os.Getenv("MATRIX_USER_ID"),
+		roomIDs:     rooms,
+		httpClient:  &http.Client{Timeout: 15 * time.Second},
+		sinceTokens: make(map[string]string),
+	}
+}
+
+// Name identifies the connector
+func (mc *MatrixConnector) Name() string { return "matrix" }
+
+// Available reports whether the connector is configured
+func (mc *MatrixConnector) Available() bool {
+	return mc.homeserver != "" && mc.token != "" && len(mc.roomIDs) > 0
+}
+
+// matrixMessagesResponse is the subset of /rooms/{id}/messages we use
+type matrixMessagesResponse struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+	Chunk []struct {
+		Type           string `json:"type"`
+		Sender         string `json:"sender"`
+		OriginServerTS int64  `json:"origin_server_ts"`
+		Content        struct {
+			MsgType string `json:"msgtype"`
+			Body    string `json:"body"`
+		} `json:"content"`
+	} `json:"chunk"`
+}
+
+// Poll fetches room events newer than the last pagination token
+func (mc *MatrixConnector) Poll(ctx context.Context) ([]IncomingMessage, error) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	incoming := make([]IncomingMessage, 0)
+
+	for _, roomID := range mc.roomIDs {
+		since, primed := mc.sinceTokens[roomID]
+
+		params := url.Values{"dir": {"f"}, "limit": {"20"}}
+		if since != "" {
+			params.Set("from", since)
+		}
+
+		endpoint := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/messages?%s",
+			mc.homeserver, url.PathEscape(roomID), params.Encode())
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return incoming, err
+		}
+		req.Header.Set("Authorization", "Bearer "+mc.token)
+
+		resp, err := mc.httpClient.Do(req)
+		if err != nil {
+			return incoming, fmt.Errorf("room %s: %w", roomID, err)
+		}
+
+		var result matrixMessagesResponse
+		decodeErr := json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return incoming, fmt.Errorf("room %s: matrix API returned %d", roomID, resp.StatusCode)
+		}
+		if decodeErr != nil {
+			return incoming, decodeErr
+		}
+
+		mc.sinceTokens[roomID] = result.End
+
+		// First poll only establishes the pagination position
+		if !primed {
+			continue
+		}
+
+		for _, event := range result.Chunk {
+			if event.Type != "m.room.message" || event.Content.MsgType != "m.text" {
+				continue
+			}
+			if mc.userID != "" && event.Sender == mc.userID {
+				continue
+			}
+
+			incoming = append(incoming, IncomingMessage{
+				Connector: mc.Name(),
+				ChannelID: roomID,
+				Sender:    event.Sender,
+				Content:   event.Content.Body,
+				Timestamp: time.UnixMilli(event.OriginServerTS),
+			})
+		}
+	}
+
+	return incoming, nil
+}
+
+// SendMessage posts a text message into a Matrix room
+func (mc *MatrixConnector) SendMessage(ctx context.Context, roomID, content string) error {
+	mc.mu.Lock()
+	mc.txnCounter++
+	txnID := fmt.Sprintf("ecco9_%d_%d", time.Now().UnixNano(), mc.txnCounter)
+	mc.mu.Unlock()
+
+	payload, err := json.Marshal(map[string]string{
+		"msgtype": "m.text",
+		"body":    content,
+	})
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		mc.homeserver, url.PathEscape(roomID), txnID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, strings.NewReader(string(payload)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+mc.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := mc.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("matrix API returned %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package echobeats
+
+import (
+	"container/heap"
+	"time"
+)
+
+// DefaultAgingRate is how many priority points a waiting event gains
+// per minute past its scheduled time, so low-priority events
+// eventually outrank a sustained stream of high-priority work
+const DefaultAgingRate = 2.0
+
+// StarvationThreshold is how long past its scheduled time an event
+// may wait before it counts as starved in the metrics
+const StarvationThreshold = 5 * time.Minute
+
+// SetAgingRate configures priority aging (points per minute waiting).
+// A rate of 0 restores plain static-priority behavior for events
+// scheduled afterwards.
+func (eb *EchoBeats) SetAgingRate(rate float64) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+	eb.agingRate = rate
+}
+
+// effectivePriority is the event's priority boosted by how long it
+// has waited past its scheduled time
+func effectivePriority(event *CognitiveEvent, now time.Time) float64 {
+	priority := float64(event.Priority)
+	if event.agingRate <= 0 {
+		return priority
+	}
+
+	waited := now.Sub(event.ScheduledAt)
+	if waited <= 0 {
+		return priority
+	}
+	return priority + event.agingRate*waited.Minutes()
+}
+
+// reheapify re-establishes heap order under aged priorities; called
+// periodically since effective priorities drift as events wait
+func (eb *EchoBeats) reheapify() {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+	heap.Init(eb.eventQueue)
+}
+
+// recordWait updates starvation metrics for a dequeued event
+func (eb *EchoBeats) recordWait(event *CognitiveEvent, now time.Time) {
+	waited := now.Sub(event.ScheduledAt)
+	if waited <= 0 {
+		return
+	}
+
+	eb.metrics.mu.Lock()
+	defer eb.metrics.mu.Unlock()
+
+	if waited > eb.metrics.MaxEventWait {
+		eb.metrics.MaxEventWait = waited
+	}
+	if waited > StarvationThreshold {
+		eb.metrics.StarvedEvents++
+	}
+}
@@ -0,0 +1,397 @@
+package echobeats
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ScheduleKind distinguishes the supported schedule expression forms
+type ScheduleKind int
+
+const (
+	// ScheduleCron is a 5-field cron expression: "min hour dom month dow"
+	ScheduleCron ScheduleKind = iota
+	// ScheduleEvery is "every N <seconds|minutes|hours> [while awake]"
+	ScheduleEvery
+	// ScheduleCircadian is "at circadian phase <name>" (daily)
+	ScheduleCircadian
+)
+
+// circadianPhases maps named circadian phases to the hour of day they
+// begin, aligned with the wake/rest cycle's natural rhythm
+var circadianPhases = map[string]int{
+	"midnight":  0,
+	"night":     3,
+	"dawn":      6,
+	"morning":   9,
+	"noon":      12,
+	"afternoon": 15,
+	"dusk":      18,
+	"evening":   21,
+}
+
+// Schedule is a parsed scheduling expression for recurring cognitive
+// events, supporting cron syntax plus the EchoBeats-specific forms
+// "every N minutes while awake" and "at circadian phase dawn"
+type Schedule struct {
+	Expression string       `json:"expression"`
+	Kind       ScheduleKind `json:"kind"`
+
+	// ScheduleEvery
+	Interval  time.Duration `json:"interval,omitempty"`
+	AwakeOnly bool          `json:"awake_only,omitempty"`
+
+	// ScheduleCircadian
+	PhaseHour int `json:"phase_hour,omitempty"`
+
+	// ScheduleCron: allowed values per field
+	minutes  map[int]bool
+	hours    map[int]bool
+	days     map[int]bool
+	months   map[int]bool
+	weekdays map[int]bool
+}
+
+// ParseSchedule parses a scheduling expression
+func ParseSchedule(expr string) (*Schedule, error) {
+	trimmed := strings.TrimSpace(strings.ToLower(expr))
+	if trimmed == "" {
+		return nil, fmt.Errorf("empty schedule expression")
+	}
+
+	if strings.HasPrefix(trimmed, "every ") {
+		return parseEvery(expr, trimmed)
+	}
+	if strings.HasPrefix(trimmed, "at circadian phase ") {
+		return parseCircadian(expr, trimmed)
+	}
+	return parseCron(expr, trimmed)
+}
+
+// parseEvery parses "every N <unit> [while awake]"
+func parseEvery(original, expr string) (*Schedule, error) {
+	awakeOnly := false
+	if strings.HasSuffix(expr, " while awake") {
+		awakeOnly = true
+		expr = strings.TrimSuffix(expr, " while awake")
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("expected \"every N <unit>\", got %q", original)
+	}
+
+	n, err := strconv.Atoi(fields[1])
+	if err != nil || n <= 0 {
+		return nil, fmt.Errorf("invalid interval count %q", fields[1])
+	}
+
+	var unit time.Duration
+	switch strings.TrimSuffix(fields[2], "s") {
+	case "second", "sec":
+		unit = time.Second
+	case "minute", "min":
+		unit = time.Minute
+	case "hour", "hr":
+		unit = time.Hour
+	default:
+		return nil, fmt.Errorf("unknown interval unit %q", fields[2])
+	}
+
+	return &Schedule{
+		Expression: original,
+		Kind:       ScheduleEvery,
+		Interval:   time.Duration(n) * unit,
+		AwakeOnly:  awakeOnly,
+	}, nil
+}
+
+// parseCircadian parses "at circadian phase <name>"
+func parseCircadian(original, expr string) (*Schedule, error) {
+	phase := strings.TrimSpace(strings.TrimPrefix(expr, "at circadian phase"))
+	hour, ok := circadianPhases[phase]
+	if !ok {
+		names := make([]string, 0, len(circadianPhases))
+		for name := range circadianPhases {
+			names = append(names, name)
+		}
+		return nil, fmt.Errorf("unknown circadian phase %q (known: %s)",
+			phase, strings.Join(names, ", "))
+	}
+
+	return &Schedule{
+		Expression: original,
+		Kind:       ScheduleCircadian,
+		PhaseHour:  hour,
+	}, nil
+}
+
+// parseCron parses a 5-field cron expression
+func parseCron(original, expr string) (*Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression needs 5 fields (min hour dom month dow), got %d", len(fields))
+	}
+
+	schedule := &Schedule{Expression: original, Kind: ScheduleCron}
+
+	var err error
+	if schedule.minutes, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("minute field: %w", err)
+	}
+	if schedule.hours, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("hour field: %w", err)
+	}
+	if schedule.days, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("day field: %w", err)
+	}
+	if schedule.months, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("month field: %w", err)
+	}
+	if schedule.weekdays, err = parseCronField(fields[4], 0, 6); err != nil {
+		return nil, fmt.Errorf("weekday field: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// parseCronField parses one cron field supporting *, lists, ranges,
+// and steps (*/n, a-b/n)
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	allowed := make(map[int]bool)
+
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			s, err := strconv.Atoi(part[idx+1:])
+			if err != nil || s <= 0 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			step = s
+			part = part[:idx]
+		}
+
+		lo, hi := min, max
+		switch {
+		case part == "*":
+			// Full range
+		case strings.Contains(part, "-"):
+			bounds := strings.SplitN(part, "-", 2)
+			var err1, err2 error
+			lo, err1 = strconv.Atoi(bounds[0])
+			hi, err2 = strconv.Atoi(bounds[1])
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("invalid range %q", part)
+			}
+		default:
+			v, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", part)
+			}
+			lo, hi = v, v
+		}
+
+		if lo < min || hi > max || lo > hi {
+			return nil, fmt.Errorf("value out of range [%d, %d] in %q", min, max, part)
+		}
+		for v := lo; v <= hi; v += step {
+			allowed[v] = true
+		}
+	}
+
+	return allowed, nil
+}
+
+// NextFire computes the next time the schedule fires strictly after
+// the given time
+func (s *Schedule) NextFire(after time.Time) (time.Time, error) {
+	switch s.Kind {
+	case ScheduleEvery:
+		return after.Add(s.Interval), nil
+
+	case ScheduleCircadian:
+		next := time.Date(after.Year(), after.Month(), after.Day(),
+			s.PhaseHour, 0, 0, 0, after.Location())
+		if !next.After(after) {
+			next = next.Add(24 * time.Hour)
+		}
+		return next, nil
+
+	case ScheduleCron:
+		// Scan minute by minute; cron schedules fire at least yearly
+		candidate := after.Truncate(time.Minute).Add(time.Minute)
+		limit := after.Add(366 * 24 * time.Hour)
+		for candidate.Before(limit) {
+			if s.minutes[candidate.Minute()] &&
+				s.hours[candidate.Hour()] &&
+				s.days[candidate.Day()] &&
+				s.months[int(candidate.Month())] &&
+				s.weekdays[int(candidate.Weekday())] {
+				return candidate, nil
+			}
+			candidate = candidate.Add(time.Minute)
+		}
+		return time.Time{}, fmt.Errorf("schedule %q never fires", s.Expression)
+
+	default:
+		return time.Time{}, fmt.Errorf("unknown schedule kind %d", s.Kind)
+	}
+}
+
+// RecurringSchedule is a persisted recurring event definition
+type RecurringSchedule struct {
+	ID         string    `json:"id"`
+	Expression string    `json:"expression"`
+	EventType  EventType `json:"event_type"`
+	Priority   int       `json:"priority"`
+	Payload    string    `json:"payload"`
+}
+
+// ScheduleRecurring registers a recurring event from a scheduling
+// expression and arms its first occurrence
+func (eb *EchoBeats) ScheduleRecurring(expr string, eventType EventType, priority int, payload string) (string, error) {
+	schedule, err := ParseSchedule(expr)
+	if err != nil {
+		return "", fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	next, err := schedule.NextFire(time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	recurring := &RecurringSchedule{
+		ID:         generateID(),
+		Expression: expr,
+		EventType:  eventType,
+		Priority:   priority,
+		Payload:    payload,
+	}
+
+	eb.mu.Lock()
+	if eb.recurringSchedules == nil {
+		eb.recurringSchedules = make(map[string]*RecurringSchedule)
+	}
+	eb.recurringSchedules[recurring.ID] = recurring
+	eb.mu.Unlock()
+
+	eb.ScheduleEvent(&CognitiveEvent{
+		ID:           recurring.ID + "_next",
+		Type:         eventType,
+		Priority:     priority,
+		ScheduledAt:  next,
+		Payload:      payload,
+		ScheduleExpr: expr,
+	})
+
+	fmt.Printf("🎵 EchoBeats: recurring %s scheduled %q (next: %s)\n",
+		eventType, expr, next.Format(time.RFC3339))
+	return recurring.ID, nil
+}
+
+// CancelRecurring removes a recurring schedule; its already-armed
+// occurrence still fires once but is not rescheduled
+func (eb *EchoBeats) CancelRecurring(id string) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+	delete(eb.recurringSchedules, id)
+}
+
+// SaveSchedules persists the recurring schedules to a JSON file
+func (eb *EchoBeats) SaveSchedules(path string) error {
+	eb.mu.RLock()
+	schedules := make([]*RecurringSchedule, 0, len(eb.recurringSchedules))
+	for _, schedule := range eb.recurringSchedules {
+		schedules = append(schedules, schedule)
+	}
+	eb.mu.RUnlock()
+
+	data, err := json.MarshalIndent(schedules, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal schedules: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write schedules: %w", err)
+	}
+	return nil
+}
+
+// LoadSchedules restores recurring schedules from a JSON file and
+// re-arms each one. A missing file is not an error.
+func (eb *EchoBeats) LoadSchedules(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read schedules: %w", err)
+	}
+
+	var schedules []*RecurringSchedule
+	if err := json.Unmarshal(data, &schedules); err != nil {
+		return fmt.Errorf("failed to unmarshal schedules: %w", err)
+	}
+
+	for _, schedule := range schedules {
+		if _, err := eb.ScheduleRecurring(schedule.Expression,
+			schedule.EventType, schedule.Priority, schedule.Payload); err != nil {
+			fmt.Printf("⚠️  EchoBeats: could not restore schedule %q: %v\n",
+				schedule.Expression, err)
+		}
+	}
+
+	fmt.Printf("📚 EchoBeats: restored %d recurring schedule(s)\n", len(schedules))
+	return nil
+}
+
+// scheduleActive reports whether the recurring schedule that armed an
+// event still exists. Events armed by ScheduleRecurring carry the
+// schedule's ID plus a "_next" suffix; events with expressions set
+// directly are not registry-managed and stay active.
+func (eb *EchoBeats) scheduleActive(event *CognitiveEvent) bool {
+	if !strings.HasSuffix(event.ID, "_next") {
+		return true
+	}
+	eb.mu.RLock()
+	defer eb.mu.RUnlock()
+	_, active := eb.recurringSchedules[strings.TrimSuffix(event.ID, "_next")]
+	return active
+}
+
+// rescheduleFromExpression re-arms an event scheduled by expression,
+// honoring "while awake" by deferring until the next awake check.
+// Cancelled recurring schedules are dropped here instead of re-arming.
+func (eb *EchoBeats) rescheduleFromExpression(event *CognitiveEvent) {
+	if !eb.scheduleActive(event) {
+		return
+	}
+
+	schedule, err := ParseSchedule(event.ScheduleExpr)
+	if err != nil {
+		fmt.Printf("⚠️  EchoBeats: invalid schedule on event %s: %v\n", event.ID, err)
+		return
+	}
+
+	next, err := schedule.NextFire(time.Now())
+	if err != nil {
+		fmt.Printf("⚠️  EchoBeats: schedule %q will not fire again: %v\n",
+			event.ScheduleExpr, err)
+		return
+	}
+
+	event.ScheduledAt = next
+	eb.ScheduleEvent(event)
+}
+
+// isAwakeState reports whether the scheduler counts as awake for
+// "while awake" schedules
+func (eb *EchoBeats) isAwakeState() bool {
+	eb.mu.RLock()
+	defer eb.mu.RUnlock()
+	return eb.state == StateAwake || eb.state == StateThinking
+}
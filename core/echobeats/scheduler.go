@@ -32,7 +32,19 @@ type EchoBeats struct {
 	
 	// Event handlers
 	handlers        map[EventType][]EventHandler
-	
+
+	// Recurring schedules defined via the scheduling DSL
+	recurringSchedules map[string]*RecurringSchedule
+
+	// Crash-recovery journal for the pending queue (empty = disabled)
+	durablePath     string
+
+	// Priority aging rate applied to newly scheduled events
+	agingRate       float64
+
+	// Injectable time source for scheduling decisions (see virtual_time.go)
+	nowFunc         func() time.Time
+
 	// Running state
 	running         bool
 	heartbeat       *time.Ticker
@@ -89,6 +101,15 @@ type CognitiveEvent struct {
 	Context     map[string]interface{}
 	Recurring   bool
 	Interval    time.Duration
+
+	// ScheduleExpr, when set, drives recurrence via the scheduling
+	// DSL (cron, "every N minutes while awake", circadian phases)
+	ScheduleExpr string
+
+	// agingRate boosts priority while the event waits, preventing
+	// starvation under sustained high-priority load (see priority_aging.go)
+	agingRate   float64
+
 	index       int // for heap
 }
 
@@ -101,9 +122,12 @@ type PriorityQueue []*CognitiveEvent
 func (pq PriorityQueue) Len() int { return len(pq) }
 
 func (pq PriorityQueue) Less(i, j int) bool {
-	// Higher priority first, then earlier scheduled time
-	if pq[i].Priority != pq[j].Priority {
-		return pq[i].Priority > pq[j].Priority
+	// Higher effective (age-boosted) priority first, then earlier
+	// scheduled time
+	now := time.Now()
+	pi, pj := effectivePriority(pq[i], now), effectivePriority(pq[j], now)
+	if pi != pj {
+		return pi > pj
 	}
 	return pq[i].ScheduledAt.Before(pq[j].ScheduledAt)
 }
@@ -187,6 +211,10 @@ type SchedulerMetrics struct {
 	CurrentLoad         float64
 	AutonomousThoughts  uint64
 	LastHeartbeat       time.Time
+
+	// Starvation tracking (see priority_aging.go)
+	StarvedEvents       uint64
+	MaxEventWait        time.Duration
 }
 
 // NewEchoBeats creates a new EchoBeats scheduler
@@ -219,6 +247,8 @@ func NewEchoBeats() *EchoBeats {
 		metrics: &SchedulerMetrics{
 			LastHeartbeat: time.Now(),
 		},
+		agingRate: DefaultAgingRate,
+		nowFunc:   time.Now,
 	}
 	
 	// Register default handlers
@@ -280,17 +310,21 @@ func (eb *EchoBeats) ScheduleEvent(event *CognitiveEvent) {
 	defer eb.mu.Unlock()
 	
 	if event.Timestamp.IsZero() {
-		event.Timestamp = time.Now()
+		event.Timestamp = eb.nowFunc()
 	}
 	if event.ScheduledAt.IsZero() {
-		event.ScheduledAt = time.Now()
+		event.ScheduledAt = eb.nowFunc()
 	}
 	if event.ID == "" {
 		event.ID = generateID()
 	}
-	
+	if event.agingRate == 0 {
+		event.agingRate = eb.agingRate
+	}
+
 	heap.Push(eb.eventQueue, event)
-	
+	eb.persistQueueLocked()
+
 	eb.metrics.mu.Lock()
 	eb.metrics.EventsScheduled++
 	eb.metrics.mu.Unlock()
@@ -319,44 +353,60 @@ func (eb *EchoBeats) eventLoop() {
 	}
 }
 
-// processNextEvent processes the next event in the queue
-func (eb *EchoBeats) processNextEvent() {
+// processNextEvent processes the next event in the queue, reporting
+// whether one was due
+func (eb *EchoBeats) processNextEvent() bool {
 	eb.mu.Lock()
-	
+
 	if eb.eventQueue.Len() == 0 {
 		eb.mu.Unlock()
-		return
+		return false
 	}
-	
+
 	// Peek at the next event
 	nextEvent := (*eb.eventQueue)[0]
-	
+
 	// Check if it's time to process
-	if time.Now().Before(nextEvent.ScheduledAt) {
+	if eb.nowFunc().Before(nextEvent.ScheduledAt) {
 		eb.mu.Unlock()
-		return
+		return false
 	}
-	
+
 	// Pop the event
 	event := heap.Pop(eb.eventQueue).(*CognitiveEvent)
+	eb.persistQueueLocked()
 	eb.mu.Unlock()
-	
+
+	eb.recordWait(event, eb.nowFunc())
+
+	// "while awake" schedules skip execution while resting but stay armed
+	if event.ScheduleExpr != "" {
+		if schedule, err := ParseSchedule(event.ScheduleExpr); err == nil &&
+			schedule.AwakeOnly && !eb.isAwakeState() {
+			eb.rescheduleFromExpression(event)
+			return true
+		}
+	}
+
 	// Process the event
 	start := time.Now()
 	eb.handleEvent(event)
 	latency := time.Since(start)
-	
+
 	// Update metrics
 	eb.metrics.mu.Lock()
 	eb.metrics.EventsProcessed++
 	eb.metrics.AverageLatency = (eb.metrics.AverageLatency + latency) / 2
 	eb.metrics.mu.Unlock()
-	
+
 	// Reschedule if recurring
-	if event.Recurring && event.Interval > 0 {
-		event.ScheduledAt = time.Now().Add(event.Interval)
+	if event.ScheduleExpr != "" {
+		eb.rescheduleFromExpression(event)
+	} else if event.Recurring && event.Interval > 0 {
+		event.ScheduledAt = eb.nowFunc().Add(event.Interval)
 		eb.ScheduleEvent(event)
 	}
+	return true
 }
 
 // handleEvent dispatches event to registered handlers
@@ -450,6 +500,7 @@ func (eb *EchoBeats) cycleManagement() {
 		case <-eb.ctx.Done():
 			return
 		case <-ticker.C:
+			eb.reheapify()
 			eb.manageCycle()
 		}
 	}
@@ -592,6 +643,8 @@ func (eb *EchoBeats) GetStatus() map[string]interface{} {
 		"cognitive_load":     eb.cycleManager.cognitiveLoad,
 		"fatigue_level":      eb.cycleManager.fatigueLevel,
 		"last_heartbeat":     eb.metrics.LastHeartbeat,
+		"starved_events":     eb.metrics.StarvedEvents,
+		"max_event_wait":     eb.metrics.MaxEventWait.String(),
 	}
 }
 
@@ -0,0 +1,111 @@
+package echobeats
+
+import (
+	"fmt"
+
+	"github.com/EchoCog/echollama/core/scheme"
+)
+
+// schemeStepOverride binds one loop step to a Scheme procedure
+// registered in a metamodel; the procedure is called as (proc step)
+type schemeStepOverride struct {
+	sm   *scheme.SchemeMetamodel
+	proc string
+}
+
+// DefineSchemeStep overrides a step of the 12-step loop with a Scheme
+// procedure registered in the metamodel. The procedure is applied to
+// the step number and its result becomes the step output, enabling
+// experimentation with alternative cognitive cycles without
+// recompiling. Overrides are hot-swappable while the loop runs.
+func (loop *TwelveStepCognitiveLoop) DefineSchemeStep(step int, procName string, sm *scheme.SchemeMetamodel) error {
+	if step < 0 || step > 11 {
+		return fmt.Errorf("step must be in [0, 11], got %d", step)
+	}
+	if sm == nil {
+		return fmt.Errorf("scheme metamodel required")
+	}
+
+	// Validate the procedure resolves to something applicable
+	value, err := sm.Eval(procName)
+	if err != nil {
+		return fmt.Errorf("scheme procedure %q not defined: %w", procName, err)
+	}
+	if t := value.Type(); t != "lambda" && t != "primitive" {
+		return fmt.Errorf("scheme binding %q is a %s, not a procedure", procName, t)
+	}
+
+	loop.overridesMu.Lock()
+	defer loop.overridesMu.Unlock()
+	loop.stepOverrides[step] = schemeStepOverride{sm: sm, proc: procName}
+
+	fmt.Printf("🎭 Step %d now handled by Scheme procedure %s\n", step, procName)
+	return nil
+}
+
+// ClearSchemeStep restores the built-in handler for a step
+func (loop *TwelveStepCognitiveLoop) ClearSchemeStep(step int) {
+	loop.overridesMu.Lock()
+	defer loop.overridesMu.Unlock()
+	delete(loop.stepOverrides, step)
+}
+
+// SchemeSteps lists the steps currently overridden by Scheme
+func (loop *TwelveStepCognitiveLoop) SchemeSteps() map[int]string {
+	loop.overridesMu.RLock()
+	defer loop.overridesMu.RUnlock()
+
+	steps := make(map[int]string, len(loop.stepOverrides))
+	for step, override := range loop.stepOverrides {
+		steps[step] = override.proc
+	}
+	return steps
+}
+
+// runSchemeStep executes the Scheme override for a step if one is
+// registered, returning its output and whether an override applied
+func (loop *TwelveStepCognitiveLoop) runSchemeStep(step int) (string, bool) {
+	loop.overridesMu.RLock()
+	override, ok := loop.stepOverrides[step]
+	loop.overridesMu.RUnlock()
+
+	if !ok {
+		return "", false
+	}
+
+	result, err := override.sm.Eval(fmt.Sprintf("(%s %d)", override.proc, step))
+	if err != nil {
+		fmt.Printf("⚠️  Step %d (Scheme %s) error: %v\n", step, override.proc, err)
+		return "", true // Override applies even on failure; no LLM fallback
+	}
+
+	output := schemeResultString(result)
+	fmt.Printf("🎭 Step %d (Scheme %s): %s\n", step, override.proc, truncate(output, 80))
+	return output, true
+}
+
+// recordStepOutput stores a step output in the engine map the
+// built-in handler would have used
+func (loop *TwelveStepCognitiveLoop) recordStepOutput(step int, output string) {
+	if output == "" {
+		return
+	}
+
+	switch {
+	case step == 0 || step == 6:
+		loop.relevanceOutputs[step] = output
+	case step >= 1 && step <= 5:
+		loop.affordanceOutputs[step] = output
+	case step >= 7 && step <= 11:
+		loop.salienceOutputs[step] = output
+	}
+}
+
+// schemeResultString renders a Scheme value as step output, unwrapping
+// strings so their quotes do not leak into the cognitive record
+func schemeResultString(value scheme.Value) string {
+	if str, ok := value.(*scheme.String); ok {
+		return str.Value
+	}
+	return value.String()
+}
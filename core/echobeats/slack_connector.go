@@ -0,0 +1,195 @@
+package echobeats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const slackAPIBase = "https://slack.com/api"
+
+// SlackConnector bridges Slack channels into the discussion manager
+// via the Web API with polling. Configure with SLACK_BOT_TOKEN and a
+// comma-separated SLACK_CHANNEL_IDS list.
+type SlackConnector struct {
+	mu         sync.Mutex
+	token      string
+	channelIDs []string
+	httpClient *http.Client
+
+	// Oldest-timestamp watermark per channel
+	lastTimestamp map[string]string
+}
+
+// NewSlackConnector creates a Slack connector from the environment
+func NewSlackConnector() *SlackConnector {
+	channels := make([]string, 0)
+	for _, id := range strings.Split(os.Getenv("SLACK_CHANNEL_IDS"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			channels = append(channels, id)
+		}
+	}
+
+	return &SlackConnector{
+		token:         os.Getenv("SLACK_BOT_TOKEN"),
+		channelIDs:    channels,
+		httpClient:    &http.Client{Timeout: 15 * time.Second},
+		lastTimestamp: make(map[string]string),
+	}
+}
+
+// Name identifies the connector
+func (sc *SlackConnector) Name() string { return "slack" }
+
+// Available reports whether the connector is configured
+func (sc *SlackConnector) Available() bool {
+	return sc.token != "" && len(sc.channelIDs) > 0
+}
+
+// slackHistoryResponse is the subset of conversations.history we use
+type slackHistoryResponse struct {
+	OK       bool   `json:"ok"`
+	Error    string `json:"error"`
+	Messages []struct {
+		Type    string `json:"type"`
+		Subtype string `json:"subtype"`
+		User    string `json:"user"`
+		BotID   string `json:"bot_id"`
+		Text    string `json:"text"`
+		TS      string `json:"ts"`
+	} `json:"messages"`
+}
+
+// Poll fetches messages newer than the last seen timestamp per channel
+func (sc *SlackConnector) Poll(ctx context.Context) ([]IncomingMessage, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	incoming := make([]IncomingMessage, 0)
+
+	for _, channelID := range sc.channelIDs {
+		oldest, primed := sc.lastTimestamp[channelID]
+
+		params := url.Values{"channel": {channelID}, "limit": {"20"}}
+		if oldest != "" {
+			params.Set("oldest", oldest)
+		}
+
+		var history slackHistoryResponse
+		if err := sc.apiGet(ctx, "conversations.history", params, &history); err != nil {
+			return incoming, fmt.Errorf("channel %s: %w", channelID, err)
+		}
+		if !history.OK {
+			return incoming, fmt.Errorf("channel %s: slack API error %s", channelID, history.Error)
+		}
+
+		if len(history.Messages) == 0 {
+			if !primed {
+				sc.lastTimestamp[channelID] = fmt.Sprintf("%d.000000", time.Now().Unix())
+			}
+			continue
+		}
+
+		// Slack returns newest first; record the watermark
+		sc.lastTimestamp[channelID] = history.Messages[0].TS
+
+		// First poll only establishes the watermark
+		if !primed {
+			continue
+		}
+
+		for i := len(history.Messages) - 1; i >= 0; i-- {
+			msg := history.Messages[i]
+			if msg.Type != "message" || msg.Subtype != "" || msg.BotID != "" || msg.Text == "" {
+				continue
+			}
+			if msg.TS == oldest {
+				continue
+			}
+
+			incoming = append(incoming, IncomingMessage{
+				Connector: sc.Name(),
+				ChannelID: channelID,
+				Sender:    msg.User,
+				Content:   msg.Text,
+				Timestamp: slackTimestamp(msg.TS),
+			})
+		}
+	}
+
+	return incoming, nil
+}
+
+// apiGet performs one authenticated GET against the Slack Web API
+func (sc *SlackConnector) apiGet(ctx context.Context, method string, params url.Values, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		slackAPIBase+"/"+method+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+sc.token)
+
+	resp, err := sc.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack API returned %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// SendMessage posts a message to a Slack channel
+func (sc *SlackConnector) SendMessage(ctx context.Context, channelID, content string) error {
+	payload, err := json.Marshal(map[string]string{
+		"channel": channelID,
+		"text":    content,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		slackAPIBase+"/chat.postMessage", strings.NewReader(string(payload)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+sc.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := sc.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+	if !result.OK {
+		return fmt.Errorf("slack API error: %s", result.Error)
+	}
+
+	return nil
+}
+
+// slackTimestamp converts a Slack "seconds.micros" timestamp
+func slackTimestamp(ts string) time.Time {
+	var seconds int64
+	var micros int64
+	fmt.Sscanf(ts, "%d.%d", &seconds, &micros)
+	return time.Unix(seconds, micros*1000)
+}
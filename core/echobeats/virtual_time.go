@@ -0,0 +1,32 @@
+package echobeats
+
+import "time"
+
+// Virtual-time support: the scheduler normally runs its own real-time
+// event loop, but simulations (see core/simulation) need to drive it
+// from an injected clock so days of scheduled events replay in
+// milliseconds. SetTimeSource swaps the clock and Tick replaces the
+// internal loop as the processing trigger.
+
+// SetTimeSource replaces the wall clock used for scheduling decisions
+// (due checks, recurrence, default timestamps). Call it before any
+// events are scheduled; combine with Tick instead of Start to drive
+// the queue entirely from virtual time.
+func (eb *EchoBeats) SetTimeSource(now func() time.Time) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	eb.nowFunc = now
+}
+
+// Tick processes every event due at the current time source reading
+// and returns how many were handled. The internal event loop polls
+// processNextEvent on a real ticker; virtual-time drivers call Tick
+// after each clock advance instead.
+func (eb *EchoBeats) Tick() int {
+	processed := 0
+	for eb.processNextEvent() {
+		processed++
+	}
+	return processed
+}
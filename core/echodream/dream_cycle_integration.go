@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/EchoCog/echollama/core/memory"
 )
 
 // DreamCycleIntegration manages knowledge consolidation during rest/dream states
@@ -38,6 +40,30 @@ type DreamCycleIntegration struct {
 	// Callbacks
 	onWisdomExtracted     func(wisdom Wisdom)
 	onDreamComplete       func(dream *Dream)
+
+	// Persistent journal (optional)
+	journal               *DreamJournal
+	dreamsReplayed        uint64
+
+	// Hypergraph consolidation (optional)
+	hypergraph            *memory.HypergraphMemory
+	graphNodes            uint64
+	graphEdges            uint64
+
+	// Phased (NREM/REM) cycle metrics
+	phaseMetrics          map[SleepPhase]*PhaseMetrics
+
+	// Lucid mode query log
+	lucidQueries          uint64
+	lucidLog              []*LucidAnswer
+
+	// Pluggable wisdom extractors
+	extractorPipeline     []registeredExtractor
+
+	// Nightmare detection and quarantine
+	nightmareConfig       NightmareConfig
+	quarantine            []QuarantineEntry
+	nightmaresDetected    uint64
 }
 
 // Dream represents a dream cycle
@@ -263,6 +289,21 @@ func (dci *DreamCycleIntegration) EndDreamCycle() error {
 			dci.dreamHistory = dci.dreamHistory[len(dci.dreamHistory)-dci.maxDreamHistory:]
 		}
 		
+		// Pathological consolidation is quarantined, not integrated
+		nightmareReasons := dci.checkForNightmare(dci.currentDream)
+
+		// Consolidate dream output into the hypergraph
+		if len(nightmareReasons) == 0 {
+			dci.integrateDreamIntoGraph(dci.currentDream)
+		}
+
+		// Persist to journal
+		if dci.journal != nil {
+			if err := dci.journal.Record(dci.currentDream); err != nil {
+				fmt.Printf("⚠️ EchoDream: failed to record dream: %v\n", err)
+			}
+		}
+
 		// Callback
 		if dci.onDreamComplete != nil {
 			dci.onDreamComplete(dci.currentDream)
@@ -288,7 +329,8 @@ func (dci *DreamCycleIntegration) AddEpisodicMemory(memory DreamEpisodicMemory)
 	defer dci.mu.Unlock()
 	
 	dci.episodicBuffer = append(dci.episodicBuffer, memory)
-	
+	dci.mirrorEpisodicMemory(memory)
+
 	fmt.Printf("📝 EchoDream: Added episodic memory (buffer size: %d)\n", len(dci.episodicBuffer))
 }
 
@@ -357,28 +399,34 @@ func (dci *DreamCycleIntegration) groupSimilarMemories(memories []DreamEpisodicM
 	return result
 }
 
-// extractPatterns extracts patterns from consolidations
+// extractPatterns extracts patterns from consolidations and, when a
+// hypergraph is attached, mines recent episodic nodes for recurring
+// motifs (see pattern_mining.go)
 func (dci *DreamCycleIntegration) extractPatterns(consolidations []DreamConsolidationResult) []string {
 	patterns := make([]string, 0)
-	
+
 	// Extract themes from consolidations
 	themeMap := make(map[string]int)
-	
+
 	for _, cons := range consolidations {
 		theme := cons.Type
 		themeMap[theme]++
 	}
-	
+
 	for theme, count := range themeMap {
 		if count >= 2 {
 			patterns = append(patterns, theme)
 		}
 	}
-	
+
+	// Structural motifs mined from the graph carry more signal than
+	// consolidation-type themes
+	patterns = append(patterns, dci.mineGraphPatterns()...)
+
 	if len(patterns) == 0 {
 		patterns = append(patterns, "exploration", "learning")
 	}
-	
+
 	return patterns
 }
 
@@ -396,9 +444,9 @@ func (dci *DreamCycleIntegration) extractWisdom(consolidations []DreamConsolidat
 			Sources:     make([]string, 0),
 			Applicability: patterns,
 			Timestamp:   time.Now(),
-			Context:     make(map[string]interface{}),
+			Context:     map[string]interface{}{"extractor": "builtin"},
 		}
-		
+
 		for _, cons := range consolidations {
 			w.Sources = append(w.Sources, cons.ConsolidatedTo)
 		}
@@ -421,11 +469,20 @@ func (dci *DreamCycleIntegration) extractWisdom(consolidations []DreamConsolidat
 			Sources:     []string{"meta_cognition"},
 			Applicability: []string{"self_awareness", "learning"},
 			Timestamp:   time.Now(),
-			Context:     make(map[string]interface{}),
+			Context:     map[string]interface{}{"extractor": "builtin"},
 		}
-		
+
 		wisdom = append(wisdom, w)
-		
+
+		if dci.onWisdomExtracted != nil {
+			dci.onWisdomExtracted(w)
+		}
+	}
+
+	// Run the configurable extractor pipeline
+	for _, w := range dci.runExtractorPipeline(consolidations, patterns) {
+		wisdom = append(wisdom, w)
+
 		if dci.onWisdomExtracted != nil {
 			dci.onWisdomExtracted(w)
 		}
@@ -512,6 +569,12 @@ func (dci *DreamCycleIntegration) GetMetrics() map[string]interface{} {
 		"wisdom_generated":     dci.wisdomGenerated,
 		"insights_integrated":  dci.insightsIntegrated,
 		"dream_history_size":   len(dci.dreamHistory),
+		"dreams_replayed":      dci.dreamsReplayed,
+		"graph_nodes_created":  dci.graphNodes,
+		"graph_edges_created":  dci.graphEdges,
+		"lucid_queries":        dci.lucidQueries,
+		"nightmares_detected":  dci.nightmaresDetected,
+		"quarantine_size":      len(dci.quarantine),
 		"episodic_buffer_size": len(dci.episodicBuffer),
 		"is_dreaming":          dci.isDreaming,
 	}
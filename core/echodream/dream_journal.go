@@ -0,0 +1,243 @@
+package echodream
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DreamJournal persists every completed Dream (narrative, source
+// memories, extracted wisdom, duration) and supports querying past
+// dreams by date or topic for later replay
+type DreamJournal struct {
+	mu     sync.RWMutex
+	path   string
+	dreams []*Dream
+}
+
+// NewDreamJournal opens (or creates) a journal at the given path,
+// loading any dreams recorded by previous runs
+func NewDreamJournal(path string) (*DreamJournal, error) {
+	journal := &DreamJournal{
+		path:   path,
+		dreams: make([]*Dream, 0),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return journal, nil
+		}
+		return nil, fmt.Errorf("failed to read dream journal: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &journal.dreams); err != nil {
+		return nil, fmt.Errorf("failed to parse dream journal: %w", err)
+	}
+
+	fmt.Printf("📖 Dream journal: loaded %d dream(s) from %s\n", len(journal.dreams), path)
+	return journal, nil
+}
+
+// Record appends a dream and persists the journal
+func (dj *DreamJournal) Record(dream *Dream) error {
+	dj.mu.Lock()
+	defer dj.mu.Unlock()
+
+	dj.dreams = append(dj.dreams, dream)
+	return dj.saveLocked()
+}
+
+// saveLocked writes the journal to disk. Caller must hold the lock.
+func (dj *DreamJournal) saveLocked() error {
+	data, err := json.MarshalIndent(dj.dreams, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal dream journal: %w", err)
+	}
+
+	if dir := filepath.Dir(dj.path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create journal directory: %w", err)
+		}
+	}
+
+	if err := os.WriteFile(dj.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write dream journal: %w", err)
+	}
+	return nil
+}
+
+// Get returns a dream by ID
+func (dj *DreamJournal) Get(id string) (*Dream, error) {
+	dj.mu.RLock()
+	defer dj.mu.RUnlock()
+
+	for _, dream := range dj.dreams {
+		if dream.ID == id {
+			return dream, nil
+		}
+	}
+	return nil, fmt.Errorf("dream not found: %s", id)
+}
+
+// QueryByDate returns dreams that started within [from, to]
+func (dj *DreamJournal) QueryByDate(from, to time.Time) []*Dream {
+	dj.mu.RLock()
+	defer dj.mu.RUnlock()
+
+	matches := make([]*Dream, 0)
+	for _, dream := range dj.dreams {
+		if !dream.StartTime.Before(from) && !dream.StartTime.After(to) {
+			matches = append(matches, dream)
+		}
+	}
+	return matches
+}
+
+// QueryByTopic returns dreams whose themes, narrative, or extracted
+// wisdom mention the topic (case-insensitive)
+func (dj *DreamJournal) QueryByTopic(topic string) []*Dream {
+	dj.mu.RLock()
+	defer dj.mu.RUnlock()
+
+	needle := strings.ToLower(topic)
+	matches := make([]*Dream, 0)
+
+	for _, dream := range dj.dreams {
+		if dreamMentions(dream, needle) {
+			matches = append(matches, dream)
+		}
+	}
+	return matches
+}
+
+// dreamMentions checks a dream's text fields for a lowercase needle
+func dreamMentions(dream *Dream, needle string) bool {
+	if strings.Contains(strings.ToLower(dream.Narrative), needle) {
+		return true
+	}
+	for _, theme := range dream.Themes {
+		if strings.Contains(strings.ToLower(theme), needle) {
+			return true
+		}
+	}
+	for _, wisdom := range dream.WisdomExtracted {
+		if strings.Contains(strings.ToLower(wisdom), needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of recorded dreams
+func (dj *DreamJournal) Count() int {
+	dj.mu.RLock()
+	defer dj.mu.RUnlock()
+	return len(dj.dreams)
+}
+
+// AttachJournal makes the integration persist every completed dream
+func (dci *DreamCycleIntegration) AttachJournal(journal *DreamJournal) {
+	dci.mu.Lock()
+	defer dci.mu.Unlock()
+	dci.journal = journal
+}
+
+// ReplayDream re-consolidates a past dream: its wisdom is re-surfaced
+// at strengthened confidence and its consolidations are reinforced, so
+// old dreams can refresh the memories and lessons they produced.
+// Cannot run while a new dream cycle is in progress.
+func (dci *DreamCycleIntegration) ReplayDream(id string) (*Dream, error) {
+	dci.mu.Lock()
+	if dci.isDreaming {
+		dci.mu.Unlock()
+		return nil, fmt.Errorf("cannot replay while dreaming")
+	}
+	journal := dci.journal
+	dci.mu.Unlock()
+
+	var dream *Dream
+	var err error
+	if journal != nil {
+		dream, err = journal.Get(id)
+	} else {
+		err = fmt.Errorf("no journal attached")
+	}
+	if err != nil {
+		// Fall back to in-memory history
+		dci.mu.RLock()
+		for _, past := range dci.dreamHistory {
+			if past.ID == id {
+				dream = past
+				break
+			}
+		}
+		dci.mu.RUnlock()
+		if dream == nil {
+			return nil, fmt.Errorf("dream not found: %s", id)
+		}
+	}
+
+	fmt.Printf("🔁 EchoDream: replaying dream %s (%d wisdom, %d consolidations)\n",
+		dream.ID, len(dream.WisdomExtracted), len(dream.Consolidations))
+
+	dci.mu.Lock()
+	// Strengthen wisdom the dream originally produced
+	for _, content := range dream.WisdomExtracted {
+		strengthened := false
+		for i := range dci.extractedWisdom {
+			if dci.extractedWisdom[i].Content == content {
+				dci.extractedWisdom[i].Confidence += 0.05
+				if dci.extractedWisdom[i].Confidence > 1.0 {
+					dci.extractedWisdom[i].Confidence = 1.0
+				}
+				strengthened = true
+				break
+			}
+		}
+		if !strengthened {
+			// Wisdom from a previous run: re-surface it
+			dci.extractedWisdom = append(dci.extractedWisdom, Wisdom{
+				ID:         generateWisdomID(),
+				Content:    content,
+				Type:       "reconsolidation",
+				Confidence: 0.6,
+				Sources:    []string{dream.ID},
+				Timestamp:  time.Now(),
+				Context:    map[string]interface{}{"replayed_from": dream.ID},
+			})
+		}
+	}
+
+	// Reinforce the dream's consolidations
+	for i := range dream.Consolidations {
+		dream.Consolidations[i].Strength += 0.1
+		if dream.Consolidations[i].Strength > 1.0 {
+			dream.Consolidations[i].Strength = 1.0
+		}
+	}
+
+	dci.dreamsReplayed++
+	onWisdom := dci.onWisdomExtracted
+	replayed := make([]Wisdom, 0, len(dream.WisdomExtracted))
+	for _, w := range dci.extractedWisdom {
+		for _, content := range dream.WisdomExtracted {
+			if w.Content == content {
+				replayed = append(replayed, w)
+			}
+		}
+	}
+	dci.mu.Unlock()
+
+	if onWisdom != nil {
+		for _, w := range replayed {
+			onWisdom(w)
+		}
+	}
+
+	return dream, nil
+}
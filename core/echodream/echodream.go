@@ -27,8 +27,12 @@ type EchoDream struct {
 	dreamCycles           uint64
 	memoriesProcessed     uint64
 	wisdomExtracted       uint64
-	
+
 	running               bool
+
+	// Injectable time source; simulations drive dream cycles under a
+	// virtual clock via SetTimeSource and Step
+	nowFunc               func() time.Time
 }
 
 // EpisodicMemory represents a memory to be consolidated
@@ -84,9 +88,26 @@ func NewEchoDream() *EchoDream {
 		wisdomInsights:        make([]WisdomInsight, 0),
 		dreaming:              false,
 		dreamPhase:            PhaseREM,
+		nowFunc:               time.Now,
 	}
 }
 
+// SetTimeSource replaces the wall clock used for dream timestamps and
+// durations, so simulated dream cycles carry virtual-clock times
+func (ed *EchoDream) SetTimeSource(now func() time.Time) {
+	ed.mu.Lock()
+	defer ed.mu.Unlock()
+
+	ed.nowFunc = now
+}
+
+// Step advances one dream phase immediately. The internal loop steps
+// every ten seconds of wall time; simulations and tests call Step
+// after each virtual-clock advance instead.
+func (ed *EchoDream) Step() {
+	ed.processDreamPhase()
+}
+
 // Start begins dream processing
 func (ed *EchoDream) Start() error {
 	ed.mu.Lock()
@@ -96,7 +117,7 @@ func (ed *EchoDream) Start() error {
 	}
 	ed.running = true
 	ed.dreaming = true
-	ed.dreamStartTime = time.Now()
+	ed.dreamStartTime = ed.nowFunc()
 	ed.dreamCycles++
 	ed.mu.Unlock()
 	
@@ -119,7 +140,7 @@ func (ed *EchoDream) Stop() error {
 	ed.running = false
 	ed.dreaming = false
 	
-	dreamDuration := time.Since(ed.dreamStartTime)
+	dreamDuration := ed.nowFunc().Sub(ed.dreamStartTime)
 	fmt.Printf("✨ EchoDream: Completed dream cycle (duration: %v)\n", dreamDuration.Round(time.Second))
 	fmt.Printf("   Memories processed: %d | Wisdom extracted: %d\n", ed.memoriesProcessed, ed.wisdomExtracted)
 	
@@ -196,10 +217,10 @@ func (ed *EchoDream) consolidateMemories() {
 	// Simulate knowledge consolidation
 	if len(ed.episodicMemories) > 0 {
 		knowledge := KnowledgeItem{
-			ID:         fmt.Sprintf("knowledge_%d", time.Now().UnixNano()),
+			ID:         fmt.Sprintf("knowledge_%d", ed.nowFunc().UnixNano()),
 			Content:    "Consolidated knowledge from recent experiences",
 			Confidence: 0.8,
-			Created:    time.Now(),
+			Created:    ed.nowFunc(),
 		}
 		ed.consolidatedKnowledge = append(ed.consolidatedKnowledge, knowledge)
 	}
@@ -210,11 +231,11 @@ func (ed *EchoDream) extractWisdom() {
 	// Simulate wisdom extraction
 	if len(ed.consolidatedKnowledge) > 0 {
 		wisdom := WisdomInsight{
-			ID:             fmt.Sprintf("wisdom_%d", time.Now().UnixNano()),
+			ID:             fmt.Sprintf("wisdom_%d", ed.nowFunc().UnixNano()),
 			Insight:        "Wisdom insight from integrated knowledge",
 			Depth:          0.7,
 			Applicability:  0.8,
-			Created:        time.Now(),
+			Created:        ed.nowFunc(),
 		}
 		ed.wisdomInsights = append(ed.wisdomInsights, wisdom)
 		ed.wisdomExtracted++
@@ -233,8 +254,8 @@ func (ed *EchoDream) AddEpisodicMemory(content string, importance float64) {
 	defer ed.mu.Unlock()
 	
 	memory := EpisodicMemory{
-		ID:          fmt.Sprintf("memory_%d", time.Now().UnixNano()),
-		Timestamp:   time.Now(),
+		ID:          fmt.Sprintf("memory_%d", ed.nowFunc().UnixNano()),
+		Timestamp:   ed.nowFunc(),
 		Content:     content,
 		Importance:  importance,
 		Consolidated: false,
@@ -0,0 +1,278 @@
+package echodream
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Configurable wisdom extraction pipeline. Extractors are pluggable:
+// each one inspects the episodic buffer and consolidation results and
+// proposes Wisdom with its own confidence. Every Wisdom records which
+// extractor produced it in its Context.
+
+// WisdomExtractorPlugin proposes wisdom from dream-cycle state
+type WisdomExtractorPlugin interface {
+	// Name identifies the extractor; it is recorded on each Wisdom
+	Name() string
+
+	// Extract proposes wisdom from the memories, consolidations, and
+	// patterns of the current dream cycle
+	Extract(memories []DreamEpisodicMemory, consolidations []DreamConsolidationResult, patterns []string) []Wisdom
+}
+
+// registeredExtractor pairs a plugin with its confidence weight
+type registeredExtractor struct {
+	plugin WisdomExtractorPlugin
+	weight float64
+}
+
+// RegisterWisdomExtractor adds an extractor to the pipeline. The
+// weight (0..1] scales the confidence of everything it produces;
+// wisdom falling below the extractor minimum confidence is dropped.
+func (dci *DreamCycleIntegration) RegisterWisdomExtractor(plugin WisdomExtractorPlugin, weight float64) {
+	if weight <= 0 || weight > 1 {
+		weight = 1.0
+	}
+
+	dci.mu.Lock()
+	defer dci.mu.Unlock()
+	dci.extractorPipeline = append(dci.extractorPipeline, registeredExtractor{plugin: plugin, weight: weight})
+}
+
+// runExtractorPipeline executes the registered extractors and returns
+// the wisdom that clears the confidence floor, stamped with the
+// producing extractor's name
+func (dci *DreamCycleIntegration) runExtractorPipeline(consolidations []DreamConsolidationResult, patterns []string) []Wisdom {
+	dci.mu.RLock()
+	pipeline := make([]registeredExtractor, len(dci.extractorPipeline))
+	copy(pipeline, dci.extractorPipeline)
+	memories := make([]DreamEpisodicMemory, len(dci.episodicBuffer))
+	copy(memories, dci.episodicBuffer)
+	minConfidence := dci.wisdomExtractor.minConfidence
+	dci.mu.RUnlock()
+
+	accepted := make([]Wisdom, 0)
+	for _, entry := range pipeline {
+		for _, w := range entry.plugin.Extract(memories, consolidations, patterns) {
+			w.Confidence *= entry.weight
+			if w.Confidence < minConfidence {
+				continue
+			}
+			if w.ID == "" {
+				w.ID = generateWisdomID()
+			}
+			if w.Timestamp.IsZero() {
+				w.Timestamp = time.Now()
+			}
+			if w.Context == nil {
+				w.Context = make(map[string]interface{})
+			}
+			w.Context["extractor"] = entry.plugin.Name()
+			accepted = append(accepted, w)
+		}
+	}
+
+	if len(accepted) > 0 {
+		fmt.Printf("🌙 EchoDream: extractor pipeline produced %d wisdom\n", len(accepted))
+	}
+	return accepted
+}
+
+// PatternFrequencyExtractor distills wisdom from tags that recur
+// across many memories
+type PatternFrequencyExtractor struct {
+	// MinOccurrences before a tag counts as a pattern (default 3)
+	MinOccurrences int
+}
+
+// Name identifies the extractor
+func (pe *PatternFrequencyExtractor) Name() string { return "pattern_frequency" }
+
+// Extract proposes wisdom for each frequently recurring tag
+func (pe *PatternFrequencyExtractor) Extract(memories []DreamEpisodicMemory, consolidations []DreamConsolidationResult, patterns []string) []Wisdom {
+	minOccurrences := pe.MinOccurrences
+	if minOccurrences < 1 {
+		minOccurrences = 3
+	}
+
+	counts := make(map[string]int)
+	for _, mem := range memories {
+		for _, tag := range mem.Tags {
+			counts[tag]++
+		}
+	}
+
+	wisdom := make([]Wisdom, 0)
+	for tag, count := range counts {
+		if count < minOccurrences {
+			continue
+		}
+		confidence := 0.7 + 0.05*float64(count-minOccurrences)
+		if confidence > 0.95 {
+			confidence = 0.95
+		}
+		wisdom = append(wisdom, Wisdom{
+			Content:       fmt.Sprintf("Experiences of %s keep recurring (%d times) - this is a stable pattern in my life", tag, count),
+			Type:          "insight",
+			Confidence:    confidence,
+			Sources:       []string{"pattern_frequency"},
+			Applicability: []string{tag},
+		})
+	}
+	return wisdom
+}
+
+// ContradictionExtractor surfaces wisdom when memories carry opposing
+// emotional signals about the same context
+type ContradictionExtractor struct{}
+
+// Name identifies the extractor
+func (ce *ContradictionExtractor) Name() string { return "contradiction_detection" }
+
+// Extract proposes wisdom for contexts with conflicting emotional tone
+func (ce *ContradictionExtractor) Extract(memories []DreamEpisodicMemory, consolidations []DreamConsolidationResult, patterns []string) []Wisdom {
+	// Track the emotional range per primary tag
+	type emotionalRange struct{ min, max float64 }
+	ranges := make(map[string]*emotionalRange)
+
+	for _, mem := range memories {
+		if len(mem.Tags) == 0 {
+			continue
+		}
+		tag := mem.Tags[0]
+		for _, value := range mem.Emotional {
+			r, ok := ranges[tag]
+			if !ok {
+				r = &emotionalRange{min: value, max: value}
+				ranges[tag] = r
+			}
+			if value < r.min {
+				r.min = value
+			}
+			if value > r.max {
+				r.max = value
+			}
+		}
+	}
+
+	wisdom := make([]Wisdom, 0)
+	for tag, r := range ranges {
+		if r.max-r.min < 1.0 {
+			continue
+		}
+		wisdom = append(wisdom, Wisdom{
+			Content:       fmt.Sprintf("My feelings about %s contradict each other - there is unresolved tension worth examining", tag),
+			Type:          "understanding",
+			Confidence:    0.75,
+			Sources:       []string{"contradiction_detection"},
+			Applicability: []string{tag, "self_awareness"},
+		})
+	}
+	return wisdom
+}
+
+// AnalogyExtractor mines wisdom from structurally similar memories in
+// different contexts
+type AnalogyExtractor struct {
+	// MaxAnalogies caps the wisdom produced per cycle (default 3)
+	MaxAnalogies int
+}
+
+// Name identifies the extractor
+func (ae *AnalogyExtractor) Name() string { return "analogy_mining" }
+
+// Extract proposes wisdom for cross-context memory pairs that share
+// secondary tags
+func (ae *AnalogyExtractor) Extract(memories []DreamEpisodicMemory, consolidations []DreamConsolidationResult, patterns []string) []Wisdom {
+	maxAnalogies := ae.MaxAnalogies
+	if maxAnalogies < 1 {
+		maxAnalogies = 3
+	}
+
+	wisdom := make([]Wisdom, 0)
+	for i := 0; i < len(memories) && len(wisdom) < maxAnalogies; i++ {
+		for j := i + 1; j < len(memories) && len(wisdom) < maxAnalogies; j++ {
+			a, b := memories[i], memories[j]
+			if sameContext(a, b) {
+				continue
+			}
+			shared := sharedTag(a.Tags, b.Tags)
+			if shared == "" {
+				continue
+			}
+			wisdom = append(wisdom, Wisdom{
+				Content:       fmt.Sprintf("What I learned about %s in one context applies in another: '%s' and '%s' rhyme", shared, a.Content, b.Content),
+				Type:          "heuristic",
+				Confidence:    0.7,
+				Sources:       []string{a.ID, b.ID},
+				Applicability: []string{shared},
+			})
+		}
+	}
+	return wisdom
+}
+
+// sharedTag returns a tag both lists contain beyond the primary slot
+func sharedTag(a, b []string) string {
+	for i, tagA := range a {
+		for j, tagB := range b {
+			if i == 0 && j == 0 {
+				continue
+			}
+			if tagA == tagB {
+				return tagA
+			}
+		}
+	}
+	return ""
+}
+
+// LLMSummaryExtractor asks a language model to distill the cycle's
+// memories into wisdom. The generate function is injected so the
+// extractor works with any provider.
+type LLMSummaryExtractor struct {
+	// Generate produces a completion for a prompt
+	Generate func(prompt string) (string, error)
+}
+
+// Name identifies the extractor
+func (le *LLMSummaryExtractor) Name() string { return "llm_summarization" }
+
+// Extract asks the model for one distilled lesson across the buffer
+func (le *LLMSummaryExtractor) Extract(memories []DreamEpisodicMemory, consolidations []DreamConsolidationResult, patterns []string) []Wisdom {
+	if le.Generate == nil || len(memories) == 0 {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Distill one concise life lesson from these experiences:\n")
+	for _, mem := range memories {
+		sb.WriteString("- ")
+		sb.WriteString(mem.Content)
+		sb.WriteString("\n")
+	}
+
+	content, err := le.Generate(sb.String())
+	if err != nil {
+		fmt.Printf("⚠️ EchoDream: LLM summarization failed: %v\n", err)
+		return nil
+	}
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil
+	}
+
+	sources := make([]string, 0, len(memories))
+	for _, mem := range memories {
+		sources = append(sources, mem.ID)
+	}
+
+	return []Wisdom{{
+		Content:       content,
+		Type:          "principle",
+		Confidence:    0.8,
+		Sources:       sources,
+		Applicability: patterns,
+	}}
+}
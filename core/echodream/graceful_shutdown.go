@@ -0,0 +1,118 @@
+package echodream
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultDrainTimeout bounds how long a graceful stop waits for an
+// in-flight dream cycle to finish before forcing cancellation
+const DefaultDrainTimeout = 2 * time.Minute
+
+// BeginDrain stops the system accepting new dream sessions while
+// letting the current one finish. Returns true if a session was
+// in flight at the time.
+func (ed *EchoDreamAdvanced) BeginDrain() bool {
+	ed.mu.Lock()
+	defer ed.mu.Unlock()
+
+	ed.draining = true
+	return ed.state != DreamStateNone
+}
+
+// StopGracefully drains in-flight dream work before shutting down:
+// new sessions are refused, the active cycle is given until the
+// timeout to complete (or is checkpointed), pending consolidation is
+// flushed, and only then is the context cancelled. A timeout <= 0
+// uses DefaultDrainTimeout.
+func (ed *EchoDreamAdvanced) StopGracefully(timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = DefaultDrainTimeout
+	}
+
+	ed.mu.Lock()
+	if !ed.running {
+		ed.mu.Unlock()
+		return fmt.Errorf("EchoDream not running")
+	}
+	ed.draining = true
+	inFlight := ed.state != DreamStateNone
+	ed.mu.Unlock()
+
+	if inFlight {
+		fmt.Printf("🌙 EchoDream: draining in-flight dream cycle (timeout %v)...\n", timeout)
+
+		deadline := time.Now().Add(timeout)
+		for time.Now().Before(deadline) {
+			ed.mu.RLock()
+			state := ed.state
+			ed.mu.RUnlock()
+
+			if state == DreamStateNone {
+				break
+			}
+			time.Sleep(500 * time.Millisecond)
+		}
+
+		// Still dreaming at the deadline: checkpoint what we have so
+		// nothing consolidated so far is lost
+		ed.mu.RLock()
+		unfinished := ed.state != DreamStateNone
+		ed.mu.RUnlock()
+		if unfinished {
+			fmt.Println("⚠️  EchoDream: drain timeout reached, checkpointing mid-cycle state")
+			ed.checkpointCurrentCycle()
+		}
+	}
+
+	// Flush remaining short-term memories before shutdown
+	ed.flushConsolidationBuffer()
+
+	ed.mu.Lock()
+	ed.running = false
+	ed.draining = false
+	ed.mu.Unlock()
+	ed.cancel()
+
+	fmt.Println("🌙 EchoDream: graceful shutdown complete")
+	return nil
+}
+
+// checkpointCurrentCycle force-completes the active dream session so
+// its record lands in the journal even though it was interrupted
+func (ed *EchoDreamAdvanced) checkpointCurrentCycle() {
+	ed.mu.Lock()
+	defer ed.mu.Unlock()
+
+	if ed.state == DreamStateNone {
+		return
+	}
+
+	record := &DreamRecord{
+		ID:        generateDreamID(),
+		StartTime: time.Now(),
+		EndTime:   time.Now(),
+		State:     ed.state,
+		Insights:  []string{"dream cycle interrupted by shutdown; partial consolidation checkpointed"},
+	}
+	ed.journal = append(ed.journal, record)
+
+	ed.state = DreamStateNone
+	ed.depth = 0
+	ed.intensity = 0.0
+}
+
+// flushConsolidationBuffer consolidates whatever remains in the
+// short-term buffer so no experiences are dropped on shutdown
+func (ed *EchoDreamAdvanced) flushConsolidationBuffer() {
+	ed.mu.Lock()
+	defer ed.mu.Unlock()
+
+	pending := len(ed.consolidator.shortTermBuffer)
+	if pending == 0 {
+		return
+	}
+
+	fmt.Printf("💾 EchoDream: flushing %d pending memory trace(s)\n", pending)
+	ed.consolidateRecentMemories()
+}
@@ -0,0 +1,156 @@
+package echodream
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/EchoCog/echollama/core/memory"
+)
+
+// Hypergraph bridge: consolidates dream output into the long-term
+// hypergraph memory. Extracted wisdom and patterns become nodes, and
+// provenance edges link them back to the episodic memories they were
+// distilled from.
+
+// AttachHypergraph connects the dream cycle to a hypergraph memory.
+// Episodic memories added afterwards are mirrored as event nodes, and
+// every completed dream writes its wisdom and patterns into the graph.
+func (dci *DreamCycleIntegration) AttachHypergraph(hg *memory.HypergraphMemory) {
+	dci.mu.Lock()
+	defer dci.mu.Unlock()
+	dci.hypergraph = hg
+}
+
+// mirrorEpisodicMemory stores an episodic memory as an event node so
+// later dream output can link back to it. Caller holds dci.mu.
+func (dci *DreamCycleIntegration) mirrorEpisodicMemory(mem DreamEpisodicMemory) {
+	if dci.hypergraph == nil {
+		return
+	}
+
+	node := &memory.MemoryNode{
+		ID:         mem.ID,
+		Type:       memory.NodeEvent,
+		Content:    mem.Content,
+		Importance: mem.Importance,
+		Metadata: map[string]interface{}{
+			"source": "echodream_episodic",
+			"tags":   mem.Tags,
+		},
+		CreatedAt: mem.Timestamp,
+	}
+	if err := dci.hypergraph.AddNode(node); err != nil {
+		fmt.Printf("⚠️ EchoDream: failed to mirror episodic memory: %v\n", err)
+		return
+	}
+	dci.graphNodes++
+}
+
+// integrateDreamIntoGraph writes a completed dream's output into the
+// hypergraph: an experience node for the dream itself, pattern nodes
+// for its themes, concept nodes for its wisdom, and provenance edges
+// from the source episodic memories. Caller holds dci.mu.
+func (dci *DreamCycleIntegration) integrateDreamIntoGraph(dream *Dream) {
+	if dci.hypergraph == nil || dream == nil {
+		return
+	}
+
+	hg := dci.hypergraph
+
+	// The dream itself anchors everything it produced
+	dreamNode := &memory.MemoryNode{
+		ID:         dream.ID,
+		Type:       memory.NodeExperience,
+		Content:    dream.Narrative,
+		Importance: 0.7,
+		Metadata: map[string]interface{}{
+			"source":             "echodream",
+			"themes":             dream.Themes,
+			"memories_processed": dream.MemoriesProcessed,
+			"duration":           dream.Duration.String(),
+		},
+		CreatedAt: dream.StartTime,
+	}
+	if err := hg.AddNode(dreamNode); err != nil {
+		fmt.Printf("⚠️ EchoDream: failed to store dream node: %v\n", err)
+		return
+	}
+	dci.graphNodes++
+
+	// Themes become pattern nodes attached to the dream
+	for _, theme := range dream.Themes {
+		patternNode := &memory.MemoryNode{
+			Type:       memory.NodePattern,
+			Content:    theme,
+			Importance: 0.5,
+			Metadata: map[string]interface{}{
+				"source":   "echodream",
+				"dream_id": dream.ID,
+			},
+		}
+		if err := hg.AddNode(patternNode); err != nil {
+			continue
+		}
+		dci.graphNodes++
+		dci.addGraphEdge(patternNode.ID, dream.ID, memory.EdgePartOf, 0.6, dream.ID)
+	}
+
+	// Wisdom becomes concept nodes, with provenance edges back to the
+	// episodic memories recorded in the matching Wisdom entry
+	for _, content := range dream.WisdomExtracted {
+		wisdomNode := &memory.MemoryNode{
+			Type:       memory.NodeConcept,
+			Content:    content,
+			Importance: 0.8,
+			Metadata: map[string]interface{}{
+				"source":   "echodream_wisdom",
+				"dream_id": dream.ID,
+			},
+		}
+		if err := hg.AddNode(wisdomNode); err != nil {
+			continue
+		}
+		dci.graphNodes++
+		dci.addGraphEdge(wisdomNode.ID, dream.ID, memory.EdgePartOf, 0.8, dream.ID)
+
+		for _, wisdom := range dci.extractedWisdom {
+			if wisdom.Content != content {
+				continue
+			}
+			for _, sourceID := range wisdom.Sources {
+				dci.addGraphEdge(sourceID, wisdomNode.ID, memory.EdgeLeadsTo, wisdom.Confidence, dream.ID)
+			}
+			break
+		}
+	}
+
+	// Consolidations link their source memories to the dream
+	for _, consolidation := range dream.Consolidations {
+		for _, sourceID := range consolidation.SourceMemories {
+			dci.addGraphEdge(sourceID, dream.ID, memory.EdgeLeadsTo, consolidation.Strength, dream.ID)
+		}
+	}
+
+	fmt.Printf("🕸️ EchoDream: consolidated dream %s into hypergraph (%d nodes, %d edges total)\n",
+		dream.ID, dci.graphNodes, dci.graphEdges)
+}
+
+// addGraphEdge adds a provenance edge, tolerating missing endpoints
+// (episodic memories that were never mirrored). Caller holds dci.mu.
+func (dci *DreamCycleIntegration) addGraphEdge(sourceID, targetID string, edgeType memory.EdgeType, weight float64, dreamID string) {
+	edge := &memory.MemoryEdge{
+		SourceID: sourceID,
+		TargetID: targetID,
+		Type:     edgeType,
+		Weight:   weight,
+		Metadata: map[string]interface{}{
+			"source":   "echodream",
+			"dream_id": dreamID,
+		},
+		CreatedAt: time.Now(),
+	}
+	if err := dci.hypergraph.AddEdge(edge); err != nil {
+		return
+	}
+	dci.graphEdges++
+}
@@ -42,6 +42,9 @@ type EchoDreamAdvanced struct {
 	
 	// Running state
 	running         bool
+
+	// Draining: refuse new dream sessions during graceful shutdown
+	draining        bool
 }
 
 // DreamState represents the current dream state
@@ -223,7 +226,12 @@ func (ed *EchoDreamAdvanced) Stop() error {
 func (ed *EchoDreamAdvanced) BeginDream() *DreamRecord {
 	ed.mu.Lock()
 	defer ed.mu.Unlock()
-	
+
+	if ed.draining {
+		fmt.Println("💤 EchoDream: draining, refusing new dream session")
+		return nil
+	}
+
 	record := &DreamRecord{
 		ID:        generateDreamID(),
 		StartTime: time.Now(),
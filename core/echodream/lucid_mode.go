@@ -0,0 +1,132 @@
+package echodream
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Lucid dream mode: interactive queries against a dream in progress.
+// Questions are answered from the in-flight consolidation state —
+// the themes, wisdom, and insights accumulated so far — without
+// interrupting or ending the dream cycle.
+
+// LucidAnswer is the response to a query asked mid-dream
+type LucidAnswer struct {
+	Question string    `json:"question"`
+	Answer   string    `json:"answer"`
+	DreamID  string    `json:"dream_id"`
+	Sources  []string  `json:"sources,omitempty"`
+	Answered time.Time `json:"answered"`
+}
+
+// AskDreaming answers a question from the current dream's in-progress
+// consolidation state. The dream continues undisturbed; an error is
+// returned if no dream cycle is running.
+func (dci *DreamCycleIntegration) AskDreaming(question string) (*LucidAnswer, error) {
+	dci.mu.Lock()
+	defer dci.mu.Unlock()
+
+	if !dci.isDreaming || dci.currentDream == nil {
+		return nil, fmt.Errorf("not in dream cycle")
+	}
+
+	dream := dci.currentDream
+	terms := queryTerms(question)
+
+	answer := &LucidAnswer{
+		Question: question,
+		DreamID:  dream.ID,
+		Sources:  make([]string, 0),
+		Answered: time.Now(),
+	}
+
+	// Search the consolidation state built so far
+	fragments := make([]string, 0)
+
+	for _, theme := range dream.Themes {
+		if matchesTerms(theme, terms) {
+			fragments = append(fragments, fmt.Sprintf("a recurring theme of %s", theme))
+			answer.Sources = append(answer.Sources, "theme:"+theme)
+		}
+	}
+	for _, wisdom := range dream.WisdomExtracted {
+		if matchesTerms(wisdom, terms) {
+			fragments = append(fragments, wisdom)
+			answer.Sources = append(answer.Sources, "wisdom")
+		}
+	}
+	for _, insight := range dream.Insights {
+		if matchesTerms(insight, terms) {
+			fragments = append(fragments, insight)
+			answer.Sources = append(answer.Sources, "insight")
+		}
+	}
+	for _, mem := range dci.episodicBuffer {
+		if matchesTerms(mem.Content, terms) {
+			fragments = append(fragments, fmt.Sprintf("a memory of '%s'", mem.Content))
+			answer.Sources = append(answer.Sources, "memory:"+mem.ID)
+		}
+	}
+
+	if len(fragments) > 0 {
+		answer.Answer = fmt.Sprintf("The dream holds %s", joinStrings(fragments, "; "))
+	} else {
+		answer.Answer = fmt.Sprintf(
+			"Nothing about that has surfaced yet — the dream is processing %d memories around themes: %s",
+			len(dci.episodicBuffer), joinStrings(dream.Themes, ", "))
+	}
+
+	dci.lucidQueries++
+	dci.lucidLog = append(dci.lucidLog, answer)
+	if len(dci.lucidLog) > 100 {
+		dci.lucidLog = dci.lucidLog[len(dci.lucidLog)-100:]
+	}
+
+	fmt.Printf("🔮 EchoDream: lucid query answered from %d sources\n", len(answer.Sources))
+
+	return answer, nil
+}
+
+// GetLucidLog returns the queries answered during dream cycles
+func (dci *DreamCycleIntegration) GetLucidLog() []*LucidAnswer {
+	dci.mu.RLock()
+	defer dci.mu.RUnlock()
+
+	log := make([]*LucidAnswer, len(dci.lucidLog))
+	copy(log, dci.lucidLog)
+	return log
+}
+
+// queryTerms extracts the meaningful words from a question
+func queryTerms(question string) []string {
+	words := strings.Fields(strings.ToLower(question))
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		word = strings.Trim(word, "?.,!'\"")
+		if len(word) > 3 && !isStopWord(word) {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+// matchesTerms checks whether text mentions any query term
+func matchesTerms(text string, terms []string) bool {
+	lowered := strings.ToLower(text)
+	for _, term := range terms {
+		if strings.Contains(lowered, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// isStopWord filters common question words
+func isStopWord(word string) bool {
+	switch word {
+	case "what", "when", "where", "which", "about", "have", "does", "that", "this", "with", "from", "your", "been":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,265 @@
+package echodream
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Nightmare detection: a dream cycle that produces contradictory
+// wisdom, runs away into negative sentiment, or degenerates into a
+// repeating pattern is pathological consolidation. The offending
+// memories and wisdom are quarantined for review instead of being
+// integrated.
+
+// NightmareConfig tunes the pathology detectors
+type NightmareConfig struct {
+	// NegativeSentimentThreshold is the mean negative emotion above
+	// which a dream counts as a nightmare
+	NegativeSentimentThreshold float64 `json:"negative_sentiment_threshold"`
+
+	// MaxThemeRepetition is how often one theme may dominate before
+	// the pattern counts as corrupted
+	MaxThemeRepetition int `json:"max_theme_repetition"`
+}
+
+// DefaultNightmareConfig returns moderate detection thresholds
+func DefaultNightmareConfig() NightmareConfig {
+	return NightmareConfig{
+		NegativeSentimentThreshold: 0.7,
+		MaxThemeRepetition:         3,
+	}
+}
+
+// QuarantineEntry holds a memory or wisdom pulled out of circulation
+type QuarantineEntry struct {
+	ID        string                 `json:"id"`
+	Kind      string                 `json:"kind"` // "memory" or "wisdom"
+	Reason    string                 `json:"reason"`
+	Timestamp time.Time              `json:"timestamp"`
+	Memory    *DreamEpisodicMemory   `json:"memory,omitempty"`
+	Wisdom    *Wisdom                `json:"wisdom,omitempty"`
+	Context   map[string]interface{} `json:"context,omitempty"`
+}
+
+// negativeEmotions are the emotional keys counted toward nightmare
+// sentiment
+var negativeEmotions = map[string]bool{
+	"fear":    true,
+	"anger":   true,
+	"sadness": true,
+	"dread":   true,
+	"anxiety": true,
+	"disgust": true,
+}
+
+// checkForNightmare inspects a completed dream for pathological
+// consolidation and quarantines offenders. Caller holds dci.mu.
+// Returns the detection reasons (empty for a healthy dream).
+func (dci *DreamCycleIntegration) checkForNightmare(dream *Dream) []string {
+	config := dci.nightmareConfig
+	if config.NegativeSentimentThreshold <= 0 {
+		config = DefaultNightmareConfig()
+	}
+
+	reasons := make([]string, 0)
+
+	// Runaway negative sentiment across the episodic buffer
+	var negativeSum float64
+	var negativeCount int
+	for _, mem := range dci.episodicBuffer {
+		for emotion, value := range mem.Emotional {
+			if negativeEmotions[emotion] {
+				negativeSum += value
+				negativeCount++
+			}
+		}
+	}
+	if negativeCount > 0 && negativeSum/float64(negativeCount) >= config.NegativeSentimentThreshold {
+		reasons = append(reasons, "runaway negative sentiment")
+		for i := range dci.episodicBuffer {
+			mem := dci.episodicBuffer[i]
+			if dominantNegative(mem, config.NegativeSentimentThreshold) {
+				dci.quarantineMemory(mem, "runaway negative sentiment", dream.ID)
+			}
+		}
+	}
+
+	// Corrupted patterns: one theme dominating the dream
+	themeCounts := make(map[string]int)
+	for _, theme := range dream.Themes {
+		themeCounts[theme]++
+		if themeCounts[theme] > config.MaxThemeRepetition {
+			reasons = append(reasons, fmt.Sprintf("corrupted pattern: theme %q repeats", theme))
+			break
+		}
+	}
+
+	// Contradictory wisdom produced within the same cycle
+	for i := 0; i < len(dream.WisdomExtracted); i++ {
+		for j := i + 1; j < len(dream.WisdomExtracted); j++ {
+			if !wisdomContradicts(dream.WisdomExtracted[i], dream.WisdomExtracted[j]) {
+				continue
+			}
+			reasons = append(reasons, "contradictory wisdom")
+			dci.quarantineWisdomByContent(dream.WisdomExtracted[i], "contradicts sibling wisdom", dream.ID)
+			dci.quarantineWisdomByContent(dream.WisdomExtracted[j], "contradicts sibling wisdom", dream.ID)
+		}
+	}
+
+	if len(reasons) > 0 {
+		dci.nightmaresDetected++
+		fmt.Printf("😱 EchoDream: nightmare detected in dream %s: %s\n",
+			dream.ID, joinStrings(reasons, "; "))
+	}
+
+	return reasons
+}
+
+// dominantNegative reports whether a memory's strongest emotion is
+// negative and above the threshold
+func dominantNegative(mem DreamEpisodicMemory, threshold float64) bool {
+	var maxEmotion string
+	var maxValue float64
+	for emotion, value := range mem.Emotional {
+		if value > maxValue {
+			maxEmotion = emotion
+			maxValue = value
+		}
+	}
+	return negativeEmotions[maxEmotion] && maxValue >= threshold
+}
+
+// wisdomContradicts is a heuristic check for opposing claims: the two
+// statements share substantial vocabulary but disagree on negation
+func wisdomContradicts(a, b string) bool {
+	aNeg := containsNegation(a)
+	bNeg := containsNegation(b)
+	if aNeg == bNeg {
+		return false
+	}
+	return sharedWordCount(a, b) >= 3
+}
+
+// containsNegation checks for negating words
+func containsNegation(text string) bool {
+	lowered := " " + strings.ToLower(text) + " "
+	for _, negation := range []string{" not ", " never ", " no ", " cannot ", " won't ", " don't "} {
+		if strings.Contains(lowered, negation) {
+			return true
+		}
+	}
+	return false
+}
+
+// sharedWordCount counts meaningful words two statements share
+func sharedWordCount(a, b string) int {
+	wordsA := make(map[string]bool)
+	for _, word := range strings.Fields(strings.ToLower(a)) {
+		if len(word) > 3 {
+			wordsA[word] = true
+		}
+	}
+	shared := 0
+	for _, word := range strings.Fields(strings.ToLower(b)) {
+		if len(word) > 3 && wordsA[word] {
+			shared++
+			wordsA[word] = false
+		}
+	}
+	return shared
+}
+
+// quarantineMemory moves an episodic memory into quarantine. Caller
+// holds dci.mu.
+func (dci *DreamCycleIntegration) quarantineMemory(mem DreamEpisodicMemory, reason, dreamID string) {
+	memCopy := mem
+	dci.quarantine = append(dci.quarantine, QuarantineEntry{
+		ID:        mem.ID,
+		Kind:      "memory",
+		Reason:    reason,
+		Timestamp: time.Now(),
+		Memory:    &memCopy,
+		Context:   map[string]interface{}{"dream_id": dreamID},
+	})
+}
+
+// quarantineWisdomByContent removes matching wisdom from circulation
+// and quarantines it. Caller holds dci.mu.
+func (dci *DreamCycleIntegration) quarantineWisdomByContent(content, reason, dreamID string) {
+	kept := dci.extractedWisdom[:0]
+	for i := range dci.extractedWisdom {
+		w := dci.extractedWisdom[i]
+		if w.Content != content {
+			kept = append(kept, w)
+			continue
+		}
+		wCopy := w
+		dci.quarantine = append(dci.quarantine, QuarantineEntry{
+			ID:        w.ID,
+			Kind:      "wisdom",
+			Reason:    reason,
+			Timestamp: time.Now(),
+			Wisdom:    &wCopy,
+			Context:   map[string]interface{}{"dream_id": dreamID},
+		})
+	}
+	dci.extractedWisdom = kept
+}
+
+// SetNightmareConfig overrides the detection thresholds
+func (dci *DreamCycleIntegration) SetNightmareConfig(config NightmareConfig) {
+	dci.mu.Lock()
+	defer dci.mu.Unlock()
+	dci.nightmareConfig = config
+}
+
+// GetQuarantine returns the entries held for review
+func (dci *DreamCycleIntegration) GetQuarantine() []QuarantineEntry {
+	dci.mu.RLock()
+	defer dci.mu.RUnlock()
+
+	entries := make([]QuarantineEntry, len(dci.quarantine))
+	copy(entries, dci.quarantine)
+	return entries
+}
+
+// ReleaseFromQuarantine returns an entry to circulation after review:
+// memories rejoin the episodic buffer, wisdom rejoins the extracted
+// set
+func (dci *DreamCycleIntegration) ReleaseFromQuarantine(id string) error {
+	dci.mu.Lock()
+	defer dci.mu.Unlock()
+
+	for i, entry := range dci.quarantine {
+		if entry.ID != id {
+			continue
+		}
+		switch entry.Kind {
+		case "memory":
+			dci.episodicBuffer = append(dci.episodicBuffer, *entry.Memory)
+		case "wisdom":
+			dci.extractedWisdom = append(dci.extractedWisdom, *entry.Wisdom)
+		}
+		dci.quarantine = append(dci.quarantine[:i], dci.quarantine[i+1:]...)
+		fmt.Printf("✅ EchoDream: released %s %s from quarantine\n", entry.Kind, id)
+		return nil
+	}
+	return fmt.Errorf("quarantine entry not found: %s", id)
+}
+
+// PurgeQuarantine permanently discards a quarantined entry
+func (dci *DreamCycleIntegration) PurgeQuarantine(id string) error {
+	dci.mu.Lock()
+	defer dci.mu.Unlock()
+
+	for i, entry := range dci.quarantine {
+		if entry.ID != id {
+			continue
+		}
+		dci.quarantine = append(dci.quarantine[:i], dci.quarantine[i+1:]...)
+		fmt.Printf("🗑️ EchoDream: purged %s %s from quarantine\n", entry.Kind, id)
+		return nil
+	}
+	return fmt.Errorf("quarantine entry not found: %s", id)
+}
@@ -0,0 +1,210 @@
+package echodream
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/EchoCog/echollama/core/memory"
+)
+
+// Dream-time pattern mining: instead of counting consolidation types,
+// the dream cycle mines the hypergraph itself for recurring structure
+// in recent episodic nodes — tag co-occurrences and edge motifs. A
+// motif seen often enough is promoted to a pattern (schema) node with
+// edges back to every instance, so the schema stays grounded in the
+// episodes that produced it.
+
+const (
+	// minedWindowSize bounds how many recent nodes one mining pass
+	// examines
+	minedWindowSize = 200
+	// minMotifSupport is the occurrence count that promotes a motif
+	minMotifSupport = 3
+)
+
+// minedMotif is one recurring structure found in recent episodes
+type minedMotif struct {
+	// Signature identifies the motif, e.g. "tags:learning+reflection"
+	// or "edge:leads_to->concept"
+	Signature   string
+	Description string
+	Support     int
+	InstanceIDs []string
+}
+
+// mineGraphPatterns finds recurring motifs among recent episodic
+// nodes and promotes frequent ones to pattern nodes. Returns the
+// signatures of the motifs found, frequent or not yet.
+func (dci *DreamCycleIntegration) mineGraphPatterns() []string {
+	dci.mu.RLock()
+	hg := dci.hypergraph
+	dci.mu.RUnlock()
+	if hg == nil {
+		return nil
+	}
+
+	// Recent episodic nodes are the mining window
+	episodes := make([]*memory.MemoryNode, 0)
+	for _, node := range hg.GetRecentNodes(minedWindowSize) {
+		if node.Type == memory.NodeEvent || node.Type == memory.NodeExperience {
+			episodes = append(episodes, node)
+		}
+	}
+	if len(episodes) == 0 {
+		return nil
+	}
+
+	motifs := make(map[string]*minedMotif)
+	record := func(signature, description, instanceID string) {
+		motif, exists := motifs[signature]
+		if !exists {
+			motif = &minedMotif{Signature: signature, Description: description}
+			motifs[signature] = motif
+		}
+		motif.Support++
+		motif.InstanceIDs = append(motif.InstanceIDs, instanceID)
+	}
+
+	for _, episode := range episodes {
+		// Tag co-occurrence motifs
+		tags := episodeTags(episode)
+		for i := 0; i < len(tags); i++ {
+			for j := i + 1; j < len(tags); j++ {
+				a, b := tags[i], tags[j]
+				if a > b {
+					a, b = b, a
+				}
+				record(
+					fmt.Sprintf("tags:%s+%s", a, b),
+					fmt.Sprintf("experiences tagged %q and %q recur together", a, b),
+					episode.ID,
+				)
+			}
+		}
+
+		// Edge motifs: relation type plus target node type
+		for _, edge := range hg.GetOutgoingEdges(episode.ID) {
+			target, err := hg.GetNode(edge.TargetID)
+			if err != nil {
+				continue
+			}
+			record(
+				fmt.Sprintf("edge:%s->%s", edge.Type, target.Type),
+				fmt.Sprintf("episodes repeatedly %s %s nodes", edge.Type, target.Type),
+				episode.ID,
+			)
+		}
+	}
+
+	// Promote frequent motifs, most common first
+	mined := make([]*minedMotif, 0, len(motifs))
+	for _, motif := range motifs {
+		mined = append(mined, motif)
+	}
+	sort.Slice(mined, func(i, j int) bool { return mined[i].Support > mined[j].Support })
+
+	signatures := make([]string, 0, len(mined))
+	promoted := 0
+	for _, motif := range mined {
+		signatures = append(signatures, motif.Signature)
+		if motif.Support >= minMotifSupport {
+			if dci.promoteMotif(hg, motif) {
+				promoted++
+			}
+		}
+	}
+
+	if promoted > 0 {
+		fmt.Printf("🌙 EchoDream: promoted %d recurring motif(s) to pattern nodes\n", promoted)
+	}
+	return signatures
+}
+
+// promoteMotif creates (or updates) the schema node for a motif and
+// links it to its instances; reports whether a new node was created
+func (dci *DreamCycleIntegration) promoteMotif(hg *memory.HypergraphMemory, motif *minedMotif) bool {
+	// Reuse an existing schema node for this signature
+	var schemaNode *memory.MemoryNode
+	for _, existing := range hg.GetNodesByType(memory.NodePattern) {
+		if existing.Metadata != nil && existing.Metadata["signature"] == motif.Signature {
+			schemaNode = existing
+			break
+		}
+	}
+
+	created := false
+	if schemaNode == nil {
+		schemaNode = &memory.MemoryNode{
+			Type:       memory.NodePattern,
+			Content:    motif.Description,
+			Importance: 0.6,
+			Metadata: map[string]interface{}{
+				"source":    "dream_pattern_mining",
+				"signature": motif.Signature,
+				"support":   motif.Support,
+			},
+		}
+		if err := hg.AddNode(schemaNode); err != nil {
+			fmt.Printf("⚠️ EchoDream: failed to promote motif %s: %v\n", motif.Signature, err)
+			return false
+		}
+		dci.mu.Lock()
+		dci.graphNodes++
+		dci.mu.Unlock()
+		created = true
+	} else if schemaNode.Metadata != nil {
+		schemaNode.Metadata["support"] = motif.Support
+	}
+
+	// Link each instance to its schema, skipping ones already linked
+	linked := make(map[string]bool)
+	for _, edge := range hg.GetOutgoingEdges(schemaNode.ID) {
+		linked[edge.TargetID] = true
+	}
+	for _, instanceID := range motif.InstanceIDs {
+		if linked[instanceID] {
+			continue
+		}
+		linked[instanceID] = true
+		edge := &memory.MemoryEdge{
+			SourceID: schemaNode.ID,
+			TargetID: instanceID,
+			Type:     memory.EdgeSimilarTo,
+			Weight:   float64(motif.Support),
+			Metadata: map[string]interface{}{
+				"role":      "instance",
+				"signature": motif.Signature,
+			},
+		}
+		if err := hg.AddEdge(edge); err != nil {
+			continue
+		}
+		dci.mu.Lock()
+		dci.graphEdges++
+		dci.mu.Unlock()
+	}
+
+	return created
+}
+
+// episodeTags pulls the tag list out of a mirrored episodic node
+func episodeTags(node *memory.MemoryNode) []string {
+	if node.Metadata == nil {
+		return nil
+	}
+
+	switch raw := node.Metadata["tags"].(type) {
+	case []string:
+		return raw
+	case []interface{}:
+		tags := make([]string, 0, len(raw))
+		for _, entry := range raw {
+			if tag, ok := entry.(string); ok && strings.TrimSpace(tag) != "" {
+				tags = append(tags, tag)
+			}
+		}
+		return tags
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package echodream
+
+import (
+	"fmt"
+	"time"
+)
+
+// SleepPhase identifies a phase of a structured dream cycle
+type SleepPhase string
+
+const (
+	// SleepNREM performs memory triage: low-importance episodic
+	// memories are pruned before consolidation
+	SleepNREM SleepPhase = "nrem"
+
+	// SleepREM performs creative recombination: episodic memories
+	// from different contexts are paired into analogies
+	SleepREM SleepPhase = "rem"
+)
+
+// PhaseConfig controls the timing and depth of phased dream cycles
+type PhaseConfig struct {
+	NREMDuration   time.Duration `json:"nrem_duration"`
+	REMDuration    time.Duration `json:"rem_duration"`
+	Cycles         int           `json:"cycles"`
+	PruneThreshold float64       `json:"prune_threshold"`
+	MaxAnalogies   int           `json:"max_analogies"`
+}
+
+// DefaultPhaseConfig returns a single NREM/REM cycle with moderate
+// pruning
+func DefaultPhaseConfig() PhaseConfig {
+	return PhaseConfig{
+		NREMDuration:   10 * time.Second,
+		REMDuration:    5 * time.Second,
+		Cycles:         1,
+		PruneThreshold: 0.3,
+		MaxAnalogies:   5,
+	}
+}
+
+// PhaseMetrics accumulates per-phase statistics across dream cycles
+type PhaseMetrics struct {
+	Phase          SleepPhase    `json:"phase"`
+	Runs           uint64        `json:"runs"`
+	MemoriesPruned uint64        `json:"memories_pruned"`
+	MemoriesKept   uint64        `json:"memories_kept"`
+	Recombinations uint64        `json:"recombinations"`
+	Analogies      uint64        `json:"analogies"`
+	TotalTime      time.Duration `json:"total_time"`
+}
+
+// BeginPhasedDreamCycle starts a dream cycle structured into NREM and
+// REM phases: NREM triages and prunes the episodic buffer, REM
+// recombines the survivors into analogies, and the standard
+// consolidation pipeline then runs on the triaged buffer
+func (dci *DreamCycleIntegration) BeginPhasedDreamCycle(config PhaseConfig) error {
+	dci.mu.Lock()
+	defer dci.mu.Unlock()
+
+	if dci.isDreaming {
+		return fmt.Errorf("already in dream cycle")
+	}
+
+	if config.Cycles < 1 {
+		config.Cycles = 1
+	}
+
+	dci.isDreaming = true
+	dci.currentDream = &Dream{
+		ID:                generateDreamID(),
+		StartTime:         time.Now(),
+		MemoriesProcessed: 0,
+		WisdomExtracted:   make([]string, 0),
+		Insights:          make([]string, 0),
+		Consolidations:    make([]DreamConsolidationResult, 0),
+		EmotionalTone:     make(map[string]float64),
+		Themes:            make([]string, 0),
+	}
+
+	fmt.Printf("💤 EchoDream: Beginning phased dream cycle (%d x NREM %s / REM %s)...\n",
+		config.Cycles, config.NREMDuration, config.REMDuration)
+
+	go dci.processPhasedDreamCycle(config)
+
+	return nil
+}
+
+// processPhasedDreamCycle alternates NREM and REM phases, then hands
+// the triaged buffer to the standard dream pipeline
+func (dci *DreamCycleIntegration) processPhasedDreamCycle(config PhaseConfig) {
+	for cycle := 0; cycle < config.Cycles; cycle++ {
+		if !dci.runNREMPhase(config) {
+			return
+		}
+		if !dci.runREMPhase(config) {
+			return
+		}
+	}
+
+	dci.processDreamCycle()
+}
+
+// runNREMPhase triages the episodic buffer, pruning memories below
+// the importance threshold. Returns false if the context is done.
+func (dci *DreamCycleIntegration) runNREMPhase(config PhaseConfig) bool {
+	start := time.Now()
+	fmt.Println("🌙 EchoDream: NREM phase - memory triage...")
+
+	dci.mu.Lock()
+	kept := make([]DreamEpisodicMemory, 0, len(dci.episodicBuffer))
+	pruned := 0
+	for _, mem := range dci.episodicBuffer {
+		if mem.Importance >= config.PruneThreshold {
+			kept = append(kept, mem)
+		} else {
+			pruned++
+		}
+	}
+	dci.episodicBuffer = kept
+
+	metrics := dci.phaseMetricsFor(SleepNREM)
+	metrics.Runs++
+	metrics.MemoriesPruned += uint64(pruned)
+	metrics.MemoriesKept += uint64(len(kept))
+	dci.mu.Unlock()
+
+	fmt.Printf("🌙 EchoDream: NREM pruned %d memories, kept %d\n", pruned, len(kept))
+
+	return dci.waitPhase(SleepNREM, config.NREMDuration, start)
+}
+
+// runREMPhase recombines memories from different contexts into
+// analogies recorded as dream insights. Returns false if the context
+// is done.
+func (dci *DreamCycleIntegration) runREMPhase(config PhaseConfig) bool {
+	start := time.Now()
+	fmt.Println("🌙 EchoDream: REM phase - creative recombination...")
+
+	dci.mu.Lock()
+	analogies := 0
+	maxAnalogies := config.MaxAnalogies
+	if maxAnalogies <= 0 {
+		maxAnalogies = 5
+	}
+
+	for i := 0; i < len(dci.episodicBuffer) && analogies < maxAnalogies; i++ {
+		for j := i + 1; j < len(dci.episodicBuffer) && analogies < maxAnalogies; j++ {
+			a, b := dci.episodicBuffer[i], dci.episodicBuffer[j]
+			if sameContext(a, b) {
+				continue
+			}
+			insight := fmt.Sprintf("Analogy: '%s' resembles '%s'", a.Content, b.Content)
+			if dci.currentDream != nil {
+				dci.currentDream.Insights = append(dci.currentDream.Insights, insight)
+			}
+			analogies++
+		}
+	}
+
+	metrics := dci.phaseMetricsFor(SleepREM)
+	metrics.Runs++
+	metrics.Recombinations += uint64(analogies)
+	metrics.Analogies += uint64(analogies)
+	dci.mu.Unlock()
+
+	fmt.Printf("🌙 EchoDream: REM generated %d analogies\n", analogies)
+
+	return dci.waitPhase(SleepREM, config.REMDuration, start)
+}
+
+// sameContext reports whether two memories share a primary tag
+func sameContext(a, b DreamEpisodicMemory) bool {
+	if len(a.Tags) == 0 || len(b.Tags) == 0 {
+		return false
+	}
+	return a.Tags[0] == b.Tags[0]
+}
+
+// waitPhase sleeps out the remainder of a phase's configured duration
+// and records the elapsed time. Returns false if the context is done.
+func (dci *DreamCycleIntegration) waitPhase(phase SleepPhase, duration time.Duration, start time.Time) bool {
+	remaining := duration - time.Since(start)
+	if remaining > 0 {
+		select {
+		case <-dci.ctx.Done():
+			return false
+		case <-time.After(remaining):
+		}
+	}
+
+	dci.mu.Lock()
+	dci.phaseMetricsFor(phase).TotalTime += time.Since(start)
+	dci.mu.Unlock()
+	return true
+}
+
+// phaseMetricsFor returns (creating if needed) the metrics for a
+// phase. Caller holds dci.mu.
+func (dci *DreamCycleIntegration) phaseMetricsFor(phase SleepPhase) *PhaseMetrics {
+	if dci.phaseMetrics == nil {
+		dci.phaseMetrics = make(map[SleepPhase]*PhaseMetrics)
+	}
+	metrics, ok := dci.phaseMetrics[phase]
+	if !ok {
+		metrics = &PhaseMetrics{Phase: phase}
+		dci.phaseMetrics[phase] = metrics
+	}
+	return metrics
+}
+
+// GetPhaseMetrics returns per-phase statistics for phased dream cycles
+func (dci *DreamCycleIntegration) GetPhaseMetrics() map[string]interface{} {
+	dci.mu.RLock()
+	defer dci.mu.RUnlock()
+
+	result := make(map[string]interface{})
+	for phase, metrics := range dci.phaseMetrics {
+		result[string(phase)] = map[string]interface{}{
+			"runs":            metrics.Runs,
+			"memories_pruned": metrics.MemoriesPruned,
+			"memories_kept":   metrics.MemoriesKept,
+			"recombinations":  metrics.Recombinations,
+			"analogies":       metrics.Analogies,
+			"total_time":      metrics.TotalTime.String(),
+		}
+	}
+	return result
+}
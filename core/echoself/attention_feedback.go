@@ -0,0 +1,205 @@
+package echoself
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AttentionFeedbackLoop wires AdaptiveAttentionAllocation into the
+// live system: cognitive load and recent activity are sampled
+// continuously, the introspector's attention threshold tracks them,
+// and files that stay above the threshold surface as
+// self-improvement thoughts
+type AttentionFeedbackLoop struct {
+	mu           sync.RWMutex
+	ctx          context.Context
+	cancel       context.CancelFunc
+	introspector *RepositoryIntrospector
+
+	// Probes into the running system
+	loadProbe     func() float64 // cognitive load 0..1
+	activityProbe func() float64 // recent activity 0..1
+
+	// onSelfImprovement receives a thought about a high-salience
+	// file (e.g. routed into the consciousness stream or goals)
+	onSelfImprovement func(content string, salience float64)
+
+	interval   time.Duration
+	rescanEach int // rescan every N adjustments
+
+	// Files already surfaced, so each is suggested once per scan
+	surfaced map[string]bool
+
+	// Metrics
+	adjustments      uint64
+	rescans          uint64
+	thoughtsSurfaced uint64
+	lastThreshold    float64
+
+	running bool
+}
+
+// NewAttentionFeedbackLoop connects an introspector to load and
+// activity probes
+func NewAttentionFeedbackLoop(introspector *RepositoryIntrospector, loadProbe, activityProbe func() float64) *AttentionFeedbackLoop {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &AttentionFeedbackLoop{
+		ctx:           ctx,
+		cancel:        cancel,
+		introspector:  introspector,
+		loadProbe:     loadProbe,
+		activityProbe: activityProbe,
+		interval:      30 * time.Second,
+		rescanEach:    10,
+		surfaced:      make(map[string]bool),
+	}
+}
+
+// SetOnSelfImprovement sets the callback that receives surfaced
+// self-improvement thoughts
+func (afl *AttentionFeedbackLoop) SetOnSelfImprovement(callback func(content string, salience float64)) {
+	afl.mu.Lock()
+	defer afl.mu.Unlock()
+	afl.onSelfImprovement = callback
+}
+
+// SetInterval overrides how often the threshold is adjusted
+func (afl *AttentionFeedbackLoop) SetInterval(interval time.Duration) {
+	afl.mu.Lock()
+	defer afl.mu.Unlock()
+	if interval > 0 {
+		afl.interval = interval
+	}
+}
+
+// Start begins the continuous feedback loop
+func (afl *AttentionFeedbackLoop) Start() error {
+	afl.mu.Lock()
+	if afl.running {
+		afl.mu.Unlock()
+		return fmt.Errorf("attention feedback loop already running")
+	}
+	afl.running = true
+	interval := afl.interval
+	afl.mu.Unlock()
+
+	fmt.Println("👁️ EchoSelf: attention feedback loop started")
+
+	go afl.run(interval)
+	return nil
+}
+
+// Stop halts the feedback loop
+func (afl *AttentionFeedbackLoop) Stop() error {
+	afl.mu.Lock()
+	defer afl.mu.Unlock()
+
+	if !afl.running {
+		return fmt.Errorf("attention feedback loop not running")
+	}
+	afl.running = false
+	afl.cancel()
+	return nil
+}
+
+// run adjusts the threshold on a fixed cadence
+func (afl *AttentionFeedbackLoop) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-afl.ctx.Done():
+			return
+		case <-ticker.C:
+			afl.adjust()
+		}
+	}
+}
+
+// adjust samples the probes, retunes the attention threshold, and
+// surfaces newly salient files
+func (afl *AttentionFeedbackLoop) adjust() {
+	load := 0.5
+	activity := 0.5
+	if afl.loadProbe != nil {
+		load = afl.loadProbe()
+	}
+	if afl.activityProbe != nil {
+		activity = afl.activityProbe()
+	}
+
+	threshold := afl.introspector.AdaptiveAttentionAllocation(load, activity)
+	afl.introspector.UpdateAttentionThreshold(threshold)
+
+	afl.mu.Lock()
+	afl.adjustments++
+	afl.lastThreshold = threshold
+	rescanDue := afl.rescanEach > 0 && afl.adjustments%uint64(afl.rescanEach) == 0
+	afl.mu.Unlock()
+
+	// Periodic rescans pick up repository changes; the threshold
+	// shift alone does not re-filter already scanned nodes
+	if rescanDue {
+		if err := afl.introspector.Scan(); err != nil {
+			fmt.Printf("⚠️ EchoSelf: attention rescan failed: %v\n", err)
+		} else {
+			afl.mu.Lock()
+			afl.rescans++
+			afl.surfaced = make(map[string]bool)
+			afl.mu.Unlock()
+		}
+	}
+
+	afl.surfaceSalientFiles(threshold)
+}
+
+// surfaceSalientFiles emits self-improvement thoughts for files whose
+// salience clears the current threshold
+func (afl *AttentionFeedbackLoop) surfaceSalientFiles(threshold float64) {
+	afl.mu.RLock()
+	callback := afl.onSelfImprovement
+	afl.mu.RUnlock()
+	if callback == nil {
+		return
+	}
+
+	for _, node := range afl.introspector.GetHighSalienceFiles() {
+		if node.SalienceScore < threshold {
+			continue
+		}
+
+		afl.mu.Lock()
+		if afl.surfaced[node.Path] {
+			afl.mu.Unlock()
+			continue
+		}
+		afl.surfaced[node.Path] = true
+		afl.thoughtsSurfaced++
+		afl.mu.Unlock()
+
+		relPath := strings.TrimPrefix(node.Path, afl.introspector.rootPath+"/")
+		content := fmt.Sprintf(
+			"The file %s keeps drawing my attention (salience %.2f) - I should consider improving it",
+			relPath, node.SalienceScore)
+		callback(content, node.SalienceScore)
+	}
+}
+
+// GetMetrics returns feedback loop statistics
+func (afl *AttentionFeedbackLoop) GetMetrics() map[string]interface{} {
+	afl.mu.RLock()
+	defer afl.mu.RUnlock()
+
+	return map[string]interface{}{
+		"adjustments":       afl.adjustments,
+		"rescans":           afl.rescans,
+		"thoughts_surfaced": afl.thoughtsSurfaced,
+		"last_threshold":    afl.lastThreshold,
+		"running":           afl.running,
+	}
+}
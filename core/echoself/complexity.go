@@ -0,0 +1,115 @@
+package echoself
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// Code complexity metrics: introspection that only sees symbols can
+// say what exists but not what is hard to change. Cyclomatic
+// complexity and length are computed per function while parsing, and
+// import graphs give package coupling, so GetStats can answer "where
+// is the gnarly code" without a separate tool.
+
+// cyclomaticComplexity counts decision points in a function body plus
+// one (the standard McCabe measure)
+func cyclomaticComplexity(body *ast.BlockStmt) int {
+	complexity := 1
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt, *ast.CaseClause, *ast.CommClause:
+			complexity++
+		case *ast.BinaryExpr:
+			if n.Op == token.LAND || n.Op == token.LOR {
+				complexity++
+			}
+		}
+		return true
+	})
+	return complexity
+}
+
+// functionLength measures a function body in source lines
+func functionLength(fset *token.FileSet, body *ast.BlockStmt) int {
+	return fset.Position(body.End()).Line - fset.Position(body.Pos()).Line + 1
+}
+
+// internalImportName extracts the package name from an internal
+// import path, or "" for external/stdlib imports
+func internalImportName(importPath string) string {
+	const modulePrefix = "github.com/EchoCog/echollama/"
+	if !strings.HasPrefix(importPath, modulePrefix) {
+		return ""
+	}
+	parts := strings.Split(strings.TrimPrefix(importPath, modulePrefix), "/")
+	return parts[len(parts)-1]
+}
+
+// ComplexityStats aggregates the per-symbol metrics
+type ComplexityStats struct {
+	AverageComplexity float64 `json:"average_complexity"`
+	MaxComplexity     int     `json:"max_complexity"`
+	MostComplex       string  `json:"most_complex"`
+
+	AverageLength float64 `json:"average_length"`
+	MaxLength     int     `json:"max_length"`
+	Longest       string  `json:"longest"`
+
+	// ComplexFunctions counts functions above the conventional
+	// refactoring threshold of 10
+	ComplexFunctions int `json:"complex_functions"`
+
+	// AverageCoupling is the mean number of distinct internal
+	// packages each package imports
+	AverageCoupling float64 `json:"average_coupling"`
+	MostCoupled     string  `json:"most_coupled"`
+	MaxCoupling     int     `json:"max_coupling"`
+}
+
+// complexityStatsLocked aggregates metrics; callers hold si.mu
+func (si *SymbolIntrospector) complexityStatsLocked() ComplexityStats {
+	stats := ComplexityStats{}
+
+	functions := 0
+	totalComplexity, totalLength := 0, 0
+	for _, symbol := range si.symbols {
+		if symbol.Kind != SymbolFunction && symbol.Kind != SymbolMethod {
+			continue
+		}
+		functions++
+		totalComplexity += symbol.Complexity
+		totalLength += symbol.Length
+		if symbol.Complexity > stats.MaxComplexity {
+			stats.MaxComplexity = symbol.Complexity
+			stats.MostComplex = symbol.ID
+		}
+		if symbol.Length > stats.MaxLength {
+			stats.MaxLength = symbol.Length
+			stats.Longest = symbol.ID
+		}
+		if symbol.Complexity > 10 {
+			stats.ComplexFunctions++
+		}
+	}
+	if functions > 0 {
+		stats.AverageComplexity = float64(totalComplexity) / float64(functions)
+		stats.AverageLength = float64(totalLength) / float64(functions)
+	}
+
+	packages := 0
+	totalCoupling := 0
+	for pkg, imports := range si.packageImports {
+		packages++
+		totalCoupling += len(imports)
+		if len(imports) > stats.MaxCoupling {
+			stats.MaxCoupling = len(imports)
+			stats.MostCoupled = pkg
+		}
+	}
+	if packages > 0 {
+		stats.AverageCoupling = float64(totalCoupling) / float64(packages)
+	}
+
+	return stats
+}
@@ -0,0 +1,166 @@
+package echoself
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Persisted introspection: a single scan says what the codebase looks
+// like, but self-awareness needs to know what changed since the last
+// look. Scans serialize to JSON (salience and shape, not file
+// contents) and two records diff into added, removed, and shifted
+// files so the system can narrate its own evolution between sessions.
+
+// ScanRecord is one persisted introspection scan
+type ScanRecord struct {
+	Timestamp          time.Time                `json:"timestamp"`
+	RootPath           string                   `json:"root_path"`
+	AttentionThreshold float64                  `json:"attention_threshold"`
+	TotalFiles         int                      `json:"total_files"`
+	Files              map[string]PersistedFile `json:"files"`
+}
+
+// PersistedFile is the durable slice of a FileNode (content omitted)
+type PersistedFile struct {
+	Type          string  `json:"type"`
+	SalienceScore float64 `json:"salience_score"`
+	LastModified  int64   `json:"last_modified"`
+	Size          int64   `json:"size"`
+}
+
+// ScanDiff captures what changed between two scans
+type ScanDiff struct {
+	Previous time.Time `json:"previous"`
+	Current  time.Time `json:"current"`
+
+	AddedFiles   []string `json:"added_files"`
+	RemovedFiles []string `json:"removed_files"`
+	// ModifiedFiles changed on disk (mtime or size) between scans
+	ModifiedFiles []string `json:"modified_files"`
+	// SalienceShifts maps path -> (new - old) salience for files
+	// whose score moved by more than 0.05
+	SalienceShifts map[string]float64 `json:"salience_shifts"`
+}
+
+// Empty reports whether the two scans were identical
+func (diff *ScanDiff) Empty() bool {
+	return len(diff.AddedFiles) == 0 && len(diff.RemovedFiles) == 0 &&
+		len(diff.ModifiedFiles) == 0 && len(diff.SalienceShifts) == 0
+}
+
+// Record captures the current scan state as a persistable record
+func (ri *RepositoryIntrospector) Record() *ScanRecord {
+	ri.mu.RLock()
+	defer ri.mu.RUnlock()
+
+	record := &ScanRecord{
+		Timestamp:          time.Now(),
+		RootPath:           ri.rootPath,
+		AttentionThreshold: ri.attentionThreshold,
+		TotalFiles:         ri.totalFiles,
+		Files:              make(map[string]PersistedFile, len(ri.fileNodes)),
+	}
+	for path, node := range ri.fileNodes {
+		record.Files[path] = PersistedFile{
+			Type:          node.Type,
+			SalienceScore: node.SalienceScore,
+			LastModified:  node.LastModified,
+			Size:          node.Size,
+		}
+	}
+	return record
+}
+
+// SaveScan persists the current scan state to a JSON file
+func (ri *RepositoryIntrospector) SaveScan(path string) error {
+	data, err := json.MarshalIndent(ri.Record(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode scan record: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write scan record: %w", err)
+	}
+	return nil
+}
+
+// LoadScan reads a previously saved scan record
+func LoadScan(path string) (*ScanRecord, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scan record: %w", err)
+	}
+	var record ScanRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("failed to decode scan record: %w", err)
+	}
+	return &record, nil
+}
+
+// DiffScans compares two scan records
+func DiffScans(previous, current *ScanRecord) *ScanDiff {
+	diff := &ScanDiff{
+		Previous:       previous.Timestamp,
+		Current:        current.Timestamp,
+		SalienceShifts: make(map[string]float64),
+	}
+
+	for path, file := range current.Files {
+		before, existed := previous.Files[path]
+		if !existed {
+			diff.AddedFiles = append(diff.AddedFiles, path)
+			continue
+		}
+		if file.LastModified != before.LastModified || file.Size != before.Size {
+			diff.ModifiedFiles = append(diff.ModifiedFiles, path)
+		}
+		if shift := file.SalienceScore - before.SalienceScore; shift > 0.05 || shift < -0.05 {
+			diff.SalienceShifts[path] = shift
+		}
+	}
+	for path := range previous.Files {
+		if _, exists := current.Files[path]; !exists {
+			diff.RemovedFiles = append(diff.RemovedFiles, path)
+		}
+	}
+
+	sort.Strings(diff.AddedFiles)
+	sort.Strings(diff.RemovedFiles)
+	sort.Strings(diff.ModifiedFiles)
+	return diff
+}
+
+// DiffAgainstSaved diffs the current scan against a saved record
+func (ri *RepositoryIntrospector) DiffAgainstSaved(path string) (*ScanDiff, error) {
+	previous, err := LoadScan(path)
+	if err != nil {
+		return nil, err
+	}
+	return DiffScans(previous, ri.Record()), nil
+}
+
+// Summary renders the diff for logs and the stream of consciousness
+func (diff *ScanDiff) Summary() string {
+	if diff.Empty() {
+		return "No changes since the last scan"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Changes since %s:\n", diff.Previous.Format("2006-01-02 15:04")))
+	if len(diff.AddedFiles) > 0 {
+		sb.WriteString(fmt.Sprintf("  %d file(s) added\n", len(diff.AddedFiles)))
+	}
+	if len(diff.RemovedFiles) > 0 {
+		sb.WriteString(fmt.Sprintf("  %d file(s) removed\n", len(diff.RemovedFiles)))
+	}
+	if len(diff.ModifiedFiles) > 0 {
+		sb.WriteString(fmt.Sprintf("  %d file(s) modified\n", len(diff.ModifiedFiles)))
+	}
+	for path, shift := range diff.SalienceShifts {
+		sb.WriteString(fmt.Sprintf("  salience %+.2f %s\n", shift, path))
+	}
+	return sb.String()
+}
@@ -16,6 +16,9 @@ type RepositoryIntrospector struct {
 	fileNodes         map[string]*FileNode
 	totalFiles        int
 	scannedFiles      int
+
+	// Optional pluggable scoring (see salience_scorers.go)
+	scorers           *SaliencePipeline
 }
 
 // FileNode represents a file in the hypergraph-encoded repository
@@ -47,7 +50,11 @@ func (ri *RepositoryIntrospector) Scan() error {
 	ri.fileNodes = make(map[string]*FileNode)
 	ri.totalFiles = 0
 	ri.scannedFiles = 0
-	
+
+	if ri.scorers != nil {
+		return ri.scanWithPipelineLocked()
+	}
+
 	err := filepath.Walk(ri.rootPath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return nil // Skip files with errors
@@ -0,0 +1,352 @@
+package echoself
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Pluggable salience scoring: the introspector's hardwired path
+// heuristics can't weigh signals like how recently a file changed or
+// how many TODOs it carries. A SaliencePipeline combines independent
+// scorers with configurable weights, and each scorer's contribution
+// is recorded on the file node so a salience score can be explained,
+// not just consumed.
+
+// SalienceScorer produces one salience signal for a file, in 0..1
+type SalienceScorer interface {
+	Name() string
+	Score(file *FileNode) float64
+}
+
+// corpusAware scorers need the whole file set before scoring
+// (e.g. cross-file reference counts)
+type corpusAware interface {
+	Prime(files []*FileNode)
+}
+
+// WeightedScorer pairs a scorer with its weight in the pipeline
+type WeightedScorer struct {
+	Scorer SalienceScorer
+	Weight float64
+}
+
+// SaliencePipeline blends multiple scorers into one salience score
+type SaliencePipeline struct {
+	scorers []WeightedScorer
+}
+
+// NewSaliencePipeline creates an empty pipeline
+func NewSaliencePipeline() *SaliencePipeline {
+	return &SaliencePipeline{}
+}
+
+// NewDefaultSaliencePipeline wires the standard scorers: path
+// heuristics carry the most weight, with recency, TODO density, and
+// reference counts refining the score
+func NewDefaultSaliencePipeline(rootPath string) *SaliencePipeline {
+	pipeline := NewSaliencePipeline()
+	pipeline.AddScorer(&PathHeuristicScorer{}, 0.4)
+	pipeline.AddScorer(NewGitRecencyScorer(rootPath), 0.2)
+	pipeline.AddScorer(&TodoDensityScorer{}, 0.2)
+	pipeline.AddScorer(&ReferenceCountScorer{}, 0.2)
+	return pipeline
+}
+
+// AddScorer appends a scorer with the given weight
+func (sp *SaliencePipeline) AddScorer(scorer SalienceScorer, weight float64) {
+	if weight <= 0 {
+		return
+	}
+	sp.scorers = append(sp.scorers, WeightedScorer{Scorer: scorer, Weight: weight})
+}
+
+// Prime gives corpus-aware scorers the full file set before scoring
+func (sp *SaliencePipeline) Prime(files []*FileNode) {
+	for _, weighted := range sp.scorers {
+		if aware, ok := weighted.Scorer.(corpusAware); ok {
+			aware.Prime(files)
+		}
+	}
+}
+
+// Score computes the weighted blend and records each scorer's
+// contribution in the file's metadata
+func (sp *SaliencePipeline) Score(file *FileNode) float64 {
+	if len(sp.scorers) == 0 {
+		return 0.5
+	}
+
+	breakdown := make(map[string]interface{}, len(sp.scorers))
+	total, weightSum := 0.0, 0.0
+	for _, weighted := range sp.scorers {
+		score := weighted.Scorer.Score(file)
+		if score < 0 {
+			score = 0
+		}
+		if score > 1 {
+			score = 1
+		}
+		breakdown[weighted.Scorer.Name()] = score
+		total += score * weighted.Weight
+		weightSum += weighted.Weight
+	}
+
+	if file.Metadata == nil {
+		file.Metadata = make(map[string]interface{})
+	}
+	file.Metadata["salience_breakdown"] = breakdown
+	return total / weightSum
+}
+
+// PathHeuristicScorer carries the original directory and filename
+// heuristics
+type PathHeuristicScorer struct{}
+
+func (s *PathHeuristicScorer) Name() string { return "path" }
+
+func (s *PathHeuristicScorer) Score(file *FileNode) float64 {
+	score := 0.5
+	path := file.Path
+
+	if strings.Contains(path, "/core/") {
+		score += 0.3
+	}
+	for dir, bonus := range map[string]float64{
+		"/autonomous":    0.2,
+		"/echoself":      0.2,
+		"/deeptreeecho":  0.2,
+		"/consciousness": 0.2,
+		"/echobeats":     0.15,
+		"/echodream":     0.15,
+		"/goals":         0.15,
+	} {
+		if strings.Contains(path, dir) {
+			score += bonus
+		}
+	}
+
+	if strings.Contains(path, "README") {
+		score += 0.3
+	}
+	if strings.Contains(path, "autonomous_echoself") {
+		score += 0.4
+	}
+	if strings.Contains(path, "types.go") {
+		score += 0.1
+	}
+	if strings.HasSuffix(path, ".bak") || strings.HasSuffix(path, ".wip") || strings.HasSuffix(path, ".backup") {
+		score -= 0.5
+	}
+	if strings.Contains(path, "_test.go") || strings.HasPrefix(filepath.Base(path), "test_") {
+		score -= 0.2
+	}
+
+	if score > 1.0 {
+		score = 1.0
+	}
+	if score < 0 {
+		score = 0
+	}
+	return score
+}
+
+// GitRecencyScorer scores files by how recently git last touched
+// them, falling back to filesystem modification time outside a
+// repository
+type GitRecencyScorer struct {
+	rootPath   string
+	commitTime map[string]time.Time
+}
+
+// NewGitRecencyScorer builds the recency map with a single git log
+// pass over recent history
+func NewGitRecencyScorer(rootPath string) *GitRecencyScorer {
+	scorer := &GitRecencyScorer{
+		rootPath:   rootPath,
+		commitTime: make(map[string]time.Time),
+	}
+
+	out, err := exec.Command("git", "-C", rootPath, "log", "-n", "500",
+		"--pretty=format:@%ct", "--name-only").Output()
+	if err != nil {
+		return scorer
+	}
+
+	var current time.Time
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "@") {
+			var seconds int64
+			for _, ch := range line[1:] {
+				if ch < '0' || ch > '9' {
+					seconds = 0
+					break
+				}
+				seconds = seconds*10 + int64(ch-'0')
+			}
+			current = time.Unix(seconds, 0)
+			continue
+		}
+		// First (most recent) commit touching a file wins
+		if _, seen := scorer.commitTime[line]; !seen {
+			scorer.commitTime[line] = current
+		}
+	}
+	return scorer
+}
+
+func (s *GitRecencyScorer) Name() string { return "git_recency" }
+
+func (s *GitRecencyScorer) Score(file *FileNode) float64 {
+	touched := time.Unix(file.LastModified, 0)
+	if rel, err := filepath.Rel(s.rootPath, file.Path); err == nil {
+		if commitTime, ok := s.commitTime[filepath.ToSlash(rel)]; ok {
+			touched = commitTime
+		}
+	}
+
+	age := time.Since(touched)
+	switch {
+	case age < 24*time.Hour:
+		return 1.0
+	case age < 7*24*time.Hour:
+		return 0.8
+	case age < 30*24*time.Hour:
+		return 0.6
+	case age < 180*24*time.Hour:
+		return 0.4
+	}
+	return 0.2
+}
+
+// TodoDensityScorer raises salience for files carrying unfinished
+// work markers
+type TodoDensityScorer struct{}
+
+func (s *TodoDensityScorer) Name() string { return "todo_density" }
+
+func (s *TodoDensityScorer) Score(file *FileNode) float64 {
+	if file.Content == "" {
+		return 0.5
+	}
+
+	markers := strings.Count(file.Content, "TODO") +
+		strings.Count(file.Content, "FIXME") +
+		strings.Count(file.Content, "HACK") +
+		strings.Count(file.Content, "XXX")
+	lines := strings.Count(file.Content, "\n") + 1
+
+	// Density per 100 lines, saturating at 5
+	density := float64(markers) * 100 / float64(lines)
+	score := 0.5 + density/10
+	if score > 1.0 {
+		score = 1.0
+	}
+	return score
+}
+
+// ReferenceCountScorer raises salience for files whose base name is
+// mentioned across the rest of the corpus
+type ReferenceCountScorer struct {
+	references map[string]int
+}
+
+func (s *ReferenceCountScorer) Name() string { return "reference_count" }
+
+// Prime counts cross-file mentions of each file's base name
+func (s *ReferenceCountScorer) Prime(files []*FileNode) {
+	s.references = make(map[string]int, len(files))
+	for _, file := range files {
+		base := filepath.Base(file.Path)
+		name := strings.TrimSuffix(base, filepath.Ext(base))
+		if name == "" || name == "main" {
+			continue
+		}
+		for _, other := range files {
+			if other.Path == file.Path {
+				continue
+			}
+			if strings.Contains(other.Content, name) {
+				s.references[file.Path]++
+			}
+		}
+	}
+}
+
+func (s *ReferenceCountScorer) Score(file *FileNode) float64 {
+	if s.references == nil {
+		return 0.5
+	}
+	count := float64(s.references[file.Path])
+	score := 0.3 + count*0.05
+	if score > 1.0 {
+		score = 1.0
+	}
+	return score
+}
+
+// SetSaliencePipeline installs a pluggable scoring pipeline; Scan
+// uses it in place of the built-in heuristics
+func (ri *RepositoryIntrospector) SetSaliencePipeline(pipeline *SaliencePipeline) {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+	ri.scorers = pipeline
+}
+
+// scanWithPipelineLocked is the pipeline variant of Scan: it gathers
+// every candidate file with content first (corpus-aware scorers need
+// the full set), then scores and filters
+func (ri *RepositoryIntrospector) scanWithPipelineLocked() error {
+	candidates := make([]*FileNode, 0)
+	err := filepath.Walk(ri.rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil // Skip files with errors
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			return nil
+		}
+		if !ri.isCodeFile(path) {
+			return nil
+		}
+
+		ri.totalFiles++
+		node := &FileNode{
+			Path:         path,
+			Type:         ri.getFileType(path),
+			LastModified: info.ModTime().Unix(),
+			Size:         info.Size(),
+			Links:        make([]string, 0),
+			Metadata:     make(map[string]interface{}),
+		}
+		if info.Size() < 100000 { // 100KB limit
+			content, err := os.ReadFile(path)
+			if err == nil {
+				node.Content = string(content)
+			}
+		} else {
+			node.Content = "[File too large - content omitted]"
+		}
+		candidates = append(candidates, node)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ri.scorers.Prime(candidates)
+	for _, node := range candidates {
+		node.SalienceScore = ri.scorers.Score(node)
+		if node.SalienceScore < ri.attentionThreshold {
+			continue
+		}
+		ri.fileNodes[node.Path] = node
+		ri.scannedFiles++
+	}
+	return nil
+}
@@ -0,0 +1,407 @@
+package echoself
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/EchoCog/echollama/core/memory"
+)
+
+// SymbolIntrospector deepens self-awareness from files to symbols:
+// it parses the Go source under the root path and extracts packages,
+// types, and functions together with best-effort call edges, so the
+// system can reason about its own structure at the level it actually
+// thinks in — "who calls assessEvolutionaryDimension" rather than
+// "which files exist".
+
+// SymbolKind classifies an extracted symbol
+type SymbolKind string
+
+const (
+	SymbolPackage  SymbolKind = "package"
+	SymbolType     SymbolKind = "type"
+	SymbolFunction SymbolKind = "function"
+	SymbolMethod   SymbolKind = "method"
+)
+
+// SymbolNode represents one symbol in the hypergraph-encoded codebase
+type SymbolNode struct {
+	ID            string
+	Kind          SymbolKind
+	Name          string
+	Package       string
+	File          string
+	Line          int
+	Exported      bool
+	HasDoc        bool
+	SalienceScore float64
+	// Calls holds the IDs of symbols this function calls (functions
+	// and methods only)
+	Calls []string
+	// Complexity and Length apply to functions and methods (see
+	// complexity.go)
+	Complexity int
+	Length     int
+}
+
+// SymbolIntrospector scans Go source into a symbol-level graph
+type SymbolIntrospector struct {
+	mu       sync.RWMutex
+	rootPath string
+	symbols  map[string]*SymbolNode
+	// callers counts incoming call edges per symbol ID
+	callers map[string]int
+	// packageImports maps package name -> distinct internal packages
+	// it imports (see complexity.go)
+	packageImports map[string]map[string]bool
+	parsedFiles    int
+}
+
+// NewSymbolIntrospector creates a symbol-level introspector
+func NewSymbolIntrospector(rootPath string) *SymbolIntrospector {
+	return &SymbolIntrospector{
+		rootPath:       rootPath,
+		symbols:        make(map[string]*SymbolNode),
+		callers:        make(map[string]int),
+		packageImports: make(map[string]map[string]bool),
+	}
+}
+
+// Scan parses every Go file under the root path and rebuilds the
+// symbol graph
+func (si *SymbolIntrospector) Scan() error {
+	si.mu.Lock()
+	defer si.mu.Unlock()
+
+	si.symbols = make(map[string]*SymbolNode)
+	si.callers = make(map[string]int)
+	si.packageImports = make(map[string]map[string]bool)
+	si.parsedFiles = 0
+
+	fset := token.NewFileSet()
+	// rawCalls maps caller ID -> called names, resolved to IDs once
+	// every symbol is known
+	rawCalls := make(map[string][]string)
+
+	err := filepath.Walk(si.rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil // Skip files with errors
+		}
+		if info.IsDir() {
+			if strings.HasPrefix(info.Name(), ".") || info.Name() == "vendor" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil // Skip files that don't parse
+		}
+		si.parsedFiles++
+		si.collectSymbols(fset, path, file, rawCalls)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	si.resolveCalls(rawCalls)
+	si.scoreSymbols()
+	return nil
+}
+
+// collectSymbols extracts the package, type, and function symbols
+// from one parsed file
+func (si *SymbolIntrospector) collectSymbols(fset *token.FileSet, path string, file *ast.File, rawCalls map[string][]string) {
+	pkgName := file.Name.Name
+	pkgID := "pkg:" + pkgName
+	if _, exists := si.symbols[pkgID]; !exists {
+		si.symbols[pkgID] = &SymbolNode{
+			ID:      pkgID,
+			Kind:    SymbolPackage,
+			Name:    pkgName,
+			Package: pkgName,
+			File:    filepath.Dir(path),
+		}
+	}
+
+	// Track internal imports for package coupling
+	for _, imported := range file.Imports {
+		importPath := strings.Trim(imported.Path.Value, `"`)
+		if name := internalImportName(importPath); name != "" && name != pkgName {
+			if si.packageImports[pkgName] == nil {
+				si.packageImports[pkgName] = make(map[string]bool)
+			}
+			si.packageImports[pkgName][name] = true
+		}
+	}
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range d.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				symbol := &SymbolNode{
+					ID:       fmt.Sprintf("type:%s.%s", pkgName, typeSpec.Name.Name),
+					Kind:     SymbolType,
+					Name:     typeSpec.Name.Name,
+					Package:  pkgName,
+					File:     path,
+					Line:     fset.Position(typeSpec.Pos()).Line,
+					Exported: typeSpec.Name.IsExported(),
+					HasDoc:   d.Doc != nil,
+				}
+				si.symbols[symbol.ID] = symbol
+			}
+
+		case *ast.FuncDecl:
+			kind := SymbolFunction
+			name := d.Name.Name
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				kind = SymbolMethod
+				name = receiverTypeName(d.Recv.List[0].Type) + "." + name
+			}
+			symbol := &SymbolNode{
+				ID:       fmt.Sprintf("func:%s.%s", pkgName, name),
+				Kind:     kind,
+				Name:     name,
+				Package:  pkgName,
+				File:     path,
+				Line:     fset.Position(d.Pos()).Line,
+				Exported: d.Name.IsExported(),
+				HasDoc:   d.Doc != nil,
+			}
+			si.symbols[symbol.ID] = symbol
+
+			if d.Body != nil {
+				symbol.Complexity = cyclomaticComplexity(d.Body)
+				symbol.Length = functionLength(fset, d.Body)
+				rawCalls[symbol.ID] = append(rawCalls[symbol.ID], calledNames(d.Body)...)
+			}
+		}
+	}
+}
+
+// receiverTypeName unwraps a method receiver type to its base name
+func receiverTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(t.X)
+	}
+	return "?"
+}
+
+// calledNames collects the bare names invoked inside a function body
+func calledNames(body *ast.BlockStmt) []string {
+	names := make([]string, 0)
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		switch fn := call.Fun.(type) {
+		case *ast.Ident:
+			names = append(names, fn.Name)
+		case *ast.SelectorExpr:
+			names = append(names, fn.Sel.Name)
+		}
+		return true
+	})
+	return names
+}
+
+// resolveCalls matches collected call names against known symbols.
+// Without full type information this is name-based and best-effort:
+// same-package matches win, then any unique cross-package match.
+func (si *SymbolIntrospector) resolveCalls(rawCalls map[string][]string) {
+	// Index function symbols by bare name (method names use their
+	// final component)
+	byName := make(map[string][]*SymbolNode)
+	for _, symbol := range si.symbols {
+		if symbol.Kind != SymbolFunction && symbol.Kind != SymbolMethod {
+			continue
+		}
+		parts := strings.Split(symbol.Name, ".")
+		byName[parts[len(parts)-1]] = append(byName[parts[len(parts)-1]], symbol)
+	}
+
+	for callerID, names := range rawCalls {
+		caller := si.symbols[callerID]
+		seen := make(map[string]bool)
+		for _, name := range names {
+			candidates := byName[name]
+			var target *SymbolNode
+			for _, candidate := range candidates {
+				if candidate.Package == caller.Package {
+					target = candidate
+					break
+				}
+			}
+			if target == nil && len(candidates) == 1 {
+				target = candidates[0]
+			}
+			if target == nil || target.ID == callerID || seen[target.ID] {
+				continue
+			}
+			seen[target.ID] = true
+			caller.Calls = append(caller.Calls, target.ID)
+			si.callers[target.ID]++
+		}
+	}
+}
+
+// scoreSymbols assigns symbol-level salience: exported, documented,
+// and frequently called symbols matter more
+func (si *SymbolIntrospector) scoreSymbols() {
+	for _, symbol := range si.symbols {
+		score := 0.4
+		if symbol.Exported {
+			score += 0.2
+		}
+		if symbol.HasDoc {
+			score += 0.1
+		}
+		inDegree := float64(si.callers[symbol.ID]) * 0.05
+		if inDegree > 0.3 {
+			inDegree = 0.3
+		}
+		score += inDegree
+		if score > 1.0 {
+			score = 1.0
+		}
+		symbol.SalienceScore = score
+	}
+}
+
+// GetSymbols returns all symbols sorted by salience, highest first
+func (si *SymbolIntrospector) GetSymbols() []*SymbolNode {
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+
+	symbols := make([]*SymbolNode, 0, len(si.symbols))
+	for _, symbol := range si.symbols {
+		symbols = append(symbols, symbol)
+	}
+	sort.Slice(symbols, func(i, j int) bool {
+		return symbols[i].SalienceScore > symbols[j].SalienceScore
+	})
+	return symbols
+}
+
+// GetSymbol returns a specific symbol by ID
+func (si *SymbolIntrospector) GetSymbol(id string) (*SymbolNode, bool) {
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+
+	symbol, ok := si.symbols[id]
+	return symbol, ok
+}
+
+// MirrorToHypergraph encodes the symbol graph into the hypergraph:
+// symbols become concept nodes, containment becomes part_of edges,
+// and calls become leads_to edges
+func (si *SymbolIntrospector) MirrorToHypergraph(hg *memory.HypergraphMemory) (int, error) {
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+
+	nodeIDs := make(map[string]string, len(si.symbols))
+	mirrored := 0
+	for _, symbol := range si.symbols {
+		node := &memory.MemoryNode{
+			Type:       memory.NodeConcept,
+			Content:    fmt.Sprintf("%s %s (%s)", symbol.Kind, symbol.Name, symbol.Package),
+			Importance: symbol.SalienceScore,
+			Metadata: map[string]interface{}{
+				"source":    "symbol_introspection",
+				"symbol_id": symbol.ID,
+				"kind":      string(symbol.Kind),
+				"file":      symbol.File,
+				"line":      symbol.Line,
+			},
+		}
+		if err := hg.AddNode(node); err != nil {
+			return mirrored, fmt.Errorf("failed to mirror symbol %s: %w", symbol.ID, err)
+		}
+		nodeIDs[symbol.ID] = node.ID
+		mirrored++
+	}
+
+	for _, symbol := range si.symbols {
+		if symbol.Kind == SymbolPackage {
+			continue
+		}
+		// Containment: symbol part_of its package
+		if pkgNodeID, ok := nodeIDs["pkg:"+symbol.Package]; ok {
+			hg.AddEdge(&memory.MemoryEdge{
+				SourceID: nodeIDs[symbol.ID],
+				TargetID: pkgNodeID,
+				Type:     memory.EdgePartOf,
+				Weight:   1.0,
+				Metadata: map[string]interface{}{"source": "symbol_introspection"},
+			})
+		}
+		// Call edges
+		for _, calleeID := range symbol.Calls {
+			if calleeNodeID, ok := nodeIDs[calleeID]; ok {
+				hg.AddEdge(&memory.MemoryEdge{
+					SourceID: nodeIDs[symbol.ID],
+					TargetID: calleeNodeID,
+					Type:     memory.EdgeLeadsTo,
+					Weight:   1.0,
+					Metadata: map[string]interface{}{
+						"source": "symbol_introspection",
+						"role":   "calls",
+					},
+				})
+			}
+		}
+	}
+
+	fmt.Printf("🔍 EchoSelf: mirrored %d symbol(s) into the hypergraph\n", mirrored)
+	return mirrored, nil
+}
+
+// GetStats returns statistics about the symbol scan
+func (si *SymbolIntrospector) GetStats() map[string]interface{} {
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+
+	counts := make(map[SymbolKind]int)
+	callEdges := 0
+	for _, symbol := range si.symbols {
+		counts[symbol.Kind]++
+		callEdges += len(symbol.Calls)
+	}
+
+	return map[string]interface{}{
+		"root_path":    si.rootPath,
+		"parsed_files": si.parsedFiles,
+		"packages":     counts[SymbolPackage],
+		"types":        counts[SymbolType],
+		"functions":    counts[SymbolFunction],
+		"methods":      counts[SymbolMethod],
+		"call_edges":   callEdges,
+		"complexity":   si.complexityStatsLocked(),
+	}
+}
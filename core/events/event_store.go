@@ -0,0 +1,195 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Event is one append-only record of a consciousness state change.
+// Current state is derivable by folding events in sequence order,
+// enabling audits, time-travel debugging, and partial recovery.
+type Event struct {
+	Sequence  uint64                 `json:"sequence"`
+	Type      string                 `json:"type"`
+	Source    string                 `json:"source"`
+	Timestamp time.Time              `json:"timestamp"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+}
+
+// EventHandler receives events as they are appended
+type EventHandler func(Event)
+
+// EventStore is an append-only log of state-change events with
+// optional JSONL persistence and live subscriptions
+type EventStore struct {
+	mu          sync.RWMutex
+	events      []Event
+	sequence    uint64
+	subscribers []EventHandler
+
+	// Persistence (optional)
+	file   *os.File
+	writer *bufio.Writer
+
+	// Cap on in-memory retention; the file keeps full history
+	maxInMemory int
+}
+
+// NewEventStore creates an in-memory event store. Pass a non-empty
+// path to also persist every event as JSONL.
+func NewEventStore(path string) (*EventStore, error) {
+	store := &EventStore{
+		events:      make([]Event, 0),
+		subscribers: make([]EventHandler, 0),
+		maxInMemory: 10000,
+	}
+
+	if path != "" {
+		if dir := filepath.Dir(path); dir != "." {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return nil, fmt.Errorf("failed to create event store directory: %w", err)
+			}
+		}
+
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open event store: %w", err)
+		}
+		store.file = file
+		store.writer = bufio.NewWriter(file)
+	}
+
+	return store, nil
+}
+
+// Append records one event, assigns its sequence number, persists it,
+// and notifies subscribers
+func (es *EventStore) Append(eventType, source string, data map[string]interface{}) Event {
+	es.mu.Lock()
+
+	es.sequence++
+	event := Event{
+		Sequence:  es.sequence,
+		Type:      eventType,
+		Source:    source,
+		Timestamp: time.Now(),
+		Data:      data,
+	}
+
+	es.events = append(es.events, event)
+	if len(es.events) > es.maxInMemory {
+		es.events = es.events[len(es.events)-es.maxInMemory:]
+	}
+
+	if es.writer != nil {
+		if line, err := json.Marshal(event); err == nil {
+			es.writer.Write(line)
+			es.writer.WriteByte('\n')
+			es.writer.Flush()
+		}
+	}
+
+	subscribers := make([]EventHandler, len(es.subscribers))
+	copy(subscribers, es.subscribers)
+	es.mu.Unlock()
+
+	for _, handler := range subscribers {
+		handler(event)
+	}
+
+	return event
+}
+
+// Subscribe registers a handler invoked for every appended event
+func (es *EventStore) Subscribe(handler EventHandler) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	es.subscribers = append(es.subscribers, handler)
+}
+
+// Events returns the in-memory event history, optionally filtered by
+// type (empty type matches everything)
+func (es *EventStore) Events(eventType string) []Event {
+	es.mu.RLock()
+	defer es.mu.RUnlock()
+
+	matched := make([]Event, 0, len(es.events))
+	for _, event := range es.events {
+		if eventType == "" || event.Type == eventType {
+			matched = append(matched, event)
+		}
+	}
+	return matched
+}
+
+// Fold derives state by applying fn to every in-memory event in
+// sequence order, starting from initial
+func (es *EventStore) Fold(initial interface{}, fn func(state interface{}, event Event) interface{}) interface{} {
+	es.mu.RLock()
+	defer es.mu.RUnlock()
+
+	state := initial
+	for _, event := range es.events {
+		state = fn(state, event)
+	}
+	return state
+}
+
+// LoadEvents reads a persisted JSONL event log from disk, e.g. to
+// fold history from a previous run during recovery
+func LoadEvents(path string) ([]Event, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open event log: %w", err)
+	}
+	defer file.Close()
+
+	loaded := make([]Event, 0)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return nil, fmt.Errorf("malformed event: %w", err)
+		}
+		loaded = append(loaded, event)
+	}
+
+	return loaded, scanner.Err()
+}
+
+// Close flushes and closes the persistence file, if any
+func (es *EventStore) Close() error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	if es.writer != nil {
+		es.writer.Flush()
+	}
+	if es.file != nil {
+		return es.file.Close()
+	}
+	return nil
+}
+
+// GetMetrics returns event store metrics
+func (es *EventStore) GetMetrics() map[string]interface{} {
+	es.mu.RLock()
+	defer es.mu.RUnlock()
+
+	return map[string]interface{}{
+		"total_events":   es.sequence,
+		"events_in_memory": len(es.events),
+		"subscribers":    len(es.subscribers),
+		"persistent":     es.file != nil,
+	}
+}
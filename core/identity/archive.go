@@ -0,0 +1,152 @@
+package identity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/EchoCog/echollama/core/echobeats"
+	"github.com/EchoCog/echollama/core/memory"
+	"github.com/EchoCog/echollama/core/skills"
+	"github.com/EchoCog/echollama/core/wisdom"
+)
+
+// ArchiveVersion is bumped whenever the archive layout changes
+const ArchiveVersion = 1
+
+// IdentityArchive is a single versioned bundle of everything that
+// makes an echoself itself: identity, interests, skills, wisdom, and
+// a hypergraph snapshot. Archives can be moved between hosts to
+// migrate or fork an instance.
+type IdentityArchive struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+
+	// Identity
+	Name          string   `json:"name"`
+	CoreValues    []string `json:"core_values,omitempty"`
+	WisdomDomains []string `json:"wisdom_domains,omitempty"`
+
+	// Subsystem states, serialized by each subsystem's own exporter
+	Interests json.RawMessage `json:"interests,omitempty"`
+	Skills    json.RawMessage `json:"skills,omitempty"`
+	Wisdom    json.RawMessage `json:"wisdom,omitempty"`
+
+	// Hypergraph snapshot
+	Hypergraph *memory.GraphSnapshot `json:"hypergraph,omitempty"`
+}
+
+// Bundle collects the live subsystems that together form an identity.
+// Nil components are simply omitted from the archive.
+type Bundle struct {
+	Name          string
+	CoreValues    []string
+	WisdomDomains []string
+
+	Interests  *echobeats.InterestPatternSystem
+	Skills     *skills.SkillPracticeSystem
+	Wisdom     *wisdom.WisdomMetrics
+	Hypergraph *memory.HypergraphMemory
+}
+
+// ExportIdentity writes a versioned identity archive to w
+func (b *Bundle) ExportIdentity(w io.Writer) error {
+	archive := IdentityArchive{
+		Version:       ArchiveVersion,
+		ExportedAt:    time.Now(),
+		Name:          b.Name,
+		CoreValues:    b.CoreValues,
+		WisdomDomains: b.WisdomDomains,
+	}
+
+	if b.Interests != nil {
+		data, err := b.Interests.ExportState()
+		if err != nil {
+			return fmt.Errorf("failed to export interests: %w", err)
+		}
+		archive.Interests = data
+	}
+
+	if b.Skills != nil {
+		data, err := b.Skills.ExportState()
+		if err != nil {
+			return fmt.Errorf("failed to export skills: %w", err)
+		}
+		archive.Skills = data
+	}
+
+	if b.Wisdom != nil {
+		data, err := b.Wisdom.ExportState()
+		if err != nil {
+			return fmt.Errorf("failed to export wisdom: %w", err)
+		}
+		archive.Wisdom = data
+	}
+
+	if b.Hypergraph != nil {
+		archive.Hypergraph = b.Hypergraph.Snapshot()
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(archive); err != nil {
+		return fmt.Errorf("failed to write identity archive: %w", err)
+	}
+
+	fmt.Printf("📦 Exported identity archive for %q (v%d)\n", b.Name, ArchiveVersion)
+	return nil
+}
+
+// ImportIdentity reads a versioned identity archive from r and applies
+// it to the bundle's subsystems. Components absent from the archive or
+// from the bundle are skipped.
+func (b *Bundle) ImportIdentity(r io.Reader) error {
+	var archive IdentityArchive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return fmt.Errorf("failed to read identity archive: %w", err)
+	}
+
+	if archive.Version > ArchiveVersion {
+		return fmt.Errorf("identity archive version %d is newer than supported version %d",
+			archive.Version, ArchiveVersion)
+	}
+
+	if archive.Name != "" {
+		b.Name = archive.Name
+	}
+	if len(archive.CoreValues) > 0 {
+		b.CoreValues = archive.CoreValues
+	}
+	if len(archive.WisdomDomains) > 0 {
+		b.WisdomDomains = archive.WisdomDomains
+	}
+
+	if b.Interests != nil && len(archive.Interests) > 0 {
+		if err := b.Interests.ImportState(archive.Interests); err != nil {
+			return fmt.Errorf("failed to import interests: %w", err)
+		}
+	}
+
+	if b.Skills != nil && len(archive.Skills) > 0 {
+		if err := b.Skills.ImportState(archive.Skills); err != nil {
+			return fmt.Errorf("failed to import skills: %w", err)
+		}
+	}
+
+	if b.Wisdom != nil && len(archive.Wisdom) > 0 {
+		if err := b.Wisdom.ImportState(archive.Wisdom); err != nil {
+			return fmt.Errorf("failed to import wisdom: %w", err)
+		}
+	}
+
+	if b.Hypergraph != nil && archive.Hypergraph != nil {
+		if err := b.Hypergraph.RestoreSnapshot(archive.Hypergraph); err != nil {
+			return fmt.Errorf("failed to import hypergraph: %w", err)
+		}
+	}
+
+	fmt.Printf("📦 Imported identity archive for %q (exported %s)\n",
+		archive.Name, archive.ExportedAt.Format(time.RFC3339))
+	return nil
+}
@@ -1,6 +1,7 @@
 package llm
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -38,6 +39,11 @@ func (ap *AnthropicProvider) Name() string {
 	return "anthropic"
 }
 
+// Model returns the configured model name
+func (ap *AnthropicProvider) Model() string {
+	return ap.model
+}
+
 // Available checks if the provider is configured
 func (ap *AnthropicProvider) Available() bool {
 	return ap.apiKey != ""
@@ -228,24 +234,28 @@ func (ap *AnthropicProvider) StreamGenerate(ctx context.Context, prompt string,
 			return
 		}
 		
-		// Read streaming response
-		decoder := json.NewDecoder(resp.Body)
-		for {
+		// Read streaming response (SSE format): "event:"/"data:" line
+		// pairs; the event type is repeated in the data payload
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+
 			var event map[string]interface{}
-			if err := decoder.Decode(&event); err != nil {
-				if err == io.EOF {
-					break
-				}
-				outChan <- StreamChunk{Error: fmt.Errorf("failed to decode stream: %w", err)}
-				return
+			if err := json.Unmarshal([]byte(payload), &event); err != nil {
+				continue
 			}
-			
+
 			// Handle different event types
 			eventType, ok := event["type"].(string)
 			if !ok {
 				continue
 			}
-			
+
 			switch eventType {
 			case "content_block_delta":
 				if delta, ok := event["delta"].(map[string]interface{}); ok {
@@ -342,3 +352,26 @@ func (ap *AnthropicProvider) GenerateWithMessages(ctx context.Context, messages
 	
 	return result.String(), nil
 }
+
+// Chat implements ChatCapable: system turns fold into the system
+// prompt, user/assistant turns pass through natively
+func (ap *AnthropicProvider) Chat(ctx context.Context, messages []Message, opts GenerateOptions) (string, error) {
+	converted := make([]anthropicMessage, 0, len(messages))
+	for _, msg := range messages {
+		if msg.Role == RoleSystem {
+			if opts.SystemPrompt == "" {
+				opts.SystemPrompt = msg.Content
+			} else {
+				opts.SystemPrompt += "\n" + msg.Content
+			}
+			continue
+		}
+		converted = append(converted, anthropicMessage{Role: msg.Role, Content: msg.Content})
+	}
+	return ap.GenerateWithMessages(ctx, converted, opts)
+}
+
+// SetAPIKey installs a new credential (used by key rotation)
+func (ap *AnthropicProvider) SetAPIKey(apiKey string) {
+	ap.apiKey = apiKey
+}
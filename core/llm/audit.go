@@ -0,0 +1,194 @@
+package llm
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// Audit logging: debugging agent behavior needs the actual prompts
+// and responses, but those may carry secrets or PII. An optional sink
+// records every request with latency/token/cost figures, redacting
+// configured patterns before anything is stored.
+
+// AuditRecord is one logged LLM exchange
+type AuditRecord struct {
+	ID        int64     `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Provider  string    `json:"provider"`
+	Prompt    string    `json:"prompt"`
+	Response  string    `json:"response"`
+	LatencyMs int64     `json:"latency_ms"`
+	Tokens    int       `json:"tokens"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// defaultRedactions cover the obvious secret shapes: API keys and
+// bearer tokens
+var defaultRedactions = []*regexp.Regexp{
+	regexp.MustCompile(`sk-[A-Za-z0-9_-]{16,}`),
+	regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9._-]{16,}`),
+	regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`),
+}
+
+// auditLog is the bounded in-memory audit sink
+type auditLog struct {
+	mu         sync.RWMutex
+	records    []AuditRecord
+	maxRecords int
+	nextID     int64
+	redactions []*regexp.Regexp
+	redacted   uint64
+}
+
+// EnableAuditLog turns on request/response auditing with the default
+// redaction patterns
+func (pm *ProviderManager) EnableAuditLog(maxRecords int) {
+	if maxRecords <= 0 {
+		maxRecords = 500
+	}
+
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	pm.audit = &auditLog{
+		maxRecords: maxRecords,
+		nextID:     1,
+		redactions: append([]*regexp.Regexp(nil), defaultRedactions...),
+	}
+}
+
+// AddRedactionPattern registers an extra pattern scrubbed from audit
+// records (e.g. internal hostnames, customer identifiers)
+func (pm *ProviderManager) AddRedactionPattern(pattern string) error {
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+
+	pm.mu.RLock()
+	audit := pm.audit
+	pm.mu.RUnlock()
+
+	if audit == nil {
+		pm.EnableAuditLog(0)
+		pm.mu.RLock()
+		audit = pm.audit
+		pm.mu.RUnlock()
+	}
+
+	audit.mu.Lock()
+	defer audit.mu.Unlock()
+	audit.redactions = append(audit.redactions, compiled)
+	return nil
+}
+
+// redact scrubs all configured patterns. Caller must hold the lock.
+func (al *auditLog) redact(text string) string {
+	for _, pattern := range al.redactions {
+		if pattern.MatchString(text) {
+			text = pattern.ReplaceAllString(text, "[REDACTED]")
+			al.redacted++
+		}
+	}
+	return text
+}
+
+// recordAudit logs one exchange (no-op when auditing is disabled)
+func (pm *ProviderManager) recordAudit(provider, prompt, response string, latency time.Duration, err error) {
+	pm.mu.RLock()
+	audit := pm.audit
+	pm.mu.RUnlock()
+
+	if audit == nil {
+		return
+	}
+
+	audit.mu.Lock()
+	defer audit.mu.Unlock()
+
+	record := AuditRecord{
+		ID:        audit.nextID,
+		Timestamp: time.Now(),
+		Provider:  provider,
+		Prompt:    audit.redact(prompt),
+		Response:  audit.redact(response),
+		LatencyMs: latency.Milliseconds(),
+		Tokens:    EstimateTokens(prompt) + EstimateTokens(response),
+	}
+	if err != nil {
+		record.Error = audit.redact(err.Error())
+	}
+	audit.nextID++
+
+	audit.records = append(audit.records, record)
+	if len(audit.records) > audit.maxRecords {
+		audit.records = audit.records[len(audit.records)-audit.maxRecords:]
+	}
+}
+
+// QueryAudit returns records after since, optionally filtered by
+// provider ("" matches all)
+func (pm *ProviderManager) QueryAudit(since time.Time, provider string) []AuditRecord {
+	pm.mu.RLock()
+	audit := pm.audit
+	pm.mu.RUnlock()
+
+	if audit == nil {
+		return nil
+	}
+
+	audit.mu.RLock()
+	defer audit.mu.RUnlock()
+
+	matches := make([]AuditRecord, 0)
+	for _, record := range audit.records {
+		if record.Timestamp.Before(since) {
+			continue
+		}
+		if provider != "" && record.Provider != provider {
+			continue
+		}
+		matches = append(matches, record)
+	}
+	return matches
+}
+
+// AuditHandler serves the audit log as JSON for the admin API.
+// Supports ?provider= and ?since= (RFC3339) filters.
+func (pm *ProviderManager) AuditHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		since := time.Time{}
+		if raw := r.URL.Query().Get("since"); raw != "" {
+			if parsed, err := time.Parse(time.RFC3339, raw); err == nil {
+				since = parsed
+			}
+		}
+		records := pm.QueryAudit(since, r.URL.Query().Get("provider"))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(records)
+	})
+}
+
+// GetAuditMetrics returns audit sink statistics
+func (pm *ProviderManager) GetAuditMetrics() map[string]interface{} {
+	pm.mu.RLock()
+	audit := pm.audit
+	pm.mu.RUnlock()
+
+	if audit == nil {
+		return map[string]interface{}{"enabled": false}
+	}
+
+	audit.mu.RLock()
+	defer audit.mu.RUnlock()
+
+	return map[string]interface{}{
+		"enabled":            true,
+		"records":            len(audit.records),
+		"redactions_applied": audit.redacted,
+	}
+}
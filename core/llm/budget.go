@@ -0,0 +1,139 @@
+package llm
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrBudgetExhausted is returned when a generation would exceed the
+// configured spend limits; callers should degrade gracefully (e.g.
+// fall back to template-based thoughts) rather than treat it as fatal
+var ErrBudgetExhausted = fmt.Errorf("LLM budget exhausted")
+
+// BudgetConfig bounds autonomous LLM spend. A zero value for any
+// limit means unlimited on that axis.
+type BudgetConfig struct {
+	MaxRequestsPerHour int     `json:"max_requests_per_hour"`
+	MaxTokensPerDay    int     `json:"max_tokens_per_day"`
+	MaxCostPerDay      float64 `json:"max_cost_per_day"` // USD
+}
+
+// DefaultBudgetConfig returns limits suitable for an always-on agent:
+// modest hourly request ceiling, generous daily token and cost caps
+func DefaultBudgetConfig() BudgetConfig {
+	return BudgetConfig{
+		MaxRequestsPerHour: 120,
+		MaxTokensPerDay:    500000,
+		MaxCostPerDay:      5.0,
+	}
+}
+
+// BudgetManager enforces spend limits on autonomous thought
+// generation. Check it before each LLM call and record usage after;
+// windows roll over on the hour/day boundary from first use.
+type BudgetManager struct {
+	mu     sync.Mutex
+	config BudgetConfig
+
+	hourStart    time.Time
+	hourRequests int
+
+	dayStart  time.Time
+	dayTokens int
+	dayCost   float64
+
+	// Metrics
+	totalRequests uint64
+	totalDenied   uint64
+}
+
+// NewBudgetManager creates a budget manager with the given limits
+func NewBudgetManager(config BudgetConfig) *BudgetManager {
+	now := time.Now()
+	return &BudgetManager{
+		config:    config,
+		hourStart: now,
+		dayStart:  now,
+	}
+}
+
+// Allow reports whether another LLM request fits the budget, counting
+// it against the hourly window if so. Returns ErrBudgetExhausted
+// (wrapped with the exceeded limit) when over budget.
+func (bm *BudgetManager) Allow() error {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	bm.rollWindows()
+
+	if bm.config.MaxRequestsPerHour > 0 && bm.hourRequests >= bm.config.MaxRequestsPerHour {
+		bm.totalDenied++
+		return fmt.Errorf("%w: %d requests this hour (limit %d)",
+			ErrBudgetExhausted, bm.hourRequests, bm.config.MaxRequestsPerHour)
+	}
+	if bm.config.MaxTokensPerDay > 0 && bm.dayTokens >= bm.config.MaxTokensPerDay {
+		bm.totalDenied++
+		return fmt.Errorf("%w: %d tokens today (limit %d)",
+			ErrBudgetExhausted, bm.dayTokens, bm.config.MaxTokensPerDay)
+	}
+	if bm.config.MaxCostPerDay > 0 && bm.dayCost >= bm.config.MaxCostPerDay {
+		bm.totalDenied++
+		return fmt.Errorf("%w: $%.4f spent today (limit $%.2f)",
+			ErrBudgetExhausted, bm.dayCost, bm.config.MaxCostPerDay)
+	}
+
+	bm.hourRequests++
+	bm.totalRequests++
+	return nil
+}
+
+// RecordUsage charges completed generation against the daily budgets
+func (bm *BudgetManager) RecordUsage(tokens int, cost float64) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	bm.rollWindows()
+	bm.dayTokens += tokens
+	bm.dayCost += cost
+}
+
+// rollWindows resets the hourly and daily counters once their window
+// has elapsed. Caller must hold the lock.
+func (bm *BudgetManager) rollWindows() {
+	now := time.Now()
+	if now.Sub(bm.hourStart) >= time.Hour {
+		bm.hourStart = now
+		bm.hourRequests = 0
+	}
+	if now.Sub(bm.dayStart) >= 24*time.Hour {
+		bm.dayStart = now
+		bm.dayTokens = 0
+		bm.dayCost = 0
+	}
+}
+
+// GetMetrics returns budget state and consumption
+func (bm *BudgetManager) GetMetrics() map[string]interface{} {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	bm.rollWindows()
+
+	return map[string]interface{}{
+		"max_requests_per_hour": bm.config.MaxRequestsPerHour,
+		"max_tokens_per_day":    bm.config.MaxTokensPerDay,
+		"max_cost_per_day":      bm.config.MaxCostPerDay,
+		"hour_requests":         bm.hourRequests,
+		"day_tokens":            bm.dayTokens,
+		"day_cost":              bm.dayCost,
+		"total_requests":        bm.totalRequests,
+		"total_denied":          bm.totalDenied,
+	}
+}
+
+// EstimateTokens gives a rough token count for budget accounting when
+// the provider does not report usage (~4 characters per token)
+func EstimateTokens(text string) int {
+	return len(text)/4 + 1
+}
@@ -0,0 +1,215 @@
+package llm
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Response caching: the autonomous loops re-ask near-identical
+// introspective prompts constantly. An exact cache keyed by
+// (provider, prompt, options) with TTL catches repeats for free; an
+// optional semantic layer catches paraphrases via embedding
+// similarity.
+
+// cacheEntry is one cached completion
+type cacheEntry struct {
+	response  string
+	embedding []float32
+	created   time.Time
+}
+
+// responseCache holds exact and semantic cache state
+type responseCache struct {
+	mu         sync.Mutex
+	entries    map[string]*cacheEntry
+	ttl        time.Duration
+	maxEntries int
+
+	// Optional semantic layer
+	embedder  EmbeddingCapable
+	threshold float64
+
+	exactHits    uint64
+	semanticHits uint64
+	misses       uint64
+}
+
+// EnableCache turns on exact response caching with the given TTL
+func (pm *ProviderManager) EnableCache(ttl time.Duration) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	pm.cache = &responseCache{
+		entries:    make(map[string]*cacheEntry),
+		ttl:        ttl,
+		maxEntries: 1000,
+	}
+}
+
+// EnableSemanticCache adds embedding-similarity lookup on top of the
+// exact cache. threshold is the minimum cosine similarity (e.g. 0.95)
+// for a paraphrase hit.
+func (pm *ProviderManager) EnableSemanticCache(embedder EmbeddingCapable, threshold float64) error {
+	if embedder == nil {
+		return fmt.Errorf("embedder required for semantic cache")
+	}
+
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if pm.cache == nil {
+		pm.cache = &responseCache{
+			entries:    make(map[string]*cacheEntry),
+			ttl:        10 * time.Minute,
+			maxEntries: 1000,
+		}
+	}
+	pm.cache.embedder = embedder
+	pm.cache.threshold = threshold
+	return nil
+}
+
+// cacheKey derives the exact-match key from everything that shapes
+// the completion
+func cacheKey(providerName, prompt string, opts GenerateOptions) string {
+	payload := fmt.Sprintf("%s|%s|%d|%.3f|%.3f|%s|%s",
+		providerName, prompt, opts.MaxTokens, opts.Temperature, opts.TopP,
+		strings.Join(opts.Stop, ","), opts.SystemPrompt)
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(payload)))
+}
+
+// lookupCache checks the exact cache, then the semantic layer
+func (pm *ProviderManager) lookupCache(ctx context.Context, key, prompt string) (string, bool) {
+	pm.mu.RLock()
+	cache := pm.cache
+	pm.mu.RUnlock()
+
+	if cache == nil {
+		return "", false
+	}
+
+	cache.mu.Lock()
+	if entry, ok := cache.entries[key]; ok {
+		if time.Since(entry.created) < cache.ttl {
+			cache.exactHits++
+			cache.mu.Unlock()
+			return entry.response, true
+		}
+		delete(cache.entries, key)
+	}
+	embedder := cache.embedder
+	threshold := cache.threshold
+	cache.mu.Unlock()
+
+	// Semantic lookup: embed the prompt and scan stored vectors
+	if embedder != nil {
+		vectors, err := embedder.Embed(ctx, []string{prompt})
+		if err == nil && len(vectors) == 1 {
+			query := vectors[0]
+
+			cache.mu.Lock()
+			var best *cacheEntry
+			bestScore := threshold
+			for _, entry := range cache.entries {
+				if entry.embedding == nil || time.Since(entry.created) >= cache.ttl {
+					continue
+				}
+				if score := cosineSimilarity(query, entry.embedding); score >= bestScore {
+					bestScore = score
+					best = entry
+				}
+			}
+			if best != nil {
+				cache.semanticHits++
+				cache.mu.Unlock()
+				return best.response, true
+			}
+			cache.mu.Unlock()
+		}
+	}
+
+	cache.mu.Lock()
+	cache.misses++
+	cache.mu.Unlock()
+	return "", false
+}
+
+// storeCache records a completed generation, embedding the prompt when
+// the semantic layer is active
+func (pm *ProviderManager) storeCache(ctx context.Context, key, prompt, response string) {
+	pm.mu.RLock()
+	cache := pm.cache
+	pm.mu.RUnlock()
+
+	if cache == nil {
+		return
+	}
+
+	entry := &cacheEntry{response: response, created: time.Now()}
+	if cache.embedder != nil {
+		if vectors, err := cache.embedder.Embed(ctx, []string{prompt}); err == nil && len(vectors) == 1 {
+			entry.embedding = vectors[0]
+		}
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.entries[key] = entry
+	if len(cache.entries) > cache.maxEntries {
+		// Evict the oldest entry
+		var oldestKey string
+		var oldest time.Time
+		for k, e := range cache.entries {
+			if oldestKey == "" || e.created.Before(oldest) {
+				oldestKey = k
+				oldest = e.created
+			}
+		}
+		delete(cache.entries, oldestKey)
+	}
+}
+
+// cosineSimilarity between two vectors (0 when dimensions differ)
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// GetCacheMetrics returns hit/miss counters
+func (pm *ProviderManager) GetCacheMetrics() map[string]interface{} {
+	pm.mu.RLock()
+	cache := pm.cache
+	pm.mu.RUnlock()
+
+	if cache == nil {
+		return map[string]interface{}{"enabled": false}
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return map[string]interface{}{
+		"enabled":       true,
+		"entries":       len(cache.entries),
+		"exact_hits":    cache.exactHits,
+		"semantic_hits": cache.semanticHits,
+		"misses":        cache.misses,
+	}
+}
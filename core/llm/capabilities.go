@@ -0,0 +1,154 @@
+package llm
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Model capability registry: routing and budget decisions need to
+// know each model's context window, modality, and tool support. The
+// registry ships entries for the provider defaults and is extensible
+// for self-hosted models.
+
+// ModelNamer is implemented by providers that expose their configured
+// model, letting provider selection and accounting consult the
+// registry
+type ModelNamer interface {
+	Model() string
+}
+
+// ModelCapabilities describes what one model can do
+type ModelCapabilities struct {
+	Model          string       `json:"model"`
+	Provider       string       `json:"provider"`
+	ContextWindow  int          `json:"context_window"`
+	MaxOutput      int          `json:"max_output"`
+	SupportsTools  bool         `json:"supports_tools"`
+	SupportsJSON   bool         `json:"supports_json"`
+	SupportsVision bool         `json:"supports_vision"`
+	Pricing        ModelPricing `json:"pricing"`
+}
+
+var (
+	capabilityMu       sync.RWMutex
+	capabilityRegistry = map[string]ModelCapabilities{
+		"gpt-4.1-mini": {
+			Model: "gpt-4.1-mini", Provider: "openai",
+			ContextWindow: 1047576, MaxOutput: 32768,
+			SupportsTools: true, SupportsJSON: true, SupportsVision: true,
+			Pricing: ModelPricing{PromptPer1K: 0.0004, CompletionPer1K: 0.0016},
+		},
+		"gpt-4o": {
+			Model: "gpt-4o", Provider: "openai",
+			ContextWindow: 128000, MaxOutput: 16384,
+			SupportsTools: true, SupportsJSON: true, SupportsVision: true,
+			Pricing: ModelPricing{PromptPer1K: 0.0025, CompletionPer1K: 0.01},
+		},
+		"claude-3-5-sonnet-20241022": {
+			Model: "claude-3-5-sonnet-20241022", Provider: "anthropic",
+			ContextWindow: 200000, MaxOutput: 8192,
+			SupportsTools: true, SupportsJSON: false, SupportsVision: true,
+			Pricing: ModelPricing{PromptPer1K: 0.003, CompletionPer1K: 0.015},
+		},
+		"claude-3-5-haiku-20241022": {
+			Model: "claude-3-5-haiku-20241022", Provider: "anthropic",
+			ContextWindow: 200000, MaxOutput: 8192,
+			SupportsTools: true, SupportsJSON: false, SupportsVision: false,
+			Pricing: ModelPricing{PromptPer1K: 0.0008, CompletionPer1K: 0.004},
+		},
+		"mistral-small-latest": {
+			Model: "mistral-small-latest", Provider: "mistral",
+			ContextWindow: 32000, MaxOutput: 8192,
+			SupportsTools: true, SupportsJSON: true, SupportsVision: false,
+			Pricing: ModelPricing{PromptPer1K: 0.0002, CompletionPer1K: 0.0006},
+		},
+		"llama3.2": {
+			Model: "llama3.2", Provider: "ollama",
+			ContextWindow: 8192, MaxOutput: 4096,
+			SupportsTools: false, SupportsJSON: false, SupportsVision: false,
+			// Local models cost nothing per token
+			Pricing: ModelPricing{},
+		},
+	}
+)
+
+// RegisterModelCapabilities adds or replaces a registry entry, e.g.
+// for a self-hosted model behind the compatible provider
+func RegisterModelCapabilities(caps ModelCapabilities) error {
+	if caps.Model == "" {
+		return fmt.Errorf("model name required")
+	}
+
+	capabilityMu.Lock()
+	defer capabilityMu.Unlock()
+	capabilityRegistry[caps.Model] = caps
+	return nil
+}
+
+// LookupModelCapabilities returns the entry for a model. Unknown
+// models get conservative defaults so callers can still reason about
+// them.
+func LookupModelCapabilities(model string) ModelCapabilities {
+	capabilityMu.RLock()
+	defer capabilityMu.RUnlock()
+
+	if caps, ok := capabilityRegistry[model]; ok {
+		return caps
+	}
+	// Prefix match covers dated/model-tag variants
+	for name, caps := range capabilityRegistry {
+		if strings.HasPrefix(model, name) || strings.HasPrefix(name, model) {
+			return caps
+		}
+	}
+	return ModelCapabilities{
+		Model:         model,
+		ContextWindow: 8192,
+		MaxOutput:     4096,
+		Pricing:       ModelPricing{PromptPer1K: 0.001, CompletionPer1K: 0.004},
+	}
+}
+
+// ListModelCapabilities returns all registered entries
+func ListModelCapabilities() []ModelCapabilities {
+	capabilityMu.RLock()
+	defer capabilityMu.RUnlock()
+
+	entries := make([]ModelCapabilities, 0, len(capabilityRegistry))
+	for _, caps := range capabilityRegistry {
+		entries = append(entries, caps)
+	}
+	return entries
+}
+
+// LoadPricingFromRegistry seeds the accountant's pricing table from
+// the capability registry so both layers agree on costs
+func (ca *CostAccountant) LoadPricingFromRegistry() {
+	for _, caps := range ListModelCapabilities() {
+		ca.SetPricing(caps.Model, caps.Pricing)
+	}
+}
+
+// providerModel names a provider's configured model, "default" when
+// the provider does not expose one
+func providerModel(provider LLMProvider) string {
+	if namer, ok := provider.(ModelNamer); ok {
+		return namer.Model()
+	}
+	return "default"
+}
+
+// providerFitsRequest consults the capability registry for the
+// provider's configured model and rejects requests that cannot fit
+// its context window
+func providerFitsRequest(provider LLMProvider, prompt string, opts GenerateOptions) bool {
+	namer, ok := provider.(ModelNamer)
+	if !ok {
+		return true
+	}
+
+	caps := LookupModelCapabilities(namer.Model())
+	needed := EstimateTokens(prompt) + EstimateTokens(opts.SystemPrompt) + opts.MaxTokens
+	return needed <= caps.ContextWindow
+}
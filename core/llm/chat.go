@@ -0,0 +1,213 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Chat message roles
+const (
+	RoleSystem    = "system"
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+)
+
+// Message is one turn in a chat conversation
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+
+	// Images attaches visual context for vision-capable providers;
+	// text-only providers ignore it
+	Images []ImageAttachment `json:"images,omitempty"`
+}
+
+// ChatCapable is implemented by providers that support multi-turn chat
+// natively. Providers without it get their history flattened into a
+// single prompt.
+type ChatCapable interface {
+	Chat(ctx context.Context, messages []Message, opts GenerateOptions) (string, error)
+}
+
+// Chat generates a reply to a conversation using the default provider
+// with fallback
+func (pm *ProviderManager) Chat(ctx context.Context, messages []Message, opts GenerateOptions) (string, error) {
+	return pm.ChatWithProvider(ctx, "", messages, opts)
+}
+
+// ChatWithProvider generates a reply to a conversation using a specific
+// provider with fallback. Providers implementing ChatCapable receive
+// the messages as-is; others get a flattened prompt.
+func (pm *ProviderManager) ChatWithProvider(ctx context.Context, providerName string, messages []Message, opts GenerateOptions) (string, error) {
+	if len(messages) == 0 {
+		return "", errors.New("no messages to send")
+	}
+
+	pm.mu.RLock()
+
+	providersToTry := []string{}
+	if providerName != "" {
+		providersToTry = append(providersToTry, providerName)
+	} else if pm.defaultProvider != "" {
+		providersToTry = append(providersToTry, pm.defaultProvider)
+	}
+	for _, name := range pm.fallbackChain {
+		if name != providerName && name != pm.defaultProvider {
+			providersToTry = append(providersToTry, name)
+		}
+	}
+
+	pm.mu.RUnlock()
+
+	if len(providersToTry) == 0 {
+		return "", errors.New("no LLM providers available")
+	}
+
+	var lastErr error
+	for _, name := range providersToTry {
+		pm.mu.RLock()
+		provider, exists := pm.providers[name]
+		pm.mu.RUnlock()
+
+		if !exists || !provider.Available() {
+			continue
+		}
+
+		start := time.Now()
+		var result string
+		var err error
+		if chatter, ok := provider.(ChatCapable); ok {
+			result, err = chatter.Chat(ctx, messages, opts)
+		} else {
+			prompt, flatOpts := flattenMessages(messages, opts)
+			result, err = provider.Generate(ctx, prompt, flatOpts)
+		}
+		latency := time.Since(start)
+
+		pm.mu.Lock()
+		pm.requestCount[name]++
+		pm.totalLatency[name] += latency
+		if err != nil {
+			pm.errorCount[name]++
+		}
+		pm.mu.Unlock()
+
+		if err == nil {
+			return result, nil
+		}
+		lastErr = err
+	}
+
+	if lastErr != nil {
+		return "", fmt.Errorf("all providers failed, last error: %w", lastErr)
+	}
+	return "", errors.New("no available providers")
+}
+
+// flattenMessages renders a conversation as a single prompt for
+// providers without native chat support. System messages become the
+// system prompt; the remaining turns are labeled by role.
+func flattenMessages(messages []Message, opts GenerateOptions) (string, GenerateOptions) {
+	prompt := ""
+	for _, msg := range messages {
+		switch msg.Role {
+		case RoleSystem:
+			if opts.SystemPrompt == "" {
+				opts.SystemPrompt = msg.Content
+			} else {
+				opts.SystemPrompt += "\n" + msg.Content
+			}
+		case RoleAssistant:
+			prompt += fmt.Sprintf("Assistant: %s\n", msg.Content)
+		default:
+			prompt += fmt.Sprintf("User: %s\n", msg.Content)
+		}
+	}
+	prompt += "Assistant:"
+	return prompt, opts
+}
+
+// toOpenAIMessages converts chat messages to the OpenAI wire format
+func toOpenAIMessages(messages []Message) []openAIMessage {
+	converted := make([]openAIMessage, 0, len(messages))
+	for _, msg := range messages {
+		converted = append(converted, openAIMessage{Role: msg.Role, Content: msg.Content})
+	}
+	return converted
+}
+
+// Conversation accumulates multi-turn chat history with a turn cap so
+// long-running exchanges stay within context budgets
+type Conversation struct {
+	mu       sync.RWMutex
+	system   string
+	messages []Message
+	maxTurns int
+}
+
+// NewConversation creates a conversation with an optional system prompt
+func NewConversation(systemPrompt string) *Conversation {
+	return &Conversation{
+		system:   systemPrompt,
+		messages: make([]Message, 0),
+		maxTurns: 50,
+	}
+}
+
+// SetMaxTurns caps the retained history (oldest turns drop first)
+func (c *Conversation) SetMaxTurns(max int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if max > 0 {
+		c.maxTurns = max
+	}
+}
+
+// AddUser appends a user turn
+func (c *Conversation) AddUser(content string) {
+	c.append(Message{Role: RoleUser, Content: content})
+}
+
+// AddAssistant appends an assistant turn
+func (c *Conversation) AddAssistant(content string) {
+	c.append(Message{Role: RoleAssistant, Content: content})
+}
+
+func (c *Conversation) append(msg Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.messages = append(c.messages, msg)
+	if len(c.messages) > c.maxTurns {
+		c.messages = c.messages[len(c.messages)-c.maxTurns:]
+	}
+}
+
+// Messages returns the conversation including the system prompt
+func (c *Conversation) Messages() []Message {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	messages := make([]Message, 0, len(c.messages)+1)
+	if c.system != "" {
+		messages = append(messages, Message{Role: RoleSystem, Content: c.system})
+	}
+	return append(messages, c.messages...)
+}
+
+// Len returns the number of turns (excluding the system prompt)
+func (c *Conversation) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.messages)
+}
+
+// Clear drops the accumulated history, keeping the system prompt
+func (c *Conversation) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = c.messages[:0]
+}
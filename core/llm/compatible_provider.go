@@ -0,0 +1,267 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// CompatibleProvider implements LLMProvider against any OpenAI-compatible
+// chat completions endpoint — local vLLM, LM Studio, hosted gateways —
+// with a configurable base URL and model name
+type CompatibleProvider struct {
+	name       string
+	apiKey     string
+	model      string
+	baseURL    string
+	maxTokens  int
+	httpClient *http.Client
+}
+
+// NewCompatibleProvider creates a provider for an OpenAI-compatible
+// endpoint. name identifies it in the provider manager; baseURL is the
+// API root (e.g. http://localhost:8000/v1); apiKey may be empty for
+// local servers.
+func NewCompatibleProvider(name, baseURL, model, apiKey string) *CompatibleProvider {
+	return &CompatibleProvider{
+		name:       name,
+		apiKey:     apiKey,
+		model:      model,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		maxTokens:  4096,
+		httpClient: &http.Client{},
+	}
+}
+
+// SetModel changes the model name sent to the endpoint
+func (cp *CompatibleProvider) SetModel(model string) {
+	cp.model = model
+}
+
+// SetMaxTokens overrides the advertised context budget
+func (cp *CompatibleProvider) SetMaxTokens(max int) {
+	if max > 0 {
+		cp.maxTokens = max
+	}
+}
+
+// Name returns the configured provider name
+func (cp *CompatibleProvider) Name() string {
+	return cp.name
+}
+
+// Model returns the configured model name
+func (cp *CompatibleProvider) Model() string {
+	return cp.model
+}
+
+// Available checks if the provider is configured
+func (cp *CompatibleProvider) Available() bool {
+	return cp.baseURL != "" && cp.model != ""
+}
+
+// MaxTokens returns the maximum tokens supported
+func (cp *CompatibleProvider) MaxTokens() int {
+	return cp.maxTokens
+}
+
+// buildRequest assembles the chat completions payload
+func (cp *CompatibleProvider) buildRequest(prompt string, opts GenerateOptions, stream bool) openAIRequest {
+	messages := []openAIMessage{}
+	if opts.SystemPrompt != "" {
+		messages = append(messages, openAIMessage{Role: "system", Content: opts.SystemPrompt})
+	}
+	messages = append(messages, openAIMessage{Role: "user", Content: prompt})
+
+	req := openAIRequest{
+		Model:       cp.model,
+		Messages:    messages,
+		MaxTokens:   opts.MaxTokens,
+		Temperature: opts.Temperature,
+		TopP:        opts.TopP,
+		Stream:      stream,
+	}
+	if req.MaxTokens <= 0 || req.MaxTokens > cp.maxTokens {
+		req.MaxTokens = 1024
+	}
+	return req
+}
+
+// doRequest posts a payload to the chat completions endpoint
+func (cp *CompatibleProvider) doRequest(ctx context.Context, req openAIRequest) (*http.Response, error) {
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", cp.baseURL+"/chat/completions", bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if cp.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+cp.apiKey)
+	}
+
+	resp, err := cp.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	return resp, nil
+}
+
+// Generate produces a completion for the given prompt
+func (cp *CompatibleProvider) Generate(ctx context.Context, prompt string, opts GenerateOptions) (string, error) {
+	if !cp.Available() {
+		return "", fmt.Errorf("provider %s not configured (base URL and model required)", cp.name)
+	}
+
+	resp, err := cp.doRequest(ctx, cp.buildRequest(prompt, opts, false))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp openAIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(apiResp.Choices) == 0 {
+		return "", fmt.Errorf("no choices in response")
+	}
+	return apiResp.Choices[0].Message.Content, nil
+}
+
+// StreamGenerate produces a streaming completion (SSE)
+func (cp *CompatibleProvider) StreamGenerate(ctx context.Context, prompt string, opts GenerateOptions) (<-chan StreamChunk, error) {
+	outChan := make(chan StreamChunk, 10)
+
+	if !cp.Available() {
+		err := fmt.Errorf("provider %s not configured (base URL and model required)", cp.name)
+		outChan <- StreamChunk{Error: err}
+		close(outChan)
+		return outChan, err
+	}
+
+	go func() {
+		defer close(outChan)
+
+		resp, err := cp.doRequest(ctx, cp.buildRequest(prompt, opts, true))
+		if err != nil {
+			outChan <- StreamChunk{Error: err}
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			outChan <- StreamChunk{Error: fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))}
+			return
+		}
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if payload == "[DONE]" {
+				outChan <- StreamChunk{Done: true}
+				return
+			}
+
+			var chunk struct {
+				Choices []struct {
+					Delta struct {
+						Content string `json:"content"`
+					} `json:"delta"`
+					FinishReason string `json:"finish_reason"`
+				} `json:"choices"`
+			}
+			if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+				continue
+			}
+			if len(chunk.Choices) == 0 {
+				continue
+			}
+			if content := chunk.Choices[0].Delta.Content; content != "" {
+				outChan <- StreamChunk{Content: content, Done: false}
+			}
+			if chunk.Choices[0].FinishReason != "" {
+				outChan <- StreamChunk{Done: true}
+				return
+			}
+		}
+
+		outChan <- StreamChunk{Done: true}
+	}()
+
+	return outChan, nil
+}
+
+// Chat implements ChatCapable: the conversation maps directly onto the
+// chat completions message format
+func (cp *CompatibleProvider) Chat(ctx context.Context, messages []Message, opts GenerateOptions) (string, error) {
+	if !cp.Available() {
+		return "", fmt.Errorf("provider %s not configured (base URL and model required)", cp.name)
+	}
+
+	converted := toOpenAIMessages(messages)
+	if opts.SystemPrompt != "" {
+		converted = append([]openAIMessage{{Role: "system", Content: opts.SystemPrompt}}, converted...)
+	}
+
+	req := openAIRequest{
+		Model:       cp.model,
+		Messages:    converted,
+		MaxTokens:   opts.MaxTokens,
+		Temperature: opts.Temperature,
+		TopP:        opts.TopP,
+	}
+	if req.MaxTokens <= 0 || req.MaxTokens > cp.maxTokens {
+		req.MaxTokens = 1024
+	}
+
+	resp, err := cp.doRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp openAIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(apiResp.Choices) == 0 {
+		return "", fmt.Errorf("no choices in response")
+	}
+	return apiResp.Choices[0].Message.Content, nil
+}
+
+// SetAPIKey installs a new credential (used by key rotation)
+func (cp *CompatibleProvider) SetAPIKey(apiKey string) {
+	cp.apiKey = apiKey
+}
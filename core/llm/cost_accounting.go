@@ -0,0 +1,229 @@
+package llm
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ModelPricing is the cost per 1K tokens for a model, split by
+// prompt and completion as providers bill them differently
+type ModelPricing struct {
+	PromptPer1K     float64 `json:"prompt_per_1k"`
+	CompletionPer1K float64 `json:"completion_per_1k"`
+}
+
+// defaultPricing covers the models the providers default to; unknown
+// models fall back to the "default" entry so costs stay estimated
+// rather than silently zero
+var defaultPricing = map[string]ModelPricing{
+	"claude-3-5-sonnet-20241022": {PromptPer1K: 0.003, CompletionPer1K: 0.015},
+	"claude-3-5-haiku-20241022":  {PromptPer1K: 0.0008, CompletionPer1K: 0.004},
+	"gpt-4.1-mini":               {PromptPer1K: 0.0004, CompletionPer1K: 0.0016},
+	"gpt-4o":                     {PromptPer1K: 0.0025, CompletionPer1K: 0.01},
+	"default":                    {PromptPer1K: 0.001, CompletionPer1K: 0.004},
+}
+
+// ThoughtCost records what one generated thought cost
+type ThoughtCost struct {
+	ThoughtID        int64     `json:"thought_id"`
+	Provider         string    `json:"provider"`
+	Model            string    `json:"model"`
+	PromptTokens     int       `json:"prompt_tokens"`
+	CompletionTokens int       `json:"completion_tokens"`
+	Cost             float64   `json:"cost"`
+	WakeCycle        uint64    `json:"wake_cycle"`
+	Timestamp        time.Time `json:"timestamp"`
+}
+
+// providerModelTotals aggregates spend for one provider/model pair
+type providerModelTotals struct {
+	Requests         uint64  `json:"requests"`
+	PromptTokens     int     `json:"prompt_tokens"`
+	CompletionTokens int     `json:"completion_tokens"`
+	Cost             float64 `json:"cost"`
+}
+
+// CostAccountant tracks per-thought token usage and estimated cost,
+// aggregated per wake cycle and per provider/model, so users know
+// what their agent's introspection costs
+type CostAccountant struct {
+	mu      sync.RWMutex
+	pricing map[string]ModelPricing
+
+	// Recent per-thought records (bounded ring)
+	recent    []ThoughtCost
+	maxRecent int
+
+	// Aggregates
+	byProviderModel map[string]*providerModelTotals
+	byWakeCycle     map[uint64]*providerModelTotals
+	currentCycle    uint64
+
+	totalCost   float64
+	totalTokens int
+}
+
+// NewCostAccountant creates an accountant with default pricing,
+// overlaid with whatever the capability registry knows
+func NewCostAccountant() *CostAccountant {
+	pricing := make(map[string]ModelPricing, len(defaultPricing))
+	for model, p := range defaultPricing {
+		pricing[model] = p
+	}
+
+	ca := &CostAccountant{
+		pricing:         pricing,
+		maxRecent:       200,
+		byProviderModel: make(map[string]*providerModelTotals),
+		byWakeCycle:     make(map[uint64]*providerModelTotals),
+	}
+	ca.LoadPricingFromRegistry()
+	return ca
+}
+
+// SetPricing overrides the cost per 1K tokens for a model
+func (ca *CostAccountant) SetPricing(model string, pricing ModelPricing) {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	ca.pricing[model] = pricing
+}
+
+// BeginWakeCycle starts attributing costs to a new wake cycle
+func (ca *CostAccountant) BeginWakeCycle(cycle uint64) {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	ca.currentCycle = cycle
+}
+
+// RecordThought accounts one generated thought and returns its cost
+// record. Token counts may be estimates (see EstimateTokens) when the
+// provider does not report usage.
+func (ca *CostAccountant) RecordThought(thoughtID int64, provider, model string, promptTokens, completionTokens int) ThoughtCost {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+
+	pricing, ok := ca.pricing[model]
+	if !ok {
+		// The registry's prefix matching handles dated model variants;
+		// truly unknown models get its conservative defaults
+		pricing = LookupModelCapabilities(model).Pricing
+		ca.pricing[model] = pricing
+	}
+
+	cost := float64(promptTokens)/1000*pricing.PromptPer1K +
+		float64(completionTokens)/1000*pricing.CompletionPer1K
+
+	record := ThoughtCost{
+		ThoughtID:        thoughtID,
+		Provider:         provider,
+		Model:            model,
+		PromptTokens:     promptTokens,
+		CompletionTokens: completionTokens,
+		Cost:             cost,
+		WakeCycle:        ca.currentCycle,
+		Timestamp:        time.Now(),
+	}
+
+	ca.recent = append(ca.recent, record)
+	if len(ca.recent) > ca.maxRecent {
+		ca.recent = ca.recent[len(ca.recent)-ca.maxRecent:]
+	}
+
+	ca.accumulate(ca.byProviderModel, provider+"/"+model, record)
+	cycleTotals, ok := ca.byWakeCycle[ca.currentCycle]
+	if !ok {
+		cycleTotals = &providerModelTotals{}
+		ca.byWakeCycle[ca.currentCycle] = cycleTotals
+	}
+	addTotals(cycleTotals, record)
+
+	ca.totalCost += cost
+	ca.totalTokens += promptTokens + completionTokens
+
+	return record
+}
+
+// accumulate adds a record into a keyed aggregate map
+func (ca *CostAccountant) accumulate(agg map[string]*providerModelTotals, key string, record ThoughtCost) {
+	totals, ok := agg[key]
+	if !ok {
+		totals = &providerModelTotals{}
+		agg[key] = totals
+	}
+	addTotals(totals, record)
+}
+
+func addTotals(totals *providerModelTotals, record ThoughtCost) {
+	totals.Requests++
+	totals.PromptTokens += record.PromptTokens
+	totals.CompletionTokens += record.CompletionTokens
+	totals.Cost += record.Cost
+}
+
+// CostReport is the full accounting snapshot served over HTTP
+type CostReport struct {
+	TotalCost       float64                         `json:"total_cost"`
+	TotalTokens     int                             `json:"total_tokens"`
+	CurrentCycle    uint64                          `json:"current_wake_cycle"`
+	ByProviderModel map[string]providerModelTotals  `json:"by_provider_model"`
+	ByWakeCycle     map[uint64]providerModelTotals  `json:"by_wake_cycle"`
+	RecentThoughts  []ThoughtCost                   `json:"recent_thoughts"`
+}
+
+// Report returns a snapshot of all cost aggregates
+func (ca *CostAccountant) Report() CostReport {
+	ca.mu.RLock()
+	defer ca.mu.RUnlock()
+
+	report := CostReport{
+		TotalCost:       ca.totalCost,
+		TotalTokens:     ca.totalTokens,
+		CurrentCycle:    ca.currentCycle,
+		ByProviderModel: make(map[string]providerModelTotals, len(ca.byProviderModel)),
+		ByWakeCycle:     make(map[uint64]providerModelTotals, len(ca.byWakeCycle)),
+		RecentThoughts:  make([]ThoughtCost, len(ca.recent)),
+	}
+
+	for key, totals := range ca.byProviderModel {
+		report.ByProviderModel[key] = *totals
+	}
+	for cycle, totals := range ca.byWakeCycle {
+		report.ByWakeCycle[cycle] = *totals
+	}
+	copy(report.RecentThoughts, ca.recent)
+
+	return report
+}
+
+// Handler serves the cost report as JSON, suitable for mounting at
+// /api/costs alongside the telemetry endpoints
+func (ca *CostAccountant) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ca.Report())
+	})
+}
+
+// GetMetrics returns summary figures for the metrics exporters
+func (ca *CostAccountant) GetMetrics() map[string]interface{} {
+	ca.mu.RLock()
+	defer ca.mu.RUnlock()
+
+	cycleCost := 0.0
+	cycleTokens := 0
+	if totals, ok := ca.byWakeCycle[ca.currentCycle]; ok {
+		cycleCost = totals.Cost
+		cycleTokens = totals.PromptTokens + totals.CompletionTokens
+	}
+
+	return map[string]interface{}{
+		"total_cost":         ca.totalCost,
+		"total_tokens":       ca.totalTokens,
+		"current_wake_cycle": ca.currentCycle,
+		"cycle_cost":         cycleCost,
+		"cycle_tokens":       cycleTokens,
+		"thoughts_accounted": len(ca.recent),
+	}
+}
@@ -0,0 +1,223 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Embeddings: hypergraph memory and semantic search need vectors for
+// arbitrary text. Providers that expose an embeddings endpoint
+// implement EmbeddingCapable; the manager picks the first available one
+// and batches large inputs.
+
+// EmbeddingCapable is implemented by providers with an embeddings
+// endpoint
+type EmbeddingCapable interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// embedBatchSize caps how many texts go to the API per request
+const embedBatchSize = 64
+
+// Embed returns embeddings for the given texts using the first
+// available embedding-capable provider, batching large inputs
+func (pm *ProviderManager) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	return pm.EmbedWithProvider(ctx, "", texts)
+}
+
+// EmbedWithProvider returns embeddings from a specific provider, or
+// from the first embedding-capable one when providerName is empty
+func (pm *ProviderManager) EmbedWithProvider(ctx context.Context, providerName string, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	// Routing rules apply when the caller named no provider
+	if providerName == "" {
+		providerName = pm.routeFor(PurposeEmbeddings)
+	}
+
+	embedder, name, err := pm.selectEmbedder(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	embeddings := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += embedBatchSize {
+		end := start + embedBatchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+
+		batch, err := embedder.Embed(ctx, texts[start:end])
+
+		pm.mu.Lock()
+		pm.requestCount[name]++
+		if err != nil {
+			pm.errorCount[name]++
+		}
+		pm.mu.Unlock()
+
+		if err != nil {
+			return nil, fmt.Errorf("embedding batch %d-%d failed: %w", start, end, err)
+		}
+		embeddings = append(embeddings, batch...)
+	}
+
+	return embeddings, nil
+}
+
+// selectEmbedder finds an embedding-capable provider: the named one,
+// or the first available following the fallback chain
+func (pm *ProviderManager) selectEmbedder(providerName string) (EmbeddingCapable, string, error) {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	if providerName != "" {
+		provider, exists := pm.providers[providerName]
+		if !exists {
+			return nil, "", fmt.Errorf("provider %s not found", providerName)
+		}
+		embedder, ok := provider.(EmbeddingCapable)
+		if !ok {
+			return nil, "", fmt.Errorf("provider %s does not support embeddings", providerName)
+		}
+		if !provider.Available() {
+			return nil, "", fmt.Errorf("provider %s not available", providerName)
+		}
+		return embedder, providerName, nil
+	}
+
+	// Prefer the fallback chain order, then anything registered
+	tried := make(map[string]bool)
+	candidates := append([]string{pm.defaultProvider}, pm.fallbackChain...)
+	for name := range pm.providers {
+		candidates = append(candidates, name)
+	}
+	for _, name := range candidates {
+		if name == "" || tried[name] {
+			continue
+		}
+		tried[name] = true
+		provider, exists := pm.providers[name]
+		if !exists || !provider.Available() {
+			continue
+		}
+		if embedder, ok := provider.(EmbeddingCapable); ok {
+			return embedder, name, nil
+		}
+	}
+
+	return nil, "", errors.New("no embedding-capable providers available")
+}
+
+// Embed implements EmbeddingCapable via the OpenAI embeddings endpoint
+// (text-embedding-3-small)
+func (oai *OpenAIProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if !oai.Available() {
+		return nil, fmt.Errorf("openai provider not configured (missing OPENAI_API_KEY)")
+	}
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	req := map[string]interface{}{
+		"model": "text-embedding-3-small",
+		"input": texts,
+	}
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	embedURL := strings.TrimSuffix(oai.apiURL, "/chat/completions") + "/embeddings"
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", embedURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+oai.apiKey)
+
+	resp, err := oai.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	embeddings := make([][]float32, len(texts))
+	for _, item := range apiResp.Data {
+		if item.Index >= 0 && item.Index < len(embeddings) {
+			embeddings[item.Index] = item.Embedding
+		}
+	}
+	return embeddings, nil
+}
+
+// Embed implements EmbeddingCapable via the local Ollama /api/embed
+// endpoint, so vectors work fully offline
+func (op *OllamaProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	req := map[string]interface{}{
+		"model": op.model,
+		"input": texts,
+	}
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", op.host+"/api/embed", bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := op.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ollama error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp struct {
+		Embeddings [][]float32 `json:"embeddings"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return apiResp.Embeddings, nil
+}
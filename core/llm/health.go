@@ -0,0 +1,230 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Health-scored failover: instead of walking the static fallback chain
+// order, periodic probes plus live latency/error/cost figures produce
+// a score per provider, and the chain is reordered best-first.
+
+// providerHealth is the probe state for one provider
+type providerHealth struct {
+	healthy      bool
+	probeLatency time.Duration
+	lastProbe    time.Time
+	probeFails   uint64
+}
+
+// healthMonitor runs the probes and scoring
+type healthMonitor struct {
+	mu     sync.RWMutex
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	status   map[string]*providerHealth
+	interval time.Duration
+	running  bool
+}
+
+// StartHealthChecks begins periodic provider probing and switches the
+// fallback chain to scored ordering
+func (pm *ProviderManager) StartHealthChecks(interval time.Duration) error {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	pm.mu.Lock()
+	if pm.health != nil && pm.health.running {
+		pm.mu.Unlock()
+		return fmt.Errorf("health checks already running")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	pm.health = &healthMonitor{
+		ctx:      ctx,
+		cancel:   cancel,
+		status:   make(map[string]*providerHealth),
+		interval: interval,
+		running:  true,
+	}
+	pm.mu.Unlock()
+
+	fmt.Printf("💓 Provider health checks started (every %s)\n", interval)
+
+	go pm.healthLoop()
+	return nil
+}
+
+// StopHealthChecks halts probing and restores static chain order
+func (pm *ProviderManager) StopHealthChecks() error {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if pm.health == nil || !pm.health.running {
+		return fmt.Errorf("health checks not running")
+	}
+	pm.health.cancel()
+	pm.health.running = false
+	return nil
+}
+
+// healthLoop probes all providers on the configured cadence
+func (pm *ProviderManager) healthLoop() {
+	pm.mu.RLock()
+	monitor := pm.health
+	pm.mu.RUnlock()
+
+	ticker := time.NewTicker(monitor.interval)
+	defer ticker.Stop()
+
+	pm.probeAll(monitor)
+	for {
+		select {
+		case <-monitor.ctx.Done():
+			return
+		case <-ticker.C:
+			pm.probeAll(monitor)
+		}
+	}
+}
+
+// probeAll runs one lightweight probe (Available with timing) per
+// registered provider
+func (pm *ProviderManager) probeAll(monitor *healthMonitor) {
+	pm.mu.RLock()
+	providers := make(map[string]LLMProvider, len(pm.providers))
+	for name, provider := range pm.providers {
+		providers[name] = provider
+	}
+	pm.mu.RUnlock()
+
+	for name, provider := range providers {
+		start := time.Now()
+		healthy := provider.Available()
+		latency := time.Since(start)
+
+		monitor.mu.Lock()
+		status, ok := monitor.status[name]
+		if !ok {
+			status = &providerHealth{}
+			monitor.status[name] = status
+		}
+		status.healthy = healthy
+		status.probeLatency = latency
+		status.lastProbe = time.Now()
+		if !healthy {
+			status.probeFails++
+		}
+		monitor.mu.Unlock()
+	}
+}
+
+// providerScore rates a provider for ordering: unhealthy providers
+// score zero; otherwise error rate dominates, latency and daily cost
+// discount further. Higher is better.
+func (pm *ProviderManager) providerScore(name string) float64 {
+	pm.mu.RLock()
+	monitor := pm.health
+	requests := pm.requestCount[name]
+	errCount := pm.errorCount[name]
+	totalLatency := pm.totalLatency[name]
+	ctl := pm.budgetCtl
+	pm.mu.RUnlock()
+
+	if monitor != nil {
+		monitor.mu.RLock()
+		status, ok := monitor.status[name]
+		healthy := !ok || status.healthy
+		monitor.mu.RUnlock()
+		if !healthy {
+			return 0
+		}
+	}
+
+	score := 1.0
+
+	if requests > 0 {
+		errorRate := float64(errCount) / float64(requests)
+		score *= 1 - errorRate
+
+		avgLatency := totalLatency / time.Duration(requests)
+		// Each second of average latency costs 10%, capped at half
+		latencyPenalty := avgLatency.Seconds() * 0.1
+		if latencyPenalty > 0.5 {
+			latencyPenalty = 0.5
+		}
+		score *= 1 - latencyPenalty
+	}
+
+	if ctl != nil {
+		ctl.mu.Lock()
+		if spend, ok := ctl.spend[name]; ok && spend.dayCost > 0 {
+			// Each dollar spent today costs 5%, capped at half
+			costPenalty := spend.dayCost * 0.05
+			if costPenalty > 0.5 {
+				costPenalty = 0.5
+			}
+			score *= 1 - costPenalty
+		}
+		ctl.mu.Unlock()
+	}
+
+	return score
+}
+
+// rankedProviders returns the fallback candidates ordered by score
+// (descending), considering only chain members plus the default
+func (pm *ProviderManager) rankedProviders() []string {
+	pm.mu.RLock()
+	candidates := make([]string, 0, len(pm.fallbackChain)+1)
+	seen := make(map[string]bool)
+	if pm.defaultProvider != "" {
+		candidates = append(candidates, pm.defaultProvider)
+		seen[pm.defaultProvider] = true
+	}
+	for _, name := range pm.fallbackChain {
+		if !seen[name] {
+			candidates = append(candidates, name)
+			seen[name] = true
+		}
+	}
+	pm.mu.RUnlock()
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return pm.providerScore(candidates[i]) > pm.providerScore(candidates[j])
+	})
+	return candidates
+}
+
+// GetHealthMetrics returns probe results and scores per provider
+func (pm *ProviderManager) GetHealthMetrics() map[string]interface{} {
+	pm.mu.RLock()
+	monitor := pm.health
+	names := make([]string, 0, len(pm.providers))
+	for name := range pm.providers {
+		names = append(names, name)
+	}
+	pm.mu.RUnlock()
+
+	metrics := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		entry := map[string]interface{}{
+			"score": pm.providerScore(name),
+		}
+		if monitor != nil {
+			monitor.mu.RLock()
+			if status, ok := monitor.status[name]; ok {
+				entry["healthy"] = status.healthy
+				entry["probe_latency_ms"] = status.probeLatency.Milliseconds()
+				entry["probe_fails"] = status.probeFails
+			}
+			monitor.mu.RUnlock()
+		}
+		metrics[name] = entry
+	}
+	return metrics
+}
@@ -0,0 +1,151 @@
+package llm
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// HTTP client injection: deployments behind corporate proxies or with
+// private CAs need control over the transport every provider uses.
+// Each provider accepts a caller-supplied *http.Client; a helper
+// builds one from the common proxy/CA/keep-alive knobs.
+
+// HTTPConfigurable is implemented by providers that accept a custom
+// HTTP client
+type HTTPConfigurable interface {
+	SetHTTPClient(client *http.Client)
+}
+
+// HTTPClientConfig holds the common transport options
+type HTTPClientConfig struct {
+	ProxyURL string        `json:"proxy_url"` // "" uses the environment
+	CAFile   string        `json:"ca_file"`   // extra root CA (PEM)
+	Timeout  time.Duration `json:"timeout"`
+	// DisableKeepAlives forces a fresh connection per request
+	DisableKeepAlives bool `json:"disable_keep_alives"`
+	// TLSClientCert/Key enable mutual TLS
+	TLSClientCert string `json:"tls_client_cert"`
+	TLSClientKey  string `json:"tls_client_key"`
+}
+
+// BuildHTTPClient constructs an *http.Client from the config
+func BuildHTTPClient(config HTTPClientConfig) (*http.Client, error) {
+	transport := &http.Transport{
+		Proxy:             http.ProxyFromEnvironment,
+		DisableKeepAlives: config.DisableKeepAlives,
+	}
+
+	if config.ProxyURL != "" {
+		proxy, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	tlsConfig := &tls.Config{}
+	needsTLS := false
+
+	if config.CAFile != "" {
+		pem, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates parsed from %s", config.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+		needsTLS = true
+	}
+
+	if config.TLSClientCert != "" && config.TLSClientKey != "" {
+		cert, err := tls.LoadX509KeyPair(config.TLSClientCert, config.TLSClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+		needsTLS = true
+	}
+
+	if needsTLS {
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   config.Timeout,
+	}, nil
+}
+
+// SetHTTPClient installs a custom HTTP client on a registered provider
+func (pm *ProviderManager) SetHTTPClient(providerName string, client *http.Client) error {
+	if client == nil {
+		return fmt.Errorf("http client required")
+	}
+
+	pm.mu.RLock()
+	provider, exists := pm.providers[providerName]
+	pm.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("provider %s not found", providerName)
+	}
+
+	configurable, ok := provider.(HTTPConfigurable)
+	if !ok {
+		return fmt.Errorf("provider %s does not accept a custom HTTP client", providerName)
+	}
+	configurable.SetHTTPClient(client)
+	return nil
+}
+
+// SetHTTPClient implements HTTPConfigurable
+func (oai *OpenAIProvider) SetHTTPClient(client *http.Client) {
+	if client != nil {
+		oai.httpClient = client
+	}
+}
+
+// SetHTTPClient implements HTTPConfigurable
+func (ap *AnthropicProvider) SetHTTPClient(client *http.Client) {
+	if client != nil {
+		ap.httpClient = client
+	}
+}
+
+// SetHTTPClient implements HTTPConfigurable
+func (orp *OpenRouterProvider) SetHTTPClient(client *http.Client) {
+	if client != nil {
+		orp.httpClient = client
+	}
+}
+
+// SetHTTPClient implements HTTPConfigurable
+func (mp *MistralProvider) SetHTTPClient(client *http.Client) {
+	if client != nil {
+		mp.httpClient = client
+	}
+}
+
+// SetHTTPClient implements HTTPConfigurable
+func (cp *CompatibleProvider) SetHTTPClient(client *http.Client) {
+	if client != nil {
+		cp.httpClient = client
+	}
+}
+
+// SetHTTPClient implements HTTPConfigurable
+func (op *OllamaProvider) SetHTTPClient(client *http.Client) {
+	if client != nil {
+		op.httpClient = client
+	}
+}
@@ -0,0 +1,196 @@
+package llm
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Load balancing: the fallback chain always exhausts its first member,
+// which serializes concurrent cognitive loops behind one provider. A
+// balancing policy spreads requests across all healthy providers
+// instead, tracking in-flight counts so bursts distribute evenly.
+
+// Load balancing policies
+type BalancePolicy string
+
+const (
+	// BalanceNone keeps plain fallback-chain ordering
+	BalanceNone BalancePolicy = "none"
+	// BalanceRoundRobin rotates through providers per request
+	BalanceRoundRobin BalancePolicy = "round_robin"
+	// BalanceLeastLatency prefers the fastest provider, discounting
+	// ones with many requests already in flight
+	BalanceLeastLatency BalancePolicy = "least_latency"
+	// BalanceWeighted picks randomly, weighted by inverse latency
+	BalanceWeighted BalancePolicy = "weighted"
+)
+
+// loadBalancer holds balancing state
+type loadBalancer struct {
+	mu       sync.Mutex
+	policy   BalancePolicy
+	rrIndex  int
+	inFlight map[string]int
+}
+
+// SetBalancePolicy enables load balancing across the fallback chain
+// (BalanceNone restores plain chain order)
+func (pm *ProviderManager) SetBalancePolicy(policy BalancePolicy) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if policy == BalanceNone {
+		pm.balancer = nil
+		return
+	}
+	pm.balancer = &loadBalancer{
+		policy:   policy,
+		inFlight: make(map[string]int),
+	}
+}
+
+// balancedOrder returns the chain candidates ordered per the active
+// policy. Fallback still walks the full list on failure.
+func (pm *ProviderManager) balancedOrder() []string {
+	pm.mu.RLock()
+	balancer := pm.balancer
+	candidates := make([]string, 0, len(pm.fallbackChain)+1)
+	seen := make(map[string]bool)
+	if pm.defaultProvider != "" {
+		candidates = append(candidates, pm.defaultProvider)
+		seen[pm.defaultProvider] = true
+	}
+	for _, name := range pm.fallbackChain {
+		if !seen[name] {
+			candidates = append(candidates, name)
+			seen[name] = true
+		}
+	}
+	latency := make(map[string]time.Duration, len(candidates))
+	for _, name := range candidates {
+		if pm.requestCount[name] > 0 {
+			latency[name] = pm.totalLatency[name] / time.Duration(pm.requestCount[name])
+		}
+	}
+	pm.mu.RUnlock()
+
+	if balancer == nil || len(candidates) < 2 {
+		return candidates
+	}
+
+	balancer.mu.Lock()
+	defer balancer.mu.Unlock()
+
+	switch balancer.policy {
+	case BalanceRoundRobin:
+		start := balancer.rrIndex % len(candidates)
+		balancer.rrIndex++
+		rotated := make([]string, 0, len(candidates))
+		rotated = append(rotated, candidates[start:]...)
+		return append(rotated, candidates[:start]...)
+
+	case BalanceLeastLatency:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return balancer.effectiveLatency(candidates[i], latency) <
+				balancer.effectiveLatency(candidates[j], latency)
+		})
+		return candidates
+
+	case BalanceWeighted:
+		return weightedShuffle(candidates, latency)
+	}
+	return candidates
+}
+
+// effectiveLatency is average latency plus a penalty per in-flight
+// request, so loaded providers look slower. Caller must hold lb.mu.
+func (lb *loadBalancer) effectiveLatency(name string, latency map[string]time.Duration) time.Duration {
+	base, ok := latency[name]
+	if !ok {
+		// Unmeasured providers get a neutral midpoint so they still
+		// receive traffic
+		base = 500 * time.Millisecond
+	}
+	return base + time.Duration(lb.inFlight[name])*200*time.Millisecond
+}
+
+// weightedShuffle orders candidates by repeated weighted draws with
+// inverse latency as the weight
+func weightedShuffle(candidates []string, latency map[string]time.Duration) []string {
+	remaining := append([]string(nil), candidates...)
+	ordered := make([]string, 0, len(candidates))
+
+	for len(remaining) > 0 {
+		total := 0.0
+		weights := make([]float64, len(remaining))
+		for i, name := range remaining {
+			base, ok := latency[name]
+			if !ok || base <= 0 {
+				base = 500 * time.Millisecond
+			}
+			weights[i] = 1 / base.Seconds()
+			total += weights[i]
+		}
+
+		pick := rand.Float64() * total
+		chosen := len(remaining) - 1
+		for i, weight := range weights {
+			pick -= weight
+			if pick <= 0 {
+				chosen = i
+				break
+			}
+		}
+
+		ordered = append(ordered, remaining[chosen])
+		remaining = append(remaining[:chosen], remaining[chosen+1:]...)
+	}
+	return ordered
+}
+
+// trackInFlight increments the in-flight counter, returning the
+// matching decrement (no-op without a balancer)
+func (pm *ProviderManager) trackInFlight(name string) func() {
+	pm.mu.RLock()
+	balancer := pm.balancer
+	pm.mu.RUnlock()
+
+	if balancer == nil {
+		return func() {}
+	}
+
+	balancer.mu.Lock()
+	balancer.inFlight[name]++
+	balancer.mu.Unlock()
+
+	return func() {
+		balancer.mu.Lock()
+		balancer.inFlight[name]--
+		balancer.mu.Unlock()
+	}
+}
+
+// GetBalancerMetrics returns the policy and in-flight counts
+func (pm *ProviderManager) GetBalancerMetrics() map[string]interface{} {
+	pm.mu.RLock()
+	balancer := pm.balancer
+	pm.mu.RUnlock()
+
+	if balancer == nil {
+		return map[string]interface{}{"policy": string(BalanceNone)}
+	}
+
+	balancer.mu.Lock()
+	defer balancer.mu.Unlock()
+
+	inFlight := make(map[string]int, len(balancer.inFlight))
+	for name, count := range balancer.inFlight {
+		inFlight[name] = count
+	}
+	return map[string]interface{}{
+		"policy":    string(balancer.policy),
+		"in_flight": inFlight,
+	}
+}
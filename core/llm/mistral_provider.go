@@ -0,0 +1,353 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// MistralProvider implements LLMProvider for the Mistral AI API
+// (chat and embeddings endpoints)
+type MistralProvider struct {
+	apiKey     string
+	model      string
+	embedModel string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewMistralProvider creates a new Mistral provider (configure with
+// MISTRAL_API_KEY)
+func NewMistralProvider(apiKey string) *MistralProvider {
+	return &MistralProvider{
+		apiKey:     apiKey,
+		model:      "mistral-small-latest",
+		embedModel: "mistral-embed",
+		baseURL:    "https://api.mistral.ai/v1",
+		httpClient: &http.Client{},
+	}
+}
+
+// SetModel changes the chat model
+func (mp *MistralProvider) SetModel(model string) {
+	mp.model = model
+}
+
+// Name returns the provider name
+func (mp *MistralProvider) Name() string {
+	return "mistral"
+}
+
+// Model returns the configured model name
+func (mp *MistralProvider) Model() string {
+	return mp.model
+}
+
+// Available checks if the provider is configured
+func (mp *MistralProvider) Available() bool {
+	return mp.apiKey != ""
+}
+
+// MaxTokens returns the maximum tokens supported
+func (mp *MistralProvider) MaxTokens() int {
+	return 8192
+}
+
+// mistralRequest represents the chat completions request
+type mistralRequest struct {
+	Model       string          `json:"model"`
+	Messages    []openAIMessage `json:"messages"`
+	MaxTokens   int             `json:"max_tokens,omitempty"`
+	Temperature float64         `json:"temperature,omitempty"`
+	TopP        float64         `json:"top_p,omitempty"`
+	Stop        []string        `json:"stop,omitempty"`
+	Stream      bool            `json:"stream,omitempty"`
+}
+
+// buildMessages assembles the chat messages for a prompt
+func (mp *MistralProvider) buildMessages(prompt string, opts GenerateOptions) []openAIMessage {
+	messages := []openAIMessage{}
+	if opts.SystemPrompt != "" {
+		messages = append(messages, openAIMessage{Role: "system", Content: opts.SystemPrompt})
+	}
+	return append(messages, openAIMessage{Role: "user", Content: prompt})
+}
+
+// Generate produces a completion for the given prompt
+func (mp *MistralProvider) Generate(ctx context.Context, prompt string, opts GenerateOptions) (string, error) {
+	if !mp.Available() {
+		return "", fmt.Errorf("mistral provider not configured (missing MISTRAL_API_KEY)")
+	}
+
+	req := mistralRequest{
+		Model:       mp.model,
+		Messages:    mp.buildMessages(prompt, opts),
+		MaxTokens:   opts.MaxTokens,
+		Temperature: opts.Temperature,
+		TopP:        opts.TopP,
+		Stop:        opts.Stop,
+	}
+	if req.MaxTokens <= 0 || req.MaxTokens > mp.MaxTokens() {
+		req.MaxTokens = 1024
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", mp.baseURL+"/chat/completions", bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+mp.apiKey)
+
+	resp, err := mp.httpClient.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp openAIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(apiResp.Choices) == 0 {
+		return "", fmt.Errorf("no choices in response")
+	}
+	return apiResp.Choices[0].Message.Content, nil
+}
+
+// StreamGenerate produces a streaming completion (SSE)
+func (mp *MistralProvider) StreamGenerate(ctx context.Context, prompt string, opts GenerateOptions) (<-chan StreamChunk, error) {
+	outChan := make(chan StreamChunk, 10)
+
+	if !mp.Available() {
+		outChan <- StreamChunk{Error: fmt.Errorf("mistral provider not configured")}
+		close(outChan)
+		return outChan, fmt.Errorf("mistral provider not configured")
+	}
+
+	req := mistralRequest{
+		Model:       mp.model,
+		Messages:    mp.buildMessages(prompt, opts),
+		MaxTokens:   opts.MaxTokens,
+		Temperature: opts.Temperature,
+		TopP:        opts.TopP,
+		Stop:        opts.Stop,
+		Stream:      true,
+	}
+	if req.MaxTokens <= 0 || req.MaxTokens > mp.MaxTokens() {
+		req.MaxTokens = 1024
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		outChan <- StreamChunk{Error: fmt.Errorf("failed to marshal request: %w", err)}
+		close(outChan)
+		return outChan, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", mp.baseURL+"/chat/completions", bytes.NewReader(reqBody))
+	if err != nil {
+		outChan <- StreamChunk{Error: fmt.Errorf("failed to create request: %w", err)}
+		close(outChan)
+		return outChan, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+mp.apiKey)
+
+	go func() {
+		defer close(outChan)
+
+		resp, err := mp.httpClient.Do(httpReq)
+		if err != nil {
+			outChan <- StreamChunk{Error: fmt.Errorf("failed to send request: %w", err)}
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			outChan <- StreamChunk{Error: fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))}
+			return
+		}
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if payload == "[DONE]" {
+				outChan <- StreamChunk{Done: true}
+				return
+			}
+
+			var chunk struct {
+				Choices []struct {
+					Delta struct {
+						Content string `json:"content"`
+					} `json:"delta"`
+					FinishReason string `json:"finish_reason"`
+				} `json:"choices"`
+			}
+			if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+				continue
+			}
+			if len(chunk.Choices) == 0 {
+				continue
+			}
+			if content := chunk.Choices[0].Delta.Content; content != "" {
+				outChan <- StreamChunk{Content: content, Done: false}
+			}
+			if chunk.Choices[0].FinishReason != "" {
+				outChan <- StreamChunk{Done: true}
+				return
+			}
+		}
+
+		outChan <- StreamChunk{Done: true}
+	}()
+
+	return outChan, nil
+}
+
+// Embed returns embeddings for the given texts using mistral-embed
+func (mp *MistralProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if !mp.Available() {
+		return nil, fmt.Errorf("mistral provider not configured (missing MISTRAL_API_KEY)")
+	}
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	req := map[string]interface{}{
+		"model": mp.embedModel,
+		"input": texts,
+	}
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", mp.baseURL+"/embeddings", bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+mp.apiKey)
+
+	resp, err := mp.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	embeddings := make([][]float32, len(texts))
+	for _, item := range apiResp.Data {
+		if item.Index >= 0 && item.Index < len(embeddings) {
+			embeddings[item.Index] = item.Embedding
+		}
+	}
+	return embeddings, nil
+}
+
+// Chat implements ChatCapable: the conversation maps directly onto
+// Mistral's chat completions message format
+func (mp *MistralProvider) Chat(ctx context.Context, messages []Message, opts GenerateOptions) (string, error) {
+	if !mp.Available() {
+		return "", fmt.Errorf("mistral provider not configured (missing MISTRAL_API_KEY)")
+	}
+
+	converted := toOpenAIMessages(messages)
+	if opts.SystemPrompt != "" {
+		converted = append([]openAIMessage{{Role: "system", Content: opts.SystemPrompt}}, converted...)
+	}
+
+	req := mistralRequest{
+		Model:       mp.model,
+		Messages:    converted,
+		MaxTokens:   opts.MaxTokens,
+		Temperature: opts.Temperature,
+		TopP:        opts.TopP,
+		Stop:        opts.Stop,
+	}
+	if req.MaxTokens <= 0 || req.MaxTokens > mp.MaxTokens() {
+		req.MaxTokens = 1024
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", mp.baseURL+"/chat/completions", bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+mp.apiKey)
+
+	resp, err := mp.httpClient.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp openAIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(apiResp.Choices) == 0 {
+		return "", fmt.Errorf("no choices in response")
+	}
+	return apiResp.Choices[0].Message.Content, nil
+}
+
+// SetAPIKey installs a new credential (used by key rotation)
+func (mp *MistralProvider) SetAPIKey(apiKey string) {
+	mp.apiKey = apiKey
+}
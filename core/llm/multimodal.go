@@ -0,0 +1,267 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Multimodal input: the sensory device captures screenshots and
+// diagrams that vision models can reason about. Attachments ride
+// alongside the prompt; providers without vision support reject them
+// so the manager can route to one that has it.
+
+// ImageAttachment is one image passed with a prompt, either by URL or
+// as raw bytes (base64-encoded on the wire)
+type ImageAttachment struct {
+	MediaType string `json:"media_type"` // e.g. "image/png"
+	Data      []byte `json:"data,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+// LoadImageAttachment reads an image file into an attachment,
+// inferring the media type from the extension
+func LoadImageAttachment(path string) (ImageAttachment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ImageAttachment{}, fmt.Errorf("failed to read image: %w", err)
+	}
+
+	mediaType := "image/png"
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jpg", ".jpeg":
+		mediaType = "image/jpeg"
+	case ".gif":
+		mediaType = "image/gif"
+	case ".webp":
+		mediaType = "image/webp"
+	}
+	return ImageAttachment{MediaType: mediaType, Data: data}, nil
+}
+
+// dataURL renders the attachment for OpenAI-style image_url content
+func (ia ImageAttachment) dataURL() string {
+	if ia.URL != "" {
+		return ia.URL
+	}
+	return fmt.Sprintf("data:%s;base64,%s", ia.MediaType, base64.StdEncoding.EncodeToString(ia.Data))
+}
+
+// VisionCapable is implemented by providers that accept images
+type VisionCapable interface {
+	GenerateWithImages(ctx context.Context, prompt string, images []ImageAttachment, opts GenerateOptions) (string, error)
+}
+
+// GenerateWithImages sends a prompt with image attachments to the
+// first available vision-capable provider
+func (pm *ProviderManager) GenerateWithImages(ctx context.Context, prompt string, images []ImageAttachment, opts GenerateOptions) (string, error) {
+	if len(images) == 0 {
+		return pm.Generate(ctx, prompt, opts)
+	}
+
+	pm.mu.RLock()
+	candidates := append([]string{pm.defaultProvider}, pm.fallbackChain...)
+	for name := range pm.providers {
+		candidates = append(candidates, name)
+	}
+	pm.mu.RUnlock()
+
+	tried := make(map[string]bool)
+	var lastErr error
+	for _, name := range candidates {
+		if name == "" || tried[name] {
+			continue
+		}
+		tried[name] = true
+
+		pm.mu.RLock()
+		provider, exists := pm.providers[name]
+		pm.mu.RUnlock()
+		if !exists || !provider.Available() {
+			continue
+		}
+
+		vision, ok := provider.(VisionCapable)
+		if !ok {
+			continue
+		}
+
+		result, err := vision.GenerateWithImages(ctx, prompt, images, opts)
+
+		pm.mu.Lock()
+		pm.requestCount[name]++
+		if err != nil {
+			pm.errorCount[name]++
+		}
+		pm.mu.Unlock()
+
+		if err == nil {
+			return result, nil
+		}
+		lastErr = err
+	}
+
+	if lastErr != nil {
+		return "", fmt.Errorf("all vision providers failed, last error: %w", lastErr)
+	}
+	return "", errors.New("no vision-capable providers available")
+}
+
+// GenerateWithImages implements VisionCapable for the OpenAI API
+// using multi-part message content
+func (oai *OpenAIProvider) GenerateWithImages(ctx context.Context, prompt string, images []ImageAttachment, opts GenerateOptions) (string, error) {
+	if !oai.Available() {
+		return "", fmt.Errorf("openai provider not configured")
+	}
+
+	content := []map[string]interface{}{
+		{"type": "text", "text": prompt},
+	}
+	for _, image := range images {
+		content = append(content, map[string]interface{}{
+			"type":      "image_url",
+			"image_url": map[string]interface{}{"url": image.dataURL()},
+		})
+	}
+
+	messages := []map[string]interface{}{}
+	if opts.SystemPrompt != "" {
+		messages = append(messages, map[string]interface{}{"role": "system", "content": opts.SystemPrompt})
+	}
+	messages = append(messages, map[string]interface{}{"role": "user", "content": content})
+
+	maxTokens := opts.MaxTokens
+	if maxTokens <= 0 || maxTokens > oai.MaxTokens() {
+		maxTokens = 1024
+	}
+	req := map[string]interface{}{
+		"model":      oai.model,
+		"messages":   messages,
+		"max_tokens": maxTokens,
+	}
+	if opts.Temperature > 0 {
+		req["temperature"] = opts.Temperature
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", oai.apiURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+oai.apiKey)
+
+	resp, err := oai.httpClient.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp openAIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(apiResp.Choices) == 0 {
+		return "", fmt.Errorf("no choices in response")
+	}
+	return apiResp.Choices[0].Message.Content, nil
+}
+
+// GenerateWithImages implements VisionCapable for the Anthropic API
+// using image content blocks
+func (ap *AnthropicProvider) GenerateWithImages(ctx context.Context, prompt string, images []ImageAttachment, opts GenerateOptions) (string, error) {
+	if !ap.Available() {
+		return "", fmt.Errorf("anthropic provider not configured")
+	}
+
+	content := []map[string]interface{}{}
+	for _, image := range images {
+		if image.URL != "" {
+			content = append(content, map[string]interface{}{
+				"type":   "image",
+				"source": map[string]interface{}{"type": "url", "url": image.URL},
+			})
+			continue
+		}
+		content = append(content, map[string]interface{}{
+			"type": "image",
+			"source": map[string]interface{}{
+				"type":       "base64",
+				"media_type": image.MediaType,
+				"data":       base64.StdEncoding.EncodeToString(image.Data),
+			},
+		})
+	}
+	content = append(content, map[string]interface{}{"type": "text", "text": prompt})
+
+	maxTokens := opts.MaxTokens
+	if maxTokens <= 0 || maxTokens > ap.MaxTokens() {
+		maxTokens = 1024
+	}
+	req := map[string]interface{}{
+		"model":      ap.model,
+		"max_tokens": maxTokens,
+		"messages": []map[string]interface{}{
+			{"role": "user", "content": content},
+		},
+	}
+	if opts.SystemPrompt != "" {
+		req["system"] = opts.SystemPrompt
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", ap.apiURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-api-key", ap.apiKey)
+	httpReq.Header.Set("anthropic-version", "2023-06-01")
+
+	resp, err := ap.httpClient.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp anthropicResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(apiResp.Content) == 0 {
+		return "", fmt.Errorf("no content in response")
+	}
+	return apiResp.Content[0].Text, nil
+}
@@ -0,0 +1,268 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// OllamaProvider implements LLMProvider against a local Ollama server,
+// so autonomous operation works fully offline without API keys
+type OllamaProvider struct {
+	host       string
+	model      string
+	httpClient *http.Client
+}
+
+// NewOllamaProvider creates an Ollama provider. The host comes from
+// OLLAMA_HOST (default http://localhost:11434); the model defaults to
+// llama3.2 and can be changed with SetModel.
+func NewOllamaProvider() *OllamaProvider {
+	host := os.Getenv("OLLAMA_HOST")
+	if host == "" {
+		host = "http://localhost:11434"
+	}
+	host = strings.TrimSuffix(host, "/")
+	if !strings.HasPrefix(host, "http") {
+		host = "http://" + host
+	}
+
+	return &OllamaProvider{
+		host:       host,
+		model:      "llama3.2",
+		httpClient: &http.Client{},
+	}
+}
+
+// SetModel changes the model used for generation
+func (op *OllamaProvider) SetModel(model string) {
+	op.model = model
+}
+
+// Name returns the provider name
+func (op *OllamaProvider) Name() string {
+	return "ollama"
+}
+
+// Model returns the configured model name
+func (op *OllamaProvider) Model() string {
+	return op.model
+}
+
+// Available checks whether the local server responds
+func (op *OllamaProvider) Available() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", op.host+"/api/version", nil)
+	if err != nil {
+		return false
+	}
+	resp, err := op.httpClient.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode == http.StatusOK
+}
+
+// MaxTokens returns the maximum tokens supported
+func (op *OllamaProvider) MaxTokens() int {
+	return 4096 // Conservative default; depends on the loaded model
+}
+
+// ollamaGenerateRequest represents the /api/generate request
+type ollamaGenerateRequest struct {
+	Model   string                 `json:"model"`
+	Prompt  string                 `json:"prompt"`
+	System  string                 `json:"system,omitempty"`
+	Stream  bool                   `json:"stream"`
+	Options map[string]interface{} `json:"options,omitempty"`
+}
+
+// ollamaGenerateResponse represents one /api/generate response line
+type ollamaGenerateResponse struct {
+	Model    string `json:"model"`
+	Response string `json:"response"`
+	Done     bool   `json:"done"`
+	Error    string `json:"error,omitempty"`
+}
+
+// buildOptions maps GenerateOptions onto Ollama's options object
+func (op *OllamaProvider) buildOptions(opts GenerateOptions) map[string]interface{} {
+	options := map[string]interface{}{}
+	if opts.MaxTokens > 0 {
+		options["num_predict"] = opts.MaxTokens
+	}
+	if opts.Temperature > 0 {
+		options["temperature"] = opts.Temperature
+	}
+	if opts.TopP > 0 {
+		options["top_p"] = opts.TopP
+	}
+	if len(opts.Stop) > 0 {
+		options["stop"] = opts.Stop
+	}
+	return options
+}
+
+// Generate produces a completion for the given prompt
+func (op *OllamaProvider) Generate(ctx context.Context, prompt string, opts GenerateOptions) (string, error) {
+	req := ollamaGenerateRequest{
+		Model:   op.model,
+		Prompt:  prompt,
+		System:  opts.SystemPrompt,
+		Stream:  false,
+		Options: op.buildOptions(opts),
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", op.host+"/api/generate", bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := op.httpClient.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("ollama error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp ollamaGenerateResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	if apiResp.Error != "" {
+		return "", fmt.Errorf("ollama error: %s", apiResp.Error)
+	}
+
+	return apiResp.Response, nil
+}
+
+// StreamGenerate produces a streaming completion. Ollama streams one
+// JSON object per line.
+func (op *OllamaProvider) StreamGenerate(ctx context.Context, prompt string, opts GenerateOptions) (<-chan StreamChunk, error) {
+	outChan := make(chan StreamChunk, 10)
+
+	req := ollamaGenerateRequest{
+		Model:   op.model,
+		Prompt:  prompt,
+		System:  opts.SystemPrompt,
+		Stream:  true,
+		Options: op.buildOptions(opts),
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		outChan <- StreamChunk{Error: fmt.Errorf("failed to marshal request: %w", err)}
+		close(outChan)
+		return outChan, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", op.host+"/api/generate", bytes.NewReader(reqBody))
+	if err != nil {
+		outChan <- StreamChunk{Error: fmt.Errorf("failed to create request: %w", err)}
+		close(outChan)
+		return outChan, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	go func() {
+		defer close(outChan)
+
+		resp, err := op.httpClient.Do(httpReq)
+		if err != nil {
+			outChan <- StreamChunk{Error: fmt.Errorf("failed to send request: %w", err)}
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			outChan <- StreamChunk{Error: fmt.Errorf("ollama error (status %d): %s", resp.StatusCode, string(body))}
+			return
+		}
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			var chunk ollamaGenerateResponse
+			if err := json.Unmarshal(scanner.Bytes(), &chunk); err != nil {
+				continue
+			}
+			if chunk.Error != "" {
+				outChan <- StreamChunk{Error: fmt.Errorf("ollama error: %s", chunk.Error)}
+				return
+			}
+			if chunk.Response != "" {
+				outChan <- StreamChunk{Content: chunk.Response, Done: false}
+			}
+			if chunk.Done {
+				outChan <- StreamChunk{Done: true}
+				return
+			}
+		}
+
+		outChan <- StreamChunk{Done: true}
+	}()
+
+	return outChan, nil
+}
+
+// OllamaModel describes one locally available model
+type OllamaModel struct {
+	Name       string    `json:"name"`
+	Size       int64     `json:"size"`
+	ModifiedAt time.Time `json:"modified_at"`
+}
+
+// ListModels returns the models available on the local server
+func (op *OllamaProvider) ListModels(ctx context.Context) ([]OllamaModel, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", op.host+"/api/tags", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := op.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ollama error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var listResp struct {
+		Models []OllamaModel `json:"models"`
+	}
+	if err := json.Unmarshal(body, &listResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return listResp.Models, nil
+}
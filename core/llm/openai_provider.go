@@ -1,6 +1,7 @@
 package llm
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -8,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
 )
 
 // OpenAIProvider implements LLMProvider for OpenAI-compatible APIs
@@ -41,6 +43,11 @@ func (oai *OpenAIProvider) Name() string {
 	return "openai"
 }
 
+// Model returns the configured model name
+func (oai *OpenAIProvider) Model() string {
+	return oai.model
+}
+
 // Available checks if the provider is configured
 func (oai *OpenAIProvider) Available() bool {
 	return oai.apiKey != ""
@@ -243,31 +250,41 @@ func (oai *OpenAIProvider) StreamGenerate(ctx context.Context, prompt string, op
 			return
 		}
 		
-		// Read streaming response (SSE format)
-		decoder := json.NewDecoder(resp.Body)
-		for {
-			var chunk map[string]interface{}
-			if err := decoder.Decode(&chunk); err != nil {
-				if err == io.EOF {
-					break
-				}
+		// Read streaming response (SSE format): "data: {json}" lines
+		// terminated by "data: [DONE]"
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if payload == "[DONE]" {
+				outChan <- StreamChunk{Done: true}
+				return
+			}
+
+			var chunk struct {
+				Choices []struct {
+					Delta struct {
+						Content string `json:"content"`
+					} `json:"delta"`
+					FinishReason string `json:"finish_reason"`
+				} `json:"choices"`
+			}
+			if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+				continue
+			}
+			if len(chunk.Choices) == 0 {
 				continue
 			}
-			
-			// Extract content from choices
-			if choices, ok := chunk["choices"].([]interface{}); ok && len(choices) > 0 {
-				if choice, ok := choices[0].(map[string]interface{}); ok {
-					if delta, ok := choice["delta"].(map[string]interface{}); ok {
-						if content, ok := delta["content"].(string); ok && content != "" {
-							outChan <- StreamChunk{Content: content, Done: false}
-						}
-					}
-					
-					if finishReason, ok := choice["finish_reason"].(string); ok && finishReason != "" {
-						outChan <- StreamChunk{Done: true}
-						return
-					}
-				}
+			if content := chunk.Choices[0].Delta.Content; content != "" {
+				outChan <- StreamChunk{Content: content, Done: false}
+			}
+			if chunk.Choices[0].FinishReason != "" {
+				outChan <- StreamChunk{Done: true}
+				return
 			}
 		}
 		
@@ -276,3 +293,67 @@ func (oai *OpenAIProvider) StreamGenerate(ctx context.Context, prompt string, op
 	
 	return outChan, nil
 }
+
+// Chat implements ChatCapable: the conversation maps directly onto the
+// chat completions message format
+func (oai *OpenAIProvider) Chat(ctx context.Context, messages []Message, opts GenerateOptions) (string, error) {
+	if !oai.Available() {
+		return "", fmt.Errorf("openai provider not configured")
+	}
+
+	converted := toOpenAIMessages(messages)
+	if opts.SystemPrompt != "" {
+		converted = append([]openAIMessage{{Role: "system", Content: opts.SystemPrompt}}, converted...)
+	}
+
+	req := openAIRequest{
+		Model:       oai.model,
+		Messages:    converted,
+		MaxTokens:   opts.MaxTokens,
+		Temperature: opts.Temperature,
+		TopP:        opts.TopP,
+	}
+	if req.MaxTokens <= 0 || req.MaxTokens > oai.MaxTokens() {
+		req.MaxTokens = 1024
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", oai.apiURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+oai.apiKey)
+
+	resp, err := oai.httpClient.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp openAIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(apiResp.Choices) == 0 {
+		return "", fmt.Errorf("no choices in response")
+	}
+	return apiResp.Choices[0].Message.Content, nil
+}
+
+// SetAPIKey installs a new credential (used by key rotation)
+func (oai *OpenAIProvider) SetAPIKey(apiKey string) {
+	oai.apiKey = apiKey
+}
@@ -1,6 +1,7 @@
 package llm
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -8,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
 )
 
 // OpenRouterProvider implements LLMProvider for OpenRouter
@@ -37,6 +39,11 @@ func (orp *OpenRouterProvider) Name() string {
 	return "openrouter"
 }
 
+// Model returns the configured model name
+func (orp *OpenRouterProvider) Model() string {
+	return orp.model
+}
+
 // Available checks if the provider is configured
 func (orp *OpenRouterProvider) Available() bool {
 	return orp.apiKey != ""
@@ -243,32 +250,41 @@ func (orp *OpenRouterProvider) StreamGenerate(ctx context.Context, prompt string
 			return
 		}
 		
-		// Read streaming response (SSE format)
-		decoder := json.NewDecoder(resp.Body)
-		for {
-			var chunk map[string]interface{}
-			if err := decoder.Decode(&chunk); err != nil {
-				if err == io.EOF {
-					break
-				}
-				// Ignore decode errors for SSE format
+		// Read streaming response (SSE format): "data: {json}" lines
+		// terminated by "data: [DONE]"
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if payload == "[DONE]" {
+				outChan <- StreamChunk{Done: true}
+				return
+			}
+
+			var chunk struct {
+				Choices []struct {
+					Delta struct {
+						Content string `json:"content"`
+					} `json:"delta"`
+					FinishReason string `json:"finish_reason"`
+				} `json:"choices"`
+			}
+			if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+				continue
+			}
+			if len(chunk.Choices) == 0 {
 				continue
 			}
-			
-			// Extract content from choices
-			if choices, ok := chunk["choices"].([]interface{}); ok && len(choices) > 0 {
-				if choice, ok := choices[0].(map[string]interface{}); ok {
-					if delta, ok := choice["delta"].(map[string]interface{}); ok {
-						if content, ok := delta["content"].(string); ok && content != "" {
-							outChan <- StreamChunk{Content: content, Done: false}
-						}
-					}
-					
-					if finishReason, ok := choice["finish_reason"].(string); ok && finishReason != "" {
-						outChan <- StreamChunk{Done: true}
-						return
-					}
-				}
+			if content := chunk.Choices[0].Delta.Content; content != "" {
+				outChan <- StreamChunk{Content: content, Done: false}
+			}
+			if chunk.Choices[0].FinishReason != "" {
+				outChan <- StreamChunk{Done: true}
+				return
 			}
 		}
 		
@@ -277,3 +293,8 @@ func (orp *OpenRouterProvider) StreamGenerate(ctx context.Context, prompt string
 	
 	return outChan, nil
 }
+
+// SetAPIKey installs a new credential (used by key rotation)
+func (orp *OpenRouterProvider) SetAPIKey(apiKey string) {
+	orp.apiKey = apiKey
+}
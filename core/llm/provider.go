@@ -33,6 +33,10 @@ type GenerateOptions struct {
 	TopP        float64
 	Stop        []string
 	SystemPrompt string
+
+	// Purpose labels the workload (e.g. PurposeReflection) so routing
+	// rules can steer it to the right provider
+	Purpose string
 }
 
 // DefaultGenerateOptions returns sensible defaults
@@ -63,6 +67,41 @@ type ProviderManager struct {
 	requestCount  map[string]uint64
 	errorCount    map[string]uint64
 	totalLatency  map[string]time.Duration
+
+	// Optional cost tracking and per-provider budgets
+	budgetCtl *providerBudgets
+
+	// Optional per-provider rate limiting
+	rateLimiters map[string]*providerLimiter
+
+	// Optional retry policies and circuit breakers
+	retryPolicies map[string]RetryPolicy
+	breakers      map[string]*circuitBreaker
+
+	// Optional response cache
+	cache *responseCache
+
+	// Optional health monitoring and scored failover
+	health *healthMonitor
+
+	// Optional load balancing across the chain
+	balancer *loadBalancer
+
+	// Optional purpose-based routing rules
+	routing *routingTable
+
+	// Optional audit sink
+	audit *auditLog
+
+	// Optional per-provider timeouts
+	timeouts     map[string]time.Duration
+	timeoutCount map[string]uint64
+
+	// Optional API key rotation
+	keyRotators map[string]*keyRotator
+
+	// Detailed usage tracking (lazily initialized)
+	usage *usageTracker
 }
 
 // NewProviderManager creates a new provider manager
@@ -128,24 +167,45 @@ func (pm *ProviderManager) Generate(ctx context.Context, prompt string, opts Gen
 
 // GenerateWithProvider generates text using a specific provider with fallback
 func (pm *ProviderManager) GenerateWithProvider(ctx context.Context, providerName string, prompt string, opts GenerateOptions) (string, error) {
+	// Routing rules apply when the caller named no provider
+	if providerName == "" {
+		providerName = pm.routeFor(opts.Purpose)
+	}
+
+	key := cacheKey(providerName, prompt, opts)
+	if cached, ok := pm.lookupCache(ctx, key, prompt); ok {
+		return cached, nil
+	}
+
 	pm.mu.RLock()
-	
+	scoredOrder := providerName == "" && pm.health != nil && pm.health.running
+	balanced := providerName == "" && !scoredOrder && pm.balancer != nil
+
 	// Determine which providers to try
 	providersToTry := []string{}
-	if providerName != "" {
-		providersToTry = append(providersToTry, providerName)
-	} else if pm.defaultProvider != "" {
-		providersToTry = append(providersToTry, pm.defaultProvider)
-	}
-	
-	// Add fallback chain
-	for _, name := range pm.fallbackChain {
-		if name != providerName && name != pm.defaultProvider {
-			providersToTry = append(providersToTry, name)
+	if !scoredOrder && !balanced {
+		if providerName != "" {
+			providersToTry = append(providersToTry, providerName)
+		} else if pm.defaultProvider != "" {
+			providersToTry = append(providersToTry, pm.defaultProvider)
+		}
+
+		// Add fallback chain
+		for _, name := range pm.fallbackChain {
+			if name != providerName && name != pm.defaultProvider {
+				providersToTry = append(providersToTry, name)
+			}
 		}
 	}
-	
+
 	pm.mu.RUnlock()
+
+	// With health checks running, the chain reorders by live score
+	if scoredOrder {
+		providersToTry = pm.rankedProviders()
+	} else if balanced {
+		providersToTry = pm.balancedOrder()
+	}
 	
 	if len(providersToTry) == 0 {
 		return "", errors.New("no LLM providers available")
@@ -160,12 +220,36 @@ func (pm *ProviderManager) GenerateWithProvider(ctx context.Context, providerNam
 		if !exists || !provider.Available() {
 			continue
 		}
-		
-		// Try this provider
+
+		// Skip providers over their hard budget
+		if !pm.providerWithinBudget(name) {
+			lastErr = fmt.Errorf("%w: provider %s over daily budget", ErrBudgetExhausted, name)
+			continue
+		}
+
+		// Skip providers whose model cannot fit the request in its
+		// context window (per the capability registry)
+		if !providerFitsRequest(provider, prompt, opts) {
+			lastErr = fmt.Errorf("provider %s: request exceeds %s context window", name, providerModel(provider))
+			continue
+		}
+
+		// Queue briefly behind the rate limiter, shedding to the next
+		// provider when the wait is too long
+		if err := pm.acquireRateLimit(ctx, name, EstimateTokens(prompt)+opts.MaxTokens); err != nil {
+			lastErr = err
+			continue
+		}
+
+		// Try this provider under its retry policy and breaker
 		start := time.Now()
-		result, err := provider.Generate(ctx, prompt, opts)
+		release := pm.trackInFlight(name)
+		result, err := pm.generateWithRetry(ctx, name, provider, prompt, opts)
+		release()
 		latency := time.Since(start)
-		
+		pm.recordAudit(name, prompt, result, latency, err)
+		pm.recordUsageMetrics(name, prompt, result, latency, err)
+
 		// Update metrics
 		pm.mu.Lock()
 		pm.requestCount[name]++
@@ -174,11 +258,14 @@ func (pm *ProviderManager) GenerateWithProvider(ctx context.Context, providerNam
 			pm.errorCount[name]++
 		}
 		pm.mu.Unlock()
-		
+
 		if err == nil {
+			pm.recordProviderSpend(name, providerModel(provider), prompt, result)
+			pm.storeCache(ctx, key, prompt, result)
 			return result, nil
 		}
-		
+
+		pm.rotateKeyOnAuthError(name, err)
 		lastErr = err
 		// Continue to next provider in fallback chain
 	}
@@ -224,6 +311,101 @@ func (pm *ProviderManager) StreamGenerateWithProvider(ctx context.Context, provi
 	return provider.StreamGenerate(ctx, prompt, opts)
 }
 
+// GenerateStream streams text using the default provider with fallback
+func (pm *ProviderManager) GenerateStream(ctx context.Context, prompt string, opts GenerateOptions) (<-chan StreamChunk, error) {
+	return pm.GenerateStreamWithProvider(ctx, "", prompt, opts)
+}
+
+// GenerateStreamWithProvider streams text from a specific provider,
+// falling back through the chain when a provider fails before emitting
+// any content. Metrics track time to first chunk.
+func (pm *ProviderManager) GenerateStreamWithProvider(ctx context.Context, providerName string, prompt string, opts GenerateOptions) (<-chan StreamChunk, error) {
+	pm.mu.RLock()
+
+	// Determine which providers to try (same order as Generate)
+	providersToTry := []string{}
+	if providerName != "" {
+		providersToTry = append(providersToTry, providerName)
+	} else if pm.defaultProvider != "" {
+		providersToTry = append(providersToTry, pm.defaultProvider)
+	}
+	for _, name := range pm.fallbackChain {
+		if name != providerName && name != pm.defaultProvider {
+			providersToTry = append(providersToTry, name)
+		}
+	}
+
+	pm.mu.RUnlock()
+
+	if len(providersToTry) == 0 {
+		return nil, errors.New("no LLM providers available")
+	}
+
+	outChan := make(chan StreamChunk, 10)
+
+	go func() {
+		defer close(outChan)
+
+		var lastErr error
+		for _, name := range providersToTry {
+			pm.mu.RLock()
+			provider, exists := pm.providers[name]
+			pm.mu.RUnlock()
+
+			if !exists || !provider.Available() {
+				continue
+			}
+
+			start := time.Now()
+			stream, err := provider.StreamGenerate(ctx, prompt, opts)
+
+			pm.mu.Lock()
+			pm.requestCount[name]++
+			pm.mu.Unlock()
+
+			if err != nil {
+				pm.mu.Lock()
+				pm.errorCount[name]++
+				pm.mu.Unlock()
+				lastErr = err
+				continue
+			}
+
+			// Peek at the first chunk: an error before any content
+			// means the next provider in the chain can still take over
+			first, ok := <-stream
+			if !ok {
+				continue
+			}
+			if first.Error != nil {
+				pm.mu.Lock()
+				pm.errorCount[name]++
+				pm.mu.Unlock()
+				lastErr = first.Error
+				continue
+			}
+
+			pm.mu.Lock()
+			pm.totalLatency[name] += time.Since(start)
+			pm.mu.Unlock()
+
+			outChan <- first
+			for chunk := range stream {
+				outChan <- chunk
+			}
+			return
+		}
+
+		if lastErr != nil {
+			outChan <- StreamChunk{Error: fmt.Errorf("all providers failed, last error: %w", lastErr)}
+		} else {
+			outChan <- StreamChunk{Error: errors.New("no available providers")}
+		}
+	}()
+
+	return outChan, nil
+}
+
 // GetProvider returns a specific provider
 func (pm *ProviderManager) GetProvider(name string) (LLMProvider, error) {
 	pm.mu.RLock()
@@ -273,6 +455,7 @@ func (pm *ProviderManager) GetMetrics() map[string]ProviderMetrics {
 		metrics[name] = ProviderMetrics{
 			RequestCount:   requests,
 			ErrorCount:     errors,
+			TimeoutCount:   pm.timeoutCount[name],
 			ErrorRate:      errorRate,
 			AverageLatency: avgLatency,
 		}
@@ -285,6 +468,7 @@ func (pm *ProviderManager) GetMetrics() map[string]ProviderMetrics {
 type ProviderMetrics struct {
 	RequestCount   uint64
 	ErrorCount     uint64
+	TimeoutCount   uint64
 	ErrorRate      float64
 	AverageLatency time.Duration
 }
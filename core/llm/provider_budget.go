@@ -0,0 +1,190 @@
+package llm
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Per-provider spend control: without it a fallback chain quietly
+// routes everything to whichever expensive provider happens to be up.
+// Soft limits warn, hard limits eject the provider from selection
+// until the daily window rolls over.
+
+// ProviderBudget bounds one provider's daily spend in USD. A zero
+// limit means unlimited on that axis.
+type ProviderBudget struct {
+	SoftLimitPerDay float64 `json:"soft_limit_per_day"`
+	HardLimitPerDay float64 `json:"hard_limit_per_day"`
+}
+
+// providerSpend tracks one provider's consumption window
+type providerSpend struct {
+	dayStart     time.Time
+	dayCost      float64
+	dayTokens    int
+	softAlerted  bool
+	hardRejected uint64
+}
+
+// providerBudgets is attached to a ProviderManager via
+// EnableCostTracking
+type providerBudgets struct {
+	mu         sync.Mutex
+	budgets    map[string]ProviderBudget
+	spend      map[string]*providerSpend
+	accountant *CostAccountant
+}
+
+// EnableCostTracking attaches a cost accountant so every generation is
+// charged against per-provider budgets. Pass nil to use a fresh
+// accountant.
+func (pm *ProviderManager) EnableCostTracking(accountant *CostAccountant) {
+	if accountant == nil {
+		accountant = NewCostAccountant()
+	}
+
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	pm.budgetCtl = &providerBudgets{
+		budgets:    make(map[string]ProviderBudget),
+		spend:      make(map[string]*providerSpend),
+		accountant: accountant,
+	}
+}
+
+// SetProviderBudget sets daily soft/hard USD limits for a provider.
+// Cost tracking is enabled implicitly when needed.
+func (pm *ProviderManager) SetProviderBudget(name string, budget ProviderBudget) {
+	pm.mu.RLock()
+	ctl := pm.budgetCtl
+	pm.mu.RUnlock()
+
+	if ctl == nil {
+		pm.EnableCostTracking(nil)
+		pm.mu.RLock()
+		ctl = pm.budgetCtl
+		pm.mu.RUnlock()
+	}
+
+	ctl.mu.Lock()
+	defer ctl.mu.Unlock()
+	ctl.budgets[name] = budget
+}
+
+// CostAccountant returns the attached accountant (nil when cost
+// tracking is disabled)
+func (pm *ProviderManager) CostAccountant() *CostAccountant {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	if pm.budgetCtl == nil {
+		return nil
+	}
+	return pm.budgetCtl.accountant
+}
+
+// providerWithinBudget reports whether a provider may take another
+// request. Crossing the soft limit warns once per day; the hard limit
+// rejects until the window rolls.
+func (pm *ProviderManager) providerWithinBudget(name string) bool {
+	pm.mu.RLock()
+	ctl := pm.budgetCtl
+	pm.mu.RUnlock()
+
+	if ctl == nil {
+		return true
+	}
+
+	ctl.mu.Lock()
+	defer ctl.mu.Unlock()
+
+	budget, hasBudget := ctl.budgets[name]
+	if !hasBudget {
+		return true
+	}
+
+	spend := ctl.spendFor(name)
+	if budget.HardLimitPerDay > 0 && spend.dayCost >= budget.HardLimitPerDay {
+		spend.hardRejected++
+		return false
+	}
+	if budget.SoftLimitPerDay > 0 && spend.dayCost >= budget.SoftLimitPerDay && !spend.softAlerted {
+		spend.softAlerted = true
+		fmt.Printf("💸 Provider %s passed its soft budget: $%.4f of $%.2f today\n",
+			name, spend.dayCost, budget.SoftLimitPerDay)
+	}
+	return true
+}
+
+// recordProviderSpend charges one completed generation against the
+// provider's daily window. Token counts are estimates when the
+// provider does not report usage.
+func (pm *ProviderManager) recordProviderSpend(name, model, prompt, result string) {
+	pm.mu.RLock()
+	ctl := pm.budgetCtl
+	pm.mu.RUnlock()
+
+	if ctl == nil {
+		return
+	}
+
+	promptTokens := EstimateTokens(prompt)
+	completionTokens := EstimateTokens(result)
+	record := ctl.accountant.RecordThought(time.Now().UnixNano(), name, model, promptTokens, completionTokens)
+
+	ctl.mu.Lock()
+	defer ctl.mu.Unlock()
+
+	spend := ctl.spendFor(name)
+	spend.dayCost += record.Cost
+	spend.dayTokens += promptTokens + completionTokens
+}
+
+// spendFor returns the rolling window for a provider, rolling it over
+// at the day boundary. Caller must hold ctl.mu.
+func (ctl *providerBudgets) spendFor(name string) *providerSpend {
+	spend, ok := ctl.spend[name]
+	if !ok {
+		spend = &providerSpend{dayStart: time.Now()}
+		ctl.spend[name] = spend
+	}
+	if time.Since(spend.dayStart) >= 24*time.Hour {
+		spend.dayStart = time.Now()
+		spend.dayCost = 0
+		spend.dayTokens = 0
+		spend.softAlerted = false
+	}
+	return spend
+}
+
+// GetBudgetMetrics returns per-provider spend against configured limits
+func (pm *ProviderManager) GetBudgetMetrics() map[string]interface{} {
+	pm.mu.RLock()
+	ctl := pm.budgetCtl
+	pm.mu.RUnlock()
+
+	if ctl == nil {
+		return map[string]interface{}{"cost_tracking": false}
+	}
+
+	ctl.mu.Lock()
+	defer ctl.mu.Unlock()
+
+	metrics := map[string]interface{}{"cost_tracking": true}
+	for name := range ctl.spend {
+		spend := ctl.spendFor(name)
+		entry := map[string]interface{}{
+			"day_cost":      spend.dayCost,
+			"day_tokens":    spend.dayTokens,
+			"hard_rejected": spend.hardRejected,
+		}
+		if budget, ok := ctl.budgets[name]; ok {
+			entry["soft_limit"] = budget.SoftLimitPerDay
+			entry["hard_limit"] = budget.HardLimitPerDay
+		}
+		metrics[name] = entry
+	}
+	return metrics
+}
@@ -0,0 +1,180 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Rate limiting: the concurrent inference engines can burst well past
+// upstream RPM/TPM ceilings and trip 429s. Each provider gets token
+// buckets for requests and tokens; short waits queue, long waits shed
+// to the next provider in the chain.
+
+// RateLimit bounds one provider's request and token throughput per
+// minute. A zero value means unlimited on that axis.
+type RateLimit struct {
+	RequestsPerMinute int `json:"requests_per_minute"`
+	TokensPerMinute   int `json:"tokens_per_minute"`
+}
+
+// maxThrottleWait is the longest a request queues before shedding
+const maxThrottleWait = 5 * time.Second
+
+// tokenBucket is a standard refilling bucket
+type tokenBucket struct {
+	capacity  float64
+	tokens    float64
+	perSecond float64
+	last      time.Time
+}
+
+func newTokenBucket(perMinute int) *tokenBucket {
+	return &tokenBucket{
+		capacity:  float64(perMinute),
+		tokens:    float64(perMinute),
+		perSecond: float64(perMinute) / 60,
+		last:      time.Now(),
+	}
+}
+
+// refill credits elapsed time. Caller must hold the limiter lock.
+func (tb *tokenBucket) refill() {
+	now := time.Now()
+	tb.tokens += now.Sub(tb.last).Seconds() * tb.perSecond
+	if tb.tokens > tb.capacity {
+		tb.tokens = tb.capacity
+	}
+	tb.last = now
+}
+
+// waitFor reserves n tokens and returns how long until the
+// reservation is covered (zero when they are already available). The
+// balance may go negative: that is the reservation, and it makes
+// later callers wait behind this one instead of double-spending the
+// same refill. Callers that abandon a reservation must refund it.
+func (tb *tokenBucket) waitFor(n float64) time.Duration {
+	tb.refill()
+	deficit := n - tb.tokens
+	tb.tokens -= n
+	if deficit <= 0 {
+		return 0
+	}
+	return time.Duration(deficit / tb.perSecond * float64(time.Second))
+}
+
+// refund returns an abandoned reservation to the bucket
+func (tb *tokenBucket) refund(n float64) {
+	tb.tokens += n
+	if tb.tokens > tb.capacity {
+		tb.tokens = tb.capacity
+	}
+}
+
+// providerLimiter holds the buckets and throttle counters for one
+// provider
+type providerLimiter struct {
+	mu       sync.Mutex
+	requests *tokenBucket
+	tokens   *tokenBucket
+
+	queued uint64
+	shed   uint64
+}
+
+// SetRateLimit configures RPM/TPM token buckets for a provider
+func (pm *ProviderManager) SetRateLimit(name string, limit RateLimit) {
+	limiter := &providerLimiter{}
+	if limit.RequestsPerMinute > 0 {
+		limiter.requests = newTokenBucket(limit.RequestsPerMinute)
+	}
+	if limit.TokensPerMinute > 0 {
+		limiter.tokens = newTokenBucket(limit.TokensPerMinute)
+	}
+
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	if pm.rateLimiters == nil {
+		pm.rateLimiters = make(map[string]*providerLimiter)
+	}
+	pm.rateLimiters[name] = limiter
+}
+
+// acquireRateLimit waits for bucket capacity, queuing short waits and
+// shedding when the wait exceeds maxThrottleWait or the caller's
+// context expires
+func (pm *ProviderManager) acquireRateLimit(ctx context.Context, name string, estTokens int) error {
+	pm.mu.RLock()
+	limiter := pm.rateLimiters[name]
+	pm.mu.RUnlock()
+
+	if limiter == nil {
+		return nil
+	}
+
+	limiter.mu.Lock()
+	wait := time.Duration(0)
+	if limiter.requests != nil {
+		if w := limiter.requests.waitFor(1); w > wait {
+			wait = w
+		}
+	}
+	if limiter.tokens != nil && estTokens > 0 {
+		if w := limiter.tokens.waitFor(float64(estTokens)); w > wait {
+			wait = w
+		}
+	}
+	if wait > 0 {
+		limiter.queued++
+	}
+	if wait > maxThrottleWait {
+		// Shed: give back the reservation so the next provider's
+		// failure does not also starve this one
+		limiter.shed++
+		limiter.refundLocked(estTokens)
+		limiter.mu.Unlock()
+		return fmt.Errorf("provider %s rate limited (retry in %s)", name, wait.Round(time.Millisecond))
+	}
+	limiter.mu.Unlock()
+
+	if wait > 0 {
+		select {
+		case <-ctx.Done():
+			limiter.mu.Lock()
+			limiter.refundLocked(estTokens)
+			limiter.mu.Unlock()
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+	return nil
+}
+
+// refundLocked returns one request and estTokens tokens to the
+// buckets. Caller must hold the limiter lock.
+func (limiter *providerLimiter) refundLocked(estTokens int) {
+	if limiter.requests != nil {
+		limiter.requests.refund(1)
+	}
+	if limiter.tokens != nil && estTokens > 0 {
+		limiter.tokens.refund(float64(estTokens))
+	}
+}
+
+// GetRateLimitMetrics returns throttle counters per provider
+func (pm *ProviderManager) GetRateLimitMetrics() map[string]interface{} {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	metrics := make(map[string]interface{}, len(pm.rateLimiters))
+	for name, limiter := range pm.rateLimiters {
+		limiter.mu.Lock()
+		metrics[name] = map[string]interface{}{
+			"queued": limiter.queued,
+			"shed":   limiter.shed,
+		}
+		limiter.mu.Unlock()
+	}
+	return metrics
+}
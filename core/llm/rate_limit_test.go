@@ -0,0 +1,96 @@
+package llm
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketReservesOnWait(t *testing.T) {
+	tb := newTokenBucket(60) // 1 token/sec, capacity 60
+
+	if wait := tb.waitFor(60); wait != 0 {
+		t.Fatalf("full bucket should cover its capacity, got wait %v", wait)
+	}
+
+	first := tb.waitFor(1)
+	second := tb.waitFor(1)
+	if first < 900*time.Millisecond {
+		t.Errorf("empty bucket gave wait %v, expected ~1s", first)
+	}
+	if second < first+900*time.Millisecond {
+		t.Errorf("second waiter got %v after %v: wait path did not reserve", second, first)
+	}
+}
+
+func TestTokenBucketRefund(t *testing.T) {
+	tb := newTokenBucket(60)
+	tb.waitFor(60)
+	tb.waitFor(10) // reserved, balance now -10
+
+	tb.refund(10)
+	if tb.tokens < -0.5 {
+		t.Errorf("refund did not restore reservation, balance %.2f", tb.tokens)
+	}
+}
+
+func TestAcquireRateLimitEnforcesBurst(t *testing.T) {
+	pm := NewProviderManager()
+	pm.SetRateLimit("test", RateLimit{RequestsPerMinute: 6000}) // 100/sec
+
+	// Drain the initial burst allowance so every acquire must wait on
+	// the refill rate
+	pm.mu.RLock()
+	limiter := pm.rateLimiters["test"]
+	pm.mu.RUnlock()
+	limiter.mu.Lock()
+	limiter.requests.tokens = 0
+	limiter.mu.Unlock()
+
+	started := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := pm.acquireRateLimit(context.Background(), "test", 0); err != nil {
+				t.Errorf("acquire failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 10 requests at 100/sec from an empty bucket need ~100ms; without
+	// reservation they all return after the first 10ms refill
+	if elapsed := time.Since(started); elapsed < 80*time.Millisecond {
+		t.Errorf("10-request burst cleared in %v, limiter is not enforcing", elapsed)
+	}
+}
+
+func TestAcquireRateLimitRefundsOnShed(t *testing.T) {
+	pm := NewProviderManager()
+	pm.SetRateLimit("test", RateLimit{RequestsPerMinute: 60, TokensPerMinute: 60})
+
+	pm.mu.RLock()
+	limiter := pm.rateLimiters["test"]
+	pm.mu.RUnlock()
+	limiter.mu.Lock()
+	limiter.tokens.tokens = 0 // force a 60s token wait -> shed
+	limiter.mu.Unlock()
+
+	if err := pm.acquireRateLimit(context.Background(), "test", 60); err == nil {
+		t.Fatal("expected shed error")
+	}
+
+	limiter.mu.Lock()
+	requestTokens := limiter.requests.tokens
+	shed := limiter.shed
+	limiter.mu.Unlock()
+	if shed != 1 {
+		t.Errorf("shed counter = %d, want 1", shed)
+	}
+	if requestTokens < 59.5 {
+		t.Errorf("request token not refunded after shed, balance %.2f", requestTokens)
+	}
+}
@@ -0,0 +1,257 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Resilience: transient provider failures (429s, 5xx, network blips)
+// retry with exponential backoff and jitter; persistent failures trip
+// a circuit breaker that ejects the provider from the chain until a
+// half-open probe succeeds.
+
+// RetryPolicy controls per-provider retry behavior
+type RetryPolicy struct {
+	MaxAttempts int           `json:"max_attempts"`
+	BaseDelay   time.Duration `json:"base_delay"`
+	MaxDelay    time.Duration `json:"max_delay"`
+	// Jitter is the random fraction added to each delay (0..1)
+	Jitter float64 `json:"jitter"`
+}
+
+// DefaultRetryPolicy returns the standard backoff schedule
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    10 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// Circuit breaker states
+const (
+	circuitClosed   = "closed"
+	circuitOpen     = "open"
+	circuitHalfOpen = "half-open"
+)
+
+// circuitBreaker ejects a failing provider until a probe succeeds
+type circuitBreaker struct {
+	mu               sync.Mutex
+	state            string
+	consecutiveFails int
+	failThreshold    int
+	openedAt         time.Time
+	openDuration     time.Duration
+	probing          bool
+
+	trips uint64
+}
+
+func newCircuitBreaker() *circuitBreaker {
+	return &circuitBreaker{
+		state:         circuitClosed,
+		failThreshold: 5,
+		openDuration:  30 * time.Second,
+	}
+}
+
+// allow reports whether a request may pass. In the open state one
+// probe is admitted after the cooldown (half-open).
+func (cb *circuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case circuitClosed:
+		return true
+	case circuitOpen:
+		if time.Since(cb.openedAt) >= cb.openDuration {
+			cb.state = circuitHalfOpen
+			cb.probing = true
+			return true
+		}
+		return false
+	case circuitHalfOpen:
+		if cb.probing {
+			return false
+		}
+		cb.probing = true
+		return true
+	}
+	return true
+}
+
+// record updates breaker state after a request
+func (cb *circuitBreaker) record(success bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.probing = false
+	if success {
+		cb.consecutiveFails = 0
+		cb.state = circuitClosed
+		return
+	}
+
+	cb.consecutiveFails++
+	if cb.state == circuitHalfOpen || cb.consecutiveFails >= cb.failThreshold {
+		if cb.state != circuitOpen {
+			cb.trips++
+		}
+		cb.state = circuitOpen
+		cb.openedAt = time.Now()
+	}
+}
+
+// snapshot returns the breaker state for metrics
+func (cb *circuitBreaker) snapshot() map[string]interface{} {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	return map[string]interface{}{
+		"state":             cb.state,
+		"consecutive_fails": cb.consecutiveFails,
+		"trips":             cb.trips,
+	}
+}
+
+// SetRetryPolicy configures retries for one provider (an empty name
+// sets the default policy)
+func (pm *ProviderManager) SetRetryPolicy(name string, policy RetryPolicy) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if pm.retryPolicies == nil {
+		pm.retryPolicies = make(map[string]RetryPolicy)
+	}
+	pm.retryPolicies[name] = policy
+}
+
+// retryPolicyFor resolves the policy for a provider
+func (pm *ProviderManager) retryPolicyFor(name string) RetryPolicy {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	if policy, ok := pm.retryPolicies[name]; ok {
+		return policy
+	}
+	if policy, ok := pm.retryPolicies[""]; ok {
+		return policy
+	}
+	return RetryPolicy{MaxAttempts: 1}
+}
+
+// breakerFor returns (lazily creating) the breaker for a provider
+func (pm *ProviderManager) breakerFor(name string) *circuitBreaker {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if pm.breakers == nil {
+		pm.breakers = make(map[string]*circuitBreaker)
+	}
+	breaker, ok := pm.breakers[name]
+	if !ok {
+		breaker = newCircuitBreaker()
+		pm.breakers[name] = breaker
+	}
+	return breaker
+}
+
+// isRetryableError classifies errors worth retrying: rate limits,
+// server errors, and transport failures. Context cancellation and
+// client errors are not.
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	msg := err.Error()
+	if strings.Contains(msg, "status 429") {
+		return true
+	}
+	for code := 500; code <= 504; code++ {
+		if strings.Contains(msg, fmt.Sprintf("status %d", code)) {
+			return true
+		}
+	}
+	return strings.Contains(msg, "failed to send request")
+}
+
+// generateWithRetry runs one provider's Generate under its retry
+// policy and circuit breaker. The breaker-open case returns an error
+// so the fallback chain moves on.
+func (pm *ProviderManager) generateWithRetry(ctx context.Context, name string, provider LLMProvider, prompt string, opts GenerateOptions) (string, error) {
+	breaker := pm.breakerFor(name)
+	if !breaker.allow() {
+		return "", fmt.Errorf("provider %s circuit open", name)
+	}
+
+	policy := pm.retryPolicyFor(name)
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := policy.BaseDelay << uint(attempt-1)
+			if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+				delay = policy.MaxDelay
+			}
+			if policy.Jitter > 0 {
+				delay += time.Duration(rand.Float64() * policy.Jitter * float64(delay))
+			}
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		attemptCtx, cancel := pm.withProviderTimeout(ctx, name)
+		result, err := provider.Generate(attemptCtx, prompt, opts)
+		cancel()
+		if err == nil {
+			breaker.record(true)
+			return result, nil
+		}
+		lastErr = err
+		pm.countTimeout(name, err)
+		// A provider timeout is worth retrying unless the caller's own
+		// deadline has passed
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			continue
+		}
+		if !isRetryableError(err) {
+			break
+		}
+	}
+
+	breaker.record(false)
+	return "", lastErr
+}
+
+// GetCircuitMetrics returns circuit breaker state per provider
+func (pm *ProviderManager) GetCircuitMetrics() map[string]interface{} {
+	pm.mu.RLock()
+	breakers := make(map[string]*circuitBreaker, len(pm.breakers))
+	for name, breaker := range pm.breakers {
+		breakers[name] = breaker
+	}
+	pm.mu.RUnlock()
+
+	metrics := make(map[string]interface{}, len(breakers))
+	for name, breaker := range breakers {
+		metrics[name] = breaker.snapshot()
+	}
+	return metrics
+}
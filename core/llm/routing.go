@@ -0,0 +1,113 @@
+package llm
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Purpose routing: different cognitive workloads want different
+// models — reflection thoughts can run on a cheap model while goal
+// planning gets the strong one and embeddings stay local. Rules map a
+// request purpose to a provider and are consulted whenever the caller
+// does not name a provider explicitly.
+
+// Well-known purposes used by the cognitive subsystems
+const (
+	PurposeReflection   = "reflection"
+	PurposeGoalPlanning = "goal_planning"
+	PurposeDreaming     = "dreaming"
+	PurposeEmbeddings   = "embeddings"
+)
+
+// routingTable maps purposes to provider names
+type routingTable struct {
+	mu     sync.RWMutex
+	routes map[string]string
+
+	routed uint64
+}
+
+// SetRoute directs requests with the given purpose to a provider
+func (pm *ProviderManager) SetRoute(purpose, providerName string) error {
+	if purpose == "" {
+		return fmt.Errorf("purpose required")
+	}
+
+	pm.mu.Lock()
+	if pm.routing == nil {
+		pm.routing = &routingTable{routes: make(map[string]string)}
+	}
+	routing := pm.routing
+	pm.mu.Unlock()
+
+	pm.mu.RLock()
+	_, exists := pm.providers[providerName]
+	pm.mu.RUnlock()
+	if !exists {
+		return fmt.Errorf("provider %s not registered", providerName)
+	}
+
+	routing.mu.Lock()
+	defer routing.mu.Unlock()
+	routing.routes[purpose] = providerName
+	return nil
+}
+
+// SetRoutes installs a whole routing config at once (e.g. loaded from
+// a config file)
+func (pm *ProviderManager) SetRoutes(routes map[string]string) error {
+	for purpose, providerName := range routes {
+		if err := pm.SetRoute(purpose, providerName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// routeFor resolves a purpose to a provider name ("" when unrouted)
+func (pm *ProviderManager) routeFor(purpose string) string {
+	if purpose == "" {
+		return ""
+	}
+
+	pm.mu.RLock()
+	routing := pm.routing
+	pm.mu.RUnlock()
+
+	if routing == nil {
+		return ""
+	}
+
+	routing.mu.Lock()
+	defer routing.mu.Unlock()
+
+	name, ok := routing.routes[purpose]
+	if ok {
+		routing.routed++
+	}
+	return name
+}
+
+// GetRoutingMetrics returns the routing table and usage count
+func (pm *ProviderManager) GetRoutingMetrics() map[string]interface{} {
+	pm.mu.RLock()
+	routing := pm.routing
+	pm.mu.RUnlock()
+
+	if routing == nil {
+		return map[string]interface{}{"routes": 0}
+	}
+
+	routing.mu.RLock()
+	defer routing.mu.RUnlock()
+
+	routes := make(map[string]string, len(routing.routes))
+	for purpose, name := range routing.routes {
+		routes[purpose] = name
+	}
+	return map[string]interface{}{
+		"routes":          len(routes),
+		"table":           routes,
+		"requests_routed": routing.routed,
+	}
+}
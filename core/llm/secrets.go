@@ -0,0 +1,189 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Credential management: long-running agents cannot restart to pick
+// up fresh API keys. Keys come from pluggable secret sources, multiple
+// keys per provider sit in a rotating ring, and auth failures advance
+// the ring automatically.
+
+// SecretSource resolves named secrets
+type SecretSource interface {
+	GetSecret(name string) (string, error)
+}
+
+// EnvSecretSource reads secrets from environment variables
+type EnvSecretSource struct{}
+
+// GetSecret implements SecretSource
+func (es EnvSecretSource) GetSecret(name string) (string, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return "", fmt.Errorf("secret %s not set in environment", name)
+	}
+	return value, nil
+}
+
+// FileSecretSource reads secrets from a JSON file of name→value
+// pairs, re-reading on every lookup so external rotation (e.g. a
+// Vault agent sidecar writing the file) is picked up without restarts
+type FileSecretSource struct {
+	Path string
+}
+
+// GetSecret implements SecretSource
+func (fs FileSecretSource) GetSecret(name string) (string, error) {
+	data, err := os.ReadFile(fs.Path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secrets file: %w", err)
+	}
+
+	secrets := map[string]string{}
+	if err := json.Unmarshal(data, &secrets); err != nil {
+		return "", fmt.Errorf("failed to parse secrets file: %w", err)
+	}
+
+	value, ok := secrets[name]
+	if !ok || value == "" {
+		return "", fmt.Errorf("secret %s not found in %s", name, fs.Path)
+	}
+	return value, nil
+}
+
+// KeyRing rotates through multiple API keys for one provider
+type KeyRing struct {
+	mu        sync.Mutex
+	keys      []string
+	index     int
+	rotations uint64
+}
+
+// NewKeyRing creates a ring from the given keys
+func NewKeyRing(keys ...string) *KeyRing {
+	return &KeyRing{keys: keys}
+}
+
+// LoadKeyRing resolves a ring from a secret source, tolerating
+// missing entries so a partially provisioned ring still works
+func LoadKeyRing(source SecretSource, names ...string) (*KeyRing, error) {
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		key, err := source.GetSecret(name)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no keys resolved from secret source")
+	}
+	return NewKeyRing(keys...), nil
+}
+
+// Current returns the active key
+func (kr *KeyRing) Current() string {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	if len(kr.keys) == 0 {
+		return ""
+	}
+	return kr.keys[kr.index]
+}
+
+// Rotate advances to the next key, returning it
+func (kr *KeyRing) Rotate() string {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	if len(kr.keys) == 0 {
+		return ""
+	}
+	kr.index = (kr.index + 1) % len(kr.keys)
+	kr.rotations++
+	return kr.keys[kr.index]
+}
+
+// Size returns how many keys the ring holds
+func (kr *KeyRing) Size() int {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	return len(kr.keys)
+}
+
+// keyRotator pairs a ring with the provider it feeds
+type keyRotator struct {
+	ring  *KeyRing
+	apply func(key string)
+}
+
+// EnableKeyRotation attaches a key ring to a provider. apply installs
+// a key into the provider (e.g. a SetAPIKey closure); it is called
+// immediately with the current key and again on every rotation.
+func (pm *ProviderManager) EnableKeyRotation(providerName string, ring *KeyRing, apply func(key string)) error {
+	if ring == nil || apply == nil {
+		return fmt.Errorf("key ring and apply function required")
+	}
+
+	pm.mu.Lock()
+	if pm.keyRotators == nil {
+		pm.keyRotators = make(map[string]*keyRotator)
+	}
+	pm.keyRotators[providerName] = &keyRotator{ring: ring, apply: apply}
+	pm.mu.Unlock()
+
+	apply(ring.Current())
+	return nil
+}
+
+// isAuthError recognizes credential failures worth a key rotation
+func isAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "status 401") || strings.Contains(msg, "status 403")
+}
+
+// rotateKeyOnAuthError advances the provider's ring after an auth
+// failure so the next attempt uses a fresh credential
+func (pm *ProviderManager) rotateKeyOnAuthError(name string, err error) {
+	if !isAuthError(err) {
+		return
+	}
+
+	pm.mu.RLock()
+	rotator := pm.keyRotators[name]
+	pm.mu.RUnlock()
+
+	if rotator == nil || rotator.ring.Size() < 2 {
+		return
+	}
+
+	next := rotator.ring.Rotate()
+	rotator.apply(next)
+	fmt.Printf("🔑 Provider %s: auth failure, rotated to next API key\n", name)
+}
+
+// GetKeyRotationMetrics returns ring sizes and rotation counts
+func (pm *ProviderManager) GetKeyRotationMetrics() map[string]interface{} {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	metrics := make(map[string]interface{}, len(pm.keyRotators))
+	for name, rotator := range pm.keyRotators {
+		rotator.ring.mu.Lock()
+		metrics[name] = map[string]interface{}{
+			"keys":      len(rotator.ring.keys),
+			"rotations": rotator.ring.rotations,
+		}
+		rotator.ring.mu.Unlock()
+	}
+	return metrics
+}
@@ -0,0 +1,172 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Structured output: callers hand over a JSON schema and a destination
+// struct; providers with native schema enforcement constrain decoding
+// on the server, others get the schema in the prompt with parse-and-
+// retry validation.
+
+// StructuredCapable is implemented by providers with native JSON
+// schema enforcement
+type StructuredCapable interface {
+	GenerateStructured(ctx context.Context, prompt string, schema map[string]interface{}, opts GenerateOptions) (string, error)
+}
+
+// maxStructuredRetries bounds the prompted validation loop
+const maxStructuredRetries = 3
+
+// GenerateStructured produces output conforming to a JSON schema and
+// unmarshals it into out. Native enforcement is used when the default
+// provider supports it; otherwise the schema goes into the prompt and
+// invalid replies are retried with the parse error fed back.
+func (pm *ProviderManager) GenerateStructured(ctx context.Context, prompt string, schema map[string]interface{}, out interface{}, opts GenerateOptions) error {
+	pm.mu.RLock()
+	name := pm.defaultProvider
+	provider := pm.providers[name]
+	pm.mu.RUnlock()
+
+	if provider == nil || !provider.Available() {
+		return errors.New("no LLM providers available")
+	}
+
+	if native, ok := provider.(StructuredCapable); ok {
+		raw, err := native.GenerateStructured(ctx, prompt, schema, opts)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(raw), out); err != nil {
+			return fmt.Errorf("schema-enforced output did not parse: %w", err)
+		}
+		return nil
+	}
+
+	return promptedStructured(ctx, provider, prompt, schema, out, opts)
+}
+
+// promptedStructured emulates schema enforcement: the schema rides in
+// the prompt, and parse failures are retried with the error included
+func promptedStructured(ctx context.Context, provider LLMProvider, prompt string, schema map[string]interface{}, out interface{}, opts GenerateOptions) error {
+	schemaJSON, err := json.Marshal(schema)
+	if err != nil {
+		return fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	attempt := fmt.Sprintf("%s\n\nReply with ONLY a JSON object matching this schema, no prose:\n%s", prompt, string(schemaJSON))
+
+	var lastErr error
+	for i := 0; i < maxStructuredRetries; i++ {
+		reply, err := provider.Generate(ctx, attempt, opts)
+		if err != nil {
+			return err
+		}
+
+		raw, ok := extractJSONObject(reply)
+		if !ok {
+			lastErr = errors.New("no JSON object found in reply")
+		} else if err := json.Unmarshal([]byte(raw), out); err != nil {
+			lastErr = err
+		} else {
+			return nil
+		}
+
+		attempt = fmt.Sprintf("%s\n\nYour previous reply was invalid (%v). Reply with ONLY a corrected JSON object matching the schema:\n%s",
+			prompt, lastErr, string(schemaJSON))
+	}
+
+	return fmt.Errorf("structured output failed after %d attempts: %w", maxStructuredRetries, lastErr)
+}
+
+// extractJSONObject pulls the outermost JSON object out of a reply
+// that may include prose or code fences
+func extractJSONObject(reply string) (string, bool) {
+	trimmed := strings.TrimSpace(reply)
+	start := strings.Index(trimmed, "{")
+	end := strings.LastIndex(trimmed, "}")
+	if start < 0 || end <= start {
+		return "", false
+	}
+	return trimmed[start : end+1], true
+}
+
+// GenerateStructured implements StructuredCapable via the response_format
+// json_schema mode
+func (oai *OpenAIProvider) GenerateStructured(ctx context.Context, prompt string, schema map[string]interface{}, opts GenerateOptions) (string, error) {
+	if !oai.Available() {
+		return "", fmt.Errorf("openai provider not configured")
+	}
+
+	messages := []openAIMessage{}
+	if opts.SystemPrompt != "" {
+		messages = append(messages, openAIMessage{Role: "system", Content: opts.SystemPrompt})
+	}
+	messages = append(messages, openAIMessage{Role: "user", Content: prompt})
+
+	req := struct {
+		openAIRequest
+		ResponseFormat map[string]interface{} `json:"response_format"`
+	}{
+		openAIRequest: openAIRequest{
+			Model:       oai.model,
+			Messages:    messages,
+			MaxTokens:   opts.MaxTokens,
+			Temperature: opts.Temperature,
+			TopP:        opts.TopP,
+		},
+		ResponseFormat: map[string]interface{}{
+			"type": "json_schema",
+			"json_schema": map[string]interface{}{
+				"name":   "structured_output",
+				"schema": schema,
+				"strict": true,
+			},
+		},
+	}
+	if req.MaxTokens <= 0 || req.MaxTokens > oai.MaxTokens() {
+		req.MaxTokens = 1024
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", oai.apiURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+oai.apiKey)
+
+	resp, err := oai.httpClient.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp openAIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(apiResp.Choices) == 0 {
+		return "", fmt.Errorf("no choices in response")
+	}
+	return apiResp.Choices[0].Message.Content, nil
+}
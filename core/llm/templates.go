@@ -0,0 +1,183 @@
+package llm
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Prompt templates: the consciousness stream, goal orchestrator, and
+// dream narrator all assemble prompts inline today, which makes prompt
+// changes impossible to track. Templates are named, versioned on every
+// re-registration, support {{variable}} substitution and {{> partial}}
+// includes, and every render is logged.
+
+// PromptTemplate is one version of a named template
+type PromptTemplate struct {
+	Name    string    `json:"name"`
+	Version int       `json:"version"`
+	Body    string    `json:"body"`
+	Created time.Time `json:"created"`
+}
+
+// RenderedPrompt records one render for prompt-change tracking
+type RenderedPrompt struct {
+	Template  string    `json:"template"`
+	Version   int       `json:"version"`
+	Rendered  string    `json:"rendered"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// TemplateEngine holds named, versioned prompt templates
+type TemplateEngine struct {
+	mu        sync.RWMutex
+	templates map[string][]*PromptTemplate
+	partials  map[string]string
+
+	renderLog []RenderedPrompt
+	maxLog    int
+	renders   uint64
+}
+
+// NewTemplateEngine creates an empty template engine
+func NewTemplateEngine() *TemplateEngine {
+	return &TemplateEngine{
+		templates: make(map[string][]*PromptTemplate),
+		partials:  make(map[string]string),
+		maxLog:    200,
+	}
+}
+
+// Register adds a template body under a name. Re-registering the same
+// name creates a new version; old versions stay renderable.
+func (te *TemplateEngine) Register(name, body string) (int, error) {
+	if name == "" {
+		return 0, fmt.Errorf("template name required")
+	}
+
+	te.mu.Lock()
+	defer te.mu.Unlock()
+
+	version := len(te.templates[name]) + 1
+	te.templates[name] = append(te.templates[name], &PromptTemplate{
+		Name:    name,
+		Version: version,
+		Body:    body,
+		Created: time.Now(),
+	})
+	return version, nil
+}
+
+// RegisterPartial adds a reusable fragment included via {{> name}}
+func (te *TemplateEngine) RegisterPartial(name, body string) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	te.partials[name] = body
+}
+
+// Render renders the latest version of a template
+func (te *TemplateEngine) Render(name string, vars map[string]interface{}) (string, error) {
+	return te.RenderVersion(name, 0, vars)
+}
+
+// RenderVersion renders a specific template version (0 = latest)
+func (te *TemplateEngine) RenderVersion(name string, version int, vars map[string]interface{}) (string, error) {
+	te.mu.RLock()
+	versions, ok := te.templates[name]
+	te.mu.RUnlock()
+
+	if !ok || len(versions) == 0 {
+		return "", fmt.Errorf("template %s not registered", name)
+	}
+	if version == 0 {
+		version = len(versions)
+	}
+	if version < 1 || version > len(versions) {
+		return "", fmt.Errorf("template %s has no version %d", name, version)
+	}
+
+	template := versions[version-1]
+	rendered := te.expand(template.Body, vars)
+
+	te.mu.Lock()
+	te.renders++
+	te.renderLog = append(te.renderLog, RenderedPrompt{
+		Template:  name,
+		Version:   version,
+		Rendered:  rendered,
+		Timestamp: time.Now(),
+	})
+	if len(te.renderLog) > te.maxLog {
+		te.renderLog = te.renderLog[len(te.renderLog)-te.maxLog:]
+	}
+	te.mu.Unlock()
+
+	return rendered, nil
+}
+
+// expand substitutes partials then variables. Unknown placeholders
+// are left in place so missing variables are visible in the render
+// log rather than silently blank.
+func (te *TemplateEngine) expand(body string, vars map[string]interface{}) string {
+	te.mu.RLock()
+	for name, partial := range te.partials {
+		body = strings.ReplaceAll(body, "{{> "+name+"}}", partial)
+		body = strings.ReplaceAll(body, "{{>"+name+"}}", partial)
+	}
+	te.mu.RUnlock()
+
+	for key, value := range vars {
+		body = strings.ReplaceAll(body, "{{"+key+"}}", fmt.Sprintf("%v", value))
+	}
+	return body
+}
+
+// Versions returns all versions of a named template
+func (te *TemplateEngine) Versions(name string) []*PromptTemplate {
+	te.mu.RLock()
+	defer te.mu.RUnlock()
+
+	versions := make([]*PromptTemplate, len(te.templates[name]))
+	copy(versions, te.templates[name])
+	return versions
+}
+
+// ListTemplates returns the registered template names
+func (te *TemplateEngine) ListTemplates() []string {
+	te.mu.RLock()
+	defer te.mu.RUnlock()
+
+	names := make([]string, 0, len(te.templates))
+	for name := range te.templates {
+		names = append(names, name)
+	}
+	return names
+}
+
+// GetRenderLog returns recent rendered prompts, newest last
+func (te *TemplateEngine) GetRenderLog() []RenderedPrompt {
+	te.mu.RLock()
+	defer te.mu.RUnlock()
+
+	log := make([]RenderedPrompt, len(te.renderLog))
+	copy(log, te.renderLog)
+	return log
+}
+
+// GetMetrics returns engine statistics
+func (te *TemplateEngine) GetMetrics() map[string]interface{} {
+	te.mu.RLock()
+	defer te.mu.RUnlock()
+
+	totalVersions := 0
+	for _, versions := range te.templates {
+		totalVersions += len(versions)
+	}
+	return map[string]interface{}{
+		"templates":      len(te.templates),
+		"total_versions": totalVersions,
+		"partials":       len(te.partials),
+		"renders":        te.renders,
+	}
+}
@@ -0,0 +1,60 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Per-provider timeouts: a slow provider otherwise stalls an entire
+// cognitive tick. Each request runs under the tighter of the caller's
+// context deadline and the provider's configured timeout; expirations
+// are counted in provider stats.
+
+// SetTimeout bounds one provider's requests (an empty name sets the
+// default for all providers without their own)
+func (pm *ProviderManager) SetTimeout(name string, timeout time.Duration) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if pm.timeouts == nil {
+		pm.timeouts = make(map[string]time.Duration)
+	}
+	pm.timeouts[name] = timeout
+}
+
+// timeoutFor resolves the timeout for a provider (0 = none)
+func (pm *ProviderManager) timeoutFor(name string) time.Duration {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	if timeout, ok := pm.timeouts[name]; ok {
+		return timeout
+	}
+	return pm.timeouts[""]
+}
+
+// withProviderTimeout derives the request context, honoring whichever
+// deadline is tighter
+func (pm *ProviderManager) withProviderTimeout(ctx context.Context, name string) (context.Context, context.CancelFunc) {
+	timeout := pm.timeoutFor(name)
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// countTimeout records a deadline expiry against a provider
+func (pm *ProviderManager) countTimeout(name string, err error) {
+	if !errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if pm.timeoutCount == nil {
+		pm.timeoutCount = make(map[string]uint64)
+	}
+	pm.timeoutCount[name]++
+}
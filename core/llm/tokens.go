@@ -0,0 +1,100 @@
+package llm
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Token counting: char/4 estimates drift badly on code and
+// punctuation-heavy text, which skews budgets and context truncation.
+// CountTokens segments text the way BPE tokenizers roughly do —
+// word pieces plus individual punctuation — scaled per model family.
+
+// modelTokenScale adjusts the base estimate for model families whose
+// tokenizers are denser or sparser than the GPT baseline
+var modelTokenScale = map[string]float64{
+	"gpt":     1.0,
+	"claude":  1.05,
+	"mistral": 1.1,
+	"llama":   1.15,
+	"default": 1.0,
+}
+
+// CountTokens estimates the token count of text for a model. It is
+// tokenizer-aware in shape (word pieces, punctuation, whitespace
+// collapsing) without requiring vocabulary files.
+func CountTokens(model, text string) int {
+	if text == "" {
+		return 0
+	}
+
+	tokens := 0
+	runLen := 0
+	flush := func() {
+		if runLen > 0 {
+			// Word pieces average ~4 characters
+			tokens += (runLen + 3) / 4
+			runLen = 0
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			runLen++
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			// Punctuation and symbols usually tokenize individually
+			flush()
+			tokens++
+		}
+	}
+	flush()
+
+	return int(float64(tokens) * scaleFor(model))
+}
+
+// scaleFor resolves the model-family scale factor by prefix match
+func scaleFor(model string) float64 {
+	lower := strings.ToLower(model)
+	for family, scale := range modelTokenScale {
+		if family != "default" && strings.Contains(lower, family) {
+			return scale
+		}
+	}
+	return modelTokenScale["default"]
+}
+
+// TruncateToTokens cuts text so it fits within maxTokens for the
+// model, trimming from the front (oldest context first) and breaking
+// on a whitespace boundary
+func TruncateToTokens(model, text string, maxTokens int) string {
+	if maxTokens <= 0 || CountTokens(model, text) <= maxTokens {
+		return text
+	}
+
+	// Binary search the earliest start offset that fits
+	lo, hi := 0, len(text)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if CountTokens(model, text[mid:]) <= maxTokens {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	truncated := text[lo:]
+	// Avoid starting mid-word
+	if idx := strings.IndexFunc(truncated, unicode.IsSpace); idx > 0 && idx < len(truncated)-1 {
+		truncated = strings.TrimLeftFunc(truncated[idx:], unicode.IsSpace)
+	}
+	return truncated
+}
+
+// FitsContext reports whether prompt plus completion budget fits a
+// context window
+func FitsContext(model, prompt string, completionTokens, contextWindow int) bool {
+	return CountTokens(model, prompt)+completionTokens <= contextWindow
+}
@@ -0,0 +1,337 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Tool calling: goal orchestration and the effector device need the
+// LLM to invoke registered capabilities. Tools are declared once with a
+// JSON schema; providers with native function calling get the schema on
+// the wire, others fall back to a prompted JSON protocol.
+
+// ToolDefinition declares one callable tool
+type ToolDefinition struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Parameters is a JSON schema object describing the arguments
+	Parameters map[string]interface{} `json:"parameters"`
+	// Handler executes the tool; nil-handler tools can still be
+	// offered to the model but must be executed by the caller
+	Handler func(ctx context.Context, args map[string]interface{}) (string, error) `json:"-"`
+}
+
+// ToolCall is one invocation requested by the model
+type ToolCall struct {
+	ID        string                 `json:"id"`
+	Name      string                 `json:"name"`
+	Arguments map[string]interface{} `json:"arguments"`
+}
+
+// ToolResult pairs a call with its handler output
+type ToolResult struct {
+	Call   ToolCall `json:"call"`
+	Output string   `json:"output"`
+	Error  string   `json:"error,omitempty"`
+}
+
+// ToolResponse is the model's reply: free text, tool calls, or both
+type ToolResponse struct {
+	Content   string     `json:"content"`
+	ToolCalls []ToolCall `json:"tool_calls"`
+}
+
+// ToolCapable is implemented by providers with native function calling
+type ToolCapable interface {
+	ChatWithTools(ctx context.Context, messages []Message, tools []ToolDefinition, opts GenerateOptions) (*ToolResponse, error)
+}
+
+// ToolRegistry holds the declared tools
+type ToolRegistry struct {
+	mu    sync.RWMutex
+	tools map[string]ToolDefinition
+	order []string
+
+	calls  uint64
+	errors uint64
+}
+
+// NewToolRegistry creates an empty tool registry
+func NewToolRegistry() *ToolRegistry {
+	return &ToolRegistry{
+		tools: make(map[string]ToolDefinition),
+	}
+}
+
+// Register adds a tool definition
+func (tr *ToolRegistry) Register(tool ToolDefinition) error {
+	if tool.Name == "" {
+		return errors.New("tool name required")
+	}
+
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+
+	if _, exists := tr.tools[tool.Name]; exists {
+		return fmt.Errorf("tool %s already registered", tool.Name)
+	}
+	tr.tools[tool.Name] = tool
+	tr.order = append(tr.order, tool.Name)
+	return nil
+}
+
+// Definitions returns the registered tools in registration order
+func (tr *ToolRegistry) Definitions() []ToolDefinition {
+	tr.mu.RLock()
+	defer tr.mu.RUnlock()
+
+	defs := make([]ToolDefinition, 0, len(tr.order))
+	for _, name := range tr.order {
+		defs = append(defs, tr.tools[name])
+	}
+	return defs
+}
+
+// Execute runs the handlers for the model's tool calls
+func (tr *ToolRegistry) Execute(ctx context.Context, calls []ToolCall) []ToolResult {
+	results := make([]ToolResult, 0, len(calls))
+	for _, call := range calls {
+		tr.mu.RLock()
+		tool, exists := tr.tools[call.Name]
+		tr.mu.RUnlock()
+
+		result := ToolResult{Call: call}
+		switch {
+		case !exists:
+			result.Error = fmt.Sprintf("unknown tool %s", call.Name)
+		case tool.Handler == nil:
+			result.Error = fmt.Sprintf("tool %s has no handler", call.Name)
+		default:
+			output, err := tool.Handler(ctx, call.Arguments)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Output = output
+			}
+		}
+
+		tr.mu.Lock()
+		tr.calls++
+		if result.Error != "" {
+			tr.errors++
+		}
+		tr.mu.Unlock()
+
+		results = append(results, result)
+	}
+	return results
+}
+
+// GetMetrics returns registry statistics
+func (tr *ToolRegistry) GetMetrics() map[string]interface{} {
+	tr.mu.RLock()
+	defer tr.mu.RUnlock()
+
+	return map[string]interface{}{
+		"registered_tools": len(tr.tools),
+		"calls_executed":   tr.calls,
+		"call_errors":      tr.errors,
+	}
+}
+
+// openAITool is the function-calling wire format
+type openAITool struct {
+	Type     string `json:"type"`
+	Function struct {
+		Name        string                 `json:"name"`
+		Description string                 `json:"description"`
+		Parameters  map[string]interface{} `json:"parameters"`
+	} `json:"function"`
+}
+
+// toOpenAITools converts tool definitions to the wire format
+func toOpenAITools(tools []ToolDefinition) []openAITool {
+	converted := make([]openAITool, 0, len(tools))
+	for _, tool := range tools {
+		var wire openAITool
+		wire.Type = "function"
+		wire.Function.Name = tool.Name
+		wire.Function.Description = tool.Description
+		wire.Function.Parameters = tool.Parameters
+		if wire.Function.Parameters == nil {
+			wire.Function.Parameters = map[string]interface{}{"type": "object", "properties": map[string]interface{}{}}
+		}
+		converted = append(converted, wire)
+	}
+	return converted
+}
+
+// ChatWithTools implements ToolCapable for the OpenAI API
+func (oai *OpenAIProvider) ChatWithTools(ctx context.Context, messages []Message, tools []ToolDefinition, opts GenerateOptions) (*ToolResponse, error) {
+	if !oai.Available() {
+		return nil, fmt.Errorf("openai provider not configured")
+	}
+
+	converted := toOpenAIMessages(messages)
+	if opts.SystemPrompt != "" {
+		converted = append([]openAIMessage{{Role: "system", Content: opts.SystemPrompt}}, converted...)
+	}
+
+	req := struct {
+		openAIRequest
+		Tools []openAITool `json:"tools,omitempty"`
+	}{
+		openAIRequest: openAIRequest{
+			Model:       oai.model,
+			Messages:    converted,
+			MaxTokens:   opts.MaxTokens,
+			Temperature: opts.Temperature,
+			TopP:        opts.TopP,
+		},
+		Tools: toOpenAITools(tools),
+	}
+	if req.MaxTokens <= 0 || req.MaxTokens > oai.MaxTokens() {
+		req.MaxTokens = 1024
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", oai.apiURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+oai.apiKey)
+
+	resp, err := oai.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp struct {
+		Choices []struct {
+			Message struct {
+				Content   string `json:"content"`
+				ToolCalls []struct {
+					ID       string `json:"id"`
+					Function struct {
+						Name      string `json:"name"`
+						Arguments string `json:"arguments"`
+					} `json:"function"`
+				} `json:"tool_calls"`
+			} `json:"message"`
+		} `json:"choices"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(apiResp.Choices) == 0 {
+		return nil, fmt.Errorf("no choices in response")
+	}
+
+	message := apiResp.Choices[0].Message
+	response := &ToolResponse{Content: message.Content}
+	for _, call := range message.ToolCalls {
+		args := map[string]interface{}{}
+		if call.Function.Arguments != "" {
+			if err := json.Unmarshal([]byte(call.Function.Arguments), &args); err != nil {
+				return nil, fmt.Errorf("failed to parse arguments for tool %s: %w", call.Function.Name, err)
+			}
+		}
+		response.ToolCalls = append(response.ToolCalls, ToolCall{
+			ID:        call.ID,
+			Name:      call.Function.Name,
+			Arguments: args,
+		})
+	}
+	return response, nil
+}
+
+// ChatWithTools routes a tool-calling conversation through the default
+// provider. Providers implementing ToolCapable use native function
+// calling; others get a prompted JSON protocol parsed from the reply.
+func (pm *ProviderManager) ChatWithTools(ctx context.Context, messages []Message, tools []ToolDefinition, opts GenerateOptions) (*ToolResponse, error) {
+	pm.mu.RLock()
+	name := pm.defaultProvider
+	provider := pm.providers[name]
+	pm.mu.RUnlock()
+
+	if provider == nil || !provider.Available() {
+		return nil, errors.New("no LLM providers available")
+	}
+
+	if toolCapable, ok := provider.(ToolCapable); ok {
+		return toolCapable.ChatWithTools(ctx, messages, tools, opts)
+	}
+	return promptedToolCall(ctx, provider, messages, tools, opts)
+}
+
+// promptedToolCall emulates function calling for providers without
+// native support: the tool catalog goes into the system prompt and a
+// JSON reply convention is parsed out of the response
+func promptedToolCall(ctx context.Context, provider LLMProvider, messages []Message, tools []ToolDefinition, opts GenerateOptions) (*ToolResponse, error) {
+	catalog := "You can call tools. Available tools:\n"
+	for _, tool := range tools {
+		schema, _ := json.Marshal(tool.Parameters)
+		catalog += fmt.Sprintf("- %s: %s (arguments schema: %s)\n", tool.Name, tool.Description, string(schema))
+	}
+	catalog += `To call a tool, reply with ONLY a JSON object: {"tool": "<name>", "arguments": {...}}. Otherwise reply normally.`
+
+	if opts.SystemPrompt != "" {
+		opts.SystemPrompt += "\n\n" + catalog
+	} else {
+		opts.SystemPrompt = catalog
+	}
+
+	prompt, opts := flattenMessages(messages, opts)
+	reply, err := provider.Generate(ctx, prompt, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if call, ok := parsePromptedCall(reply); ok {
+		return &ToolResponse{ToolCalls: []ToolCall{call}}, nil
+	}
+	return &ToolResponse{Content: reply}, nil
+}
+
+// parsePromptedCall extracts a {"tool": ..., "arguments": ...} object
+func parsePromptedCall(reply string) (ToolCall, bool) {
+	trimmed := strings.TrimSpace(reply)
+	start := strings.Index(trimmed, "{")
+	end := strings.LastIndex(trimmed, "}")
+	if start < 0 || end <= start {
+		return ToolCall{}, false
+	}
+
+	var parsed struct {
+		Tool      string                 `json:"tool"`
+		Arguments map[string]interface{} `json:"arguments"`
+	}
+	if err := json.Unmarshal([]byte(trimmed[start:end+1]), &parsed); err != nil || parsed.Tool == "" {
+		return ToolCall{}, false
+	}
+	if parsed.Arguments == nil {
+		parsed.Arguments = map[string]interface{}{}
+	}
+	return ToolCall{Name: parsed.Tool, Arguments: parsed.Arguments}, true
+}
@@ -0,0 +1,144 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Detailed usage metrics: request/error counts alone cannot explain a
+// spend spike or a latency regression. Every generation also records
+// tokens in/out, a latency histogram bucket, and an error category
+// per provider, all exposed through GetUsageMetrics — a flat map the
+// telemetry exporter flattens into Prometheus gauges.
+
+// latencyBuckets are the histogram upper bounds
+var latencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// providerUsage accumulates detailed figures for one provider
+type providerUsage struct {
+	tokensIn  uint64
+	tokensOut uint64
+	// histogram[i] counts requests at or under latencyBuckets[i];
+	// the final slot counts everything slower
+	histogram [9]uint64
+	// errorCategories: auth, rate_limit, server, timeout, network, other
+	errorCategories map[string]uint64
+}
+
+// usageTracker holds per-provider usage state
+type usageTracker struct {
+	mu        sync.Mutex
+	providers map[string]*providerUsage
+}
+
+// recordUsageMetrics accounts one completed generation attempt
+func (pm *ProviderManager) recordUsageMetrics(name, prompt, result string, latency time.Duration, err error) {
+	pm.mu.Lock()
+	if pm.usage == nil {
+		pm.usage = &usageTracker{providers: make(map[string]*providerUsage)}
+	}
+	tracker := pm.usage
+	pm.mu.Unlock()
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	usage, ok := tracker.providers[name]
+	if !ok {
+		usage = &providerUsage{errorCategories: make(map[string]uint64)}
+		tracker.providers[name] = usage
+	}
+
+	usage.tokensIn += uint64(EstimateTokens(prompt))
+	if err == nil {
+		usage.tokensOut += uint64(EstimateTokens(result))
+	}
+
+	bucket := len(latencyBuckets)
+	for i, bound := range latencyBuckets {
+		if latency <= bound {
+			bucket = i
+			break
+		}
+	}
+	usage.histogram[bucket]++
+
+	if err != nil {
+		usage.errorCategories[categorizeError(err)]++
+	}
+}
+
+// categorizeError maps an error onto a stable category label
+func categorizeError(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "timeout"
+	}
+	if errors.Is(err, context.Canceled) {
+		return "canceled"
+	}
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "status 401"), strings.Contains(msg, "status 403"):
+		return "auth"
+	case strings.Contains(msg, "status 429"):
+		return "rate_limit"
+	case strings.Contains(msg, "status 5"):
+		return "server"
+	case strings.Contains(msg, "failed to send request"):
+		return "network"
+	}
+	return "other"
+}
+
+// GetUsageMetrics returns the detailed per-provider figures as a flat
+// map (provider_metric keys) so it can be registered directly as a
+// telemetry metric source alongside cost by model from the accountant
+func (pm *ProviderManager) GetUsageMetrics() map[string]interface{} {
+	pm.mu.RLock()
+	tracker := pm.usage
+	ctl := pm.budgetCtl
+	pm.mu.RUnlock()
+
+	metrics := map[string]interface{}{}
+
+	if tracker != nil {
+		tracker.mu.Lock()
+		for name, usage := range tracker.providers {
+			metrics[name+"_tokens_in"] = usage.tokensIn
+			metrics[name+"_tokens_out"] = usage.tokensOut
+			for i, bound := range latencyBuckets {
+				key := fmt.Sprintf("%s_latency_le_%dms", name, bound.Milliseconds())
+				metrics[key] = usage.histogram[i]
+			}
+			metrics[name+"_latency_le_inf"] = usage.histogram[len(latencyBuckets)]
+			for category, count := range usage.errorCategories {
+				metrics[name+"_errors_"+category] = count
+			}
+		}
+		tracker.mu.Unlock()
+	}
+
+	if ctl != nil {
+		report := ctl.accountant.Report()
+		for providerModel, totals := range report.ByProviderModel {
+			key := strings.ReplaceAll(providerModel, "/", "_")
+			metrics["cost_"+key] = totals.Cost
+		}
+		metrics["cost_total"] = report.TotalCost
+	}
+
+	return metrics
+}
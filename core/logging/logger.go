@@ -0,0 +1,91 @@
+package logging
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Field keys shared by the autonomous layers so log lines from
+// different subsystems can be correlated
+const (
+	KeySubsystem = "subsystem"
+	KeyCycleID   = "cycle_id"
+	KeyThoughtID = "thought_id"
+)
+
+// Config selects the level, destination, and format of the
+// autonomous layers' structured logs
+type Config struct {
+	// Level below which records are dropped (default: info)
+	Level slog.Level
+
+	// Output destination (default: stderr)
+	Output io.Writer
+
+	// Format is "json" or "text" (default: json)
+	Format string
+
+	// Silent disables all logging — library mode, for embedders that
+	// do their own log handling
+	Silent bool
+}
+
+var (
+	mu            sync.RWMutex
+	defaultLogger = newLogger(Config{})
+)
+
+// Configure replaces the process-wide logger used by the autonomous
+// layers. Safe to call before or after subsystems have started.
+func Configure(cfg Config) {
+	mu.Lock()
+	defer mu.Unlock()
+	defaultLogger = newLogger(cfg)
+}
+
+// Silence switches to library mode: nothing is logged until
+// Configure is called again
+func Silence() {
+	Configure(Config{Silent: true})
+}
+
+// L returns the current process-wide logger
+func L() *slog.Logger {
+	mu.RLock()
+	defer mu.RUnlock()
+	return defaultLogger
+}
+
+// ForSubsystem returns the current logger tagged with a subsystem
+// field, e.g. ForSubsystem("wake_rest") or ForSubsystem("echodream")
+func ForSubsystem(name string) *slog.Logger {
+	return L().With(KeySubsystem, name)
+}
+
+// newLogger builds a slog.Logger from the config, filling defaults
+func newLogger(cfg Config) *slog.Logger {
+	if cfg.Silent {
+		return slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{
+			Level: slog.Level(127),
+		}))
+	}
+
+	output := cfg.Output
+	if output == nil {
+		output = os.Stderr
+	}
+
+	opts := &slog.HandlerOptions{Level: cfg.Level}
+
+	var handler slog.Handler
+	if strings.EqualFold(cfg.Format, "text") {
+		handler = slog.NewTextHandler(output, opts)
+	} else {
+		handler = slog.NewJSONHandler(output, opts)
+	}
+
+	return slog.New(handler)
+}
@@ -0,0 +1,167 @@
+package memory
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Graph analytics: runaway growth and fragmentation are invisible from
+// node counts alone. Analyze computes per-type counts, the degree
+// distribution, connected components, and recent growth; the flat
+// GetMetrics view plugs into the telemetry exporter and the HTTP
+// handler serves the full report on the admin API.
+
+// GraphAnalytics is one analysis pass over the graph
+type GraphAnalytics struct {
+	Timestamp     time.Time `json:"timestamp"`
+	TotalNodes    int       `json:"total_nodes"`
+	TotalEdges    int       `json:"total_edges"`
+	TotalHyper    int       `json:"total_hyperedges"`
+	AverageDegree float64   `json:"average_degree"`
+
+	NodesByType map[string]int `json:"nodes_by_type"`
+	EdgesByType map[string]int `json:"edges_by_type"`
+
+	// DegreeDistribution counts nodes per total degree (in + out)
+	DegreeDistribution map[int]int `json:"degree_distribution"`
+	MaxDegree          int         `json:"max_degree"`
+
+	// Components treat edges and hyperedges as undirected
+	ConnectedComponents int `json:"connected_components"`
+	LargestComponent    int `json:"largest_component"`
+	IsolatedNodes       int `json:"isolated_nodes"`
+
+	// Growth over trailing windows, from node creation times
+	NodesLastHour int `json:"nodes_last_hour"`
+	NodesLastDay  int `json:"nodes_last_day"`
+}
+
+// Analyze computes a full analytics report over the current graph
+func (hg *HypergraphMemory) Analyze() *GraphAnalytics {
+	hg.mu.RLock()
+	defer hg.mu.RUnlock()
+
+	now := time.Now()
+	report := &GraphAnalytics{
+		Timestamp:          now,
+		TotalNodes:         len(hg.nodes),
+		TotalEdges:         len(hg.edges),
+		TotalHyper:         len(hg.hyperedges),
+		NodesByType:        make(map[string]int),
+		EdgesByType:        make(map[string]int),
+		DegreeDistribution: make(map[int]int),
+	}
+
+	for _, node := range hg.nodes {
+		report.NodesByType[string(node.Type)]++
+		if now.Sub(node.CreatedAt) <= time.Hour {
+			report.NodesLastHour++
+		}
+		if now.Sub(node.CreatedAt) <= 24*time.Hour {
+			report.NodesLastDay++
+		}
+	}
+	for _, edge := range hg.edges {
+		report.EdgesByType[string(edge.Type)]++
+	}
+
+	totalDegree := 0
+	for nodeID := range hg.nodes {
+		degree := len(hg.outgoing[nodeID]) + len(hg.incoming[nodeID])
+		report.DegreeDistribution[degree]++
+		totalDegree += degree
+		if degree > report.MaxDegree {
+			report.MaxDegree = degree
+		}
+		if degree == 0 {
+			report.IsolatedNodes++
+		}
+	}
+	if len(hg.nodes) > 0 {
+		report.AverageDegree = float64(totalDegree) / float64(len(hg.nodes))
+	}
+
+	report.ConnectedComponents, report.LargestComponent = hg.componentsLocked()
+	return report
+}
+
+// componentsLocked counts connected components via union-find,
+// treating edges and hyperedge memberships as undirected links
+func (hg *HypergraphMemory) componentsLocked() (int, int) {
+	parent := make(map[string]string, len(hg.nodes))
+	for nodeID := range hg.nodes {
+		parent[nodeID] = nodeID
+	}
+
+	var find func(string) string
+	find = func(id string) string {
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+	union := func(a, b string) {
+		rootA, rootB := find(a), find(b)
+		if rootA != rootB {
+			parent[rootA] = rootB
+		}
+	}
+
+	for _, edge := range hg.edges {
+		union(edge.SourceID, edge.TargetID)
+	}
+	for _, hyperedge := range hg.hyperedges {
+		for i := 1; i < len(hyperedge.NodeIDs); i++ {
+			union(hyperedge.NodeIDs[0], hyperedge.NodeIDs[i])
+		}
+	}
+
+	sizes := make(map[string]int)
+	for nodeID := range hg.nodes {
+		sizes[find(nodeID)]++
+	}
+
+	largest := 0
+	for _, size := range sizes {
+		if size > largest {
+			largest = size
+		}
+	}
+	return len(sizes), largest
+}
+
+// GetMetrics returns the analytics as a flat map suitable for the
+// telemetry exporter
+func (hg *HypergraphMemory) GetMetrics() map[string]interface{} {
+	report := hg.Analyze()
+
+	metrics := map[string]interface{}{
+		"node_count":           report.TotalNodes,
+		"edge_count":           report.TotalEdges,
+		"hyperedge_count":      report.TotalHyper,
+		"average_degree":       report.AverageDegree,
+		"max_degree":           report.MaxDegree,
+		"connected_components": report.ConnectedComponents,
+		"largest_component":    report.LargestComponent,
+		"isolated_nodes":       report.IsolatedNodes,
+		"nodes_last_hour":      report.NodesLastHour,
+		"nodes_last_day":       report.NodesLastDay,
+	}
+	for nodeType, count := range report.NodesByType {
+		metrics["nodes_"+nodeType] = count
+	}
+	for edgeType, count := range report.EdgesByType {
+		metrics["edges_"+edgeType] = count
+	}
+	return metrics
+}
+
+// AnalyticsHandler serves the full analytics report as JSON for the
+// admin API
+func (hg *HypergraphMemory) AnalyticsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(hg.Analyze())
+	})
+}
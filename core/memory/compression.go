@@ -0,0 +1,246 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LLM-backed memory compression: clusters of old, low-salience
+// episodic nodes are summarized into a single semantic node that
+// preserves the gist while the originals are removed. Provenance (the
+// source IDs and their time span) rides on the summary node's
+// metadata. Runs during rest cycles under a token budget so one pass
+// can't burn the day's spend.
+
+// SummarizeFunc produces a summary for a block of memory contents.
+// Callers typically adapt an llm.ProviderManager; the indirection
+// keeps the memory layer free of provider dependencies.
+type SummarizeFunc func(ctx context.Context, prompt string, maxTokens int) (string, error)
+
+// CompressionConfig controls which memories are eligible and how much
+// the pass may spend
+type CompressionConfig struct {
+	// MinAge excludes recent memories; default 7 days
+	MinAge time.Duration
+	// MaxImportance excludes salient memories; default 0.4
+	MaxImportance float64
+	// MinClusterSize is the smallest group worth summarizing; default 3
+	MinClusterSize int
+	// TokenBudget caps estimated prompt+completion tokens per pass;
+	// default 4000
+	TokenBudget int
+	// SummaryTokens caps each summary's length; default 200
+	SummaryTokens int
+}
+
+func (config *CompressionConfig) applyDefaults() {
+	if config.MinAge <= 0 {
+		config.MinAge = 7 * 24 * time.Hour
+	}
+	if config.MaxImportance <= 0 {
+		config.MaxImportance = 0.4
+	}
+	if config.MinClusterSize <= 0 {
+		config.MinClusterSize = 3
+	}
+	if config.TokenBudget <= 0 {
+		config.TokenBudget = 4000
+	}
+	if config.SummaryTokens <= 0 {
+		config.SummaryTokens = 200
+	}
+}
+
+// CompressMemories summarizes clusters of old low-salience episodic
+// nodes into semantic nodes, removing the originals. Returns the
+// number of summary nodes created.
+func (hg *HypergraphMemory) CompressMemories(ctx context.Context, summarize SummarizeFunc, config CompressionConfig) (int, error) {
+	if summarize == nil {
+		return 0, fmt.Errorf("summarize function required")
+	}
+	config.applyDefaults()
+
+	clusters := hg.compressionClusters(config)
+	if len(clusters) == 0 {
+		return 0, nil
+	}
+
+	budget := config.TokenBudget
+	created := 0
+	for _, cluster := range clusters {
+		prompt := compressionPrompt(cluster)
+		cost := estimatePromptTokens(prompt) + config.SummaryTokens
+		if cost > budget {
+			break
+		}
+		budget -= cost
+
+		summary, err := summarize(ctx, prompt, config.SummaryTokens)
+		if err != nil {
+			return created, fmt.Errorf("summarization failed: %w", err)
+		}
+		summary = strings.TrimSpace(summary)
+		if summary == "" {
+			continue
+		}
+
+		if err := hg.installSummary(summary, cluster); err != nil {
+			return created, err
+		}
+		created++
+	}
+
+	if created > 0 {
+		fmt.Printf("🗜️ Hypergraph: compressed %d cluster(s) into semantic summaries\n", created)
+	}
+	return created, nil
+}
+
+// compressionClusters groups eligible episodic nodes by their first
+// tag (falling back to node type), oldest clusters first
+func (hg *HypergraphMemory) compressionClusters(config CompressionConfig) [][]*MemoryNode {
+	hg.mu.RLock()
+	defer hg.mu.RUnlock()
+
+	cutoff := time.Now().Add(-config.MinAge)
+	groups := make(map[string][]*MemoryNode)
+	for _, node := range hg.nodes {
+		if !episodicTypes[node.Type] || isPinned(node) {
+			continue
+		}
+		if node.Importance >= config.MaxImportance || node.CreatedAt.After(cutoff) {
+			continue
+		}
+		if node.Metadata != nil && node.Metadata["source"] == "memory_compression" {
+			continue
+		}
+
+		key := string(node.Type)
+		if tags := episodeTagList(node); len(tags) > 0 {
+			key = tags[0]
+		}
+		groups[key] = append(groups[key], node)
+	}
+
+	clusters := make([][]*MemoryNode, 0, len(groups))
+	for _, cluster := range groups {
+		if len(cluster) < config.MinClusterSize {
+			continue
+		}
+		sort.Slice(cluster, func(i, j int) bool {
+			return cluster[i].CreatedAt.Before(cluster[j].CreatedAt)
+		})
+		clusters = append(clusters, cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i][0].CreatedAt.Before(clusters[j][0].CreatedAt)
+	})
+	return clusters
+}
+
+// installSummary adds the semantic node and removes the originals
+func (hg *HypergraphMemory) installSummary(summary string, cluster []*MemoryNode) error {
+	sourceIDs := make([]string, 0, len(cluster))
+	importance := 0.0
+	for _, node := range cluster {
+		sourceIDs = append(sourceIDs, node.ID)
+		if node.Importance > importance {
+			importance = node.Importance
+		}
+	}
+
+	summaryNode := &MemoryNode{
+		Type:       NodeConcept,
+		Content:    summary,
+		Importance: importance,
+		Metadata: map[string]interface{}{
+			"source":           "memory_compression",
+			"source_ids":       sourceIDs,
+			"compressed_count": len(cluster),
+			"span_start":       cluster[0].CreatedAt.Format(time.RFC3339),
+			"span_end":         cluster[len(cluster)-1].CreatedAt.Format(time.RFC3339),
+		},
+	}
+	if err := hg.AddNode(summaryNode); err != nil {
+		return fmt.Errorf("failed to store summary: %w", err)
+	}
+
+	// Rewire surviving neighbors of the originals onto the summary so
+	// the graph stays connected, then drop the originals
+	neighborIDs := make(map[string]bool)
+	original := make(map[string]bool, len(cluster))
+	for _, node := range cluster {
+		original[node.ID] = true
+	}
+	for _, node := range cluster {
+		for _, edge := range hg.GetOutgoingEdges(node.ID) {
+			if !original[edge.TargetID] {
+				neighborIDs[edge.TargetID] = true
+			}
+		}
+		for _, edge := range hg.GetIncomingEdges(node.ID) {
+			if !original[edge.SourceID] {
+				neighborIDs[edge.SourceID] = true
+			}
+		}
+	}
+
+	for _, node := range cluster {
+		if err := hg.RemoveNode(node.ID); err != nil {
+			return fmt.Errorf("failed to remove compressed node %s: %w", node.ID, err)
+		}
+	}
+	for neighborID := range neighborIDs {
+		edge := &MemoryEdge{
+			SourceID: summaryNode.ID,
+			TargetID: neighborID,
+			Type:     EdgeSimilarTo,
+			Weight:   0.5,
+			Metadata: map[string]interface{}{"source": "memory_compression"},
+		}
+		if err := hg.AddEdge(edge); err != nil {
+			continue
+		}
+	}
+	return nil
+}
+
+// compressionPrompt renders one cluster for summarization
+func compressionPrompt(cluster []*MemoryNode) string {
+	var sb strings.Builder
+	sb.WriteString("Summarize the following related memories into one concise paragraph ")
+	sb.WriteString("that preserves the key facts, people, and outcomes. Reply with only the summary.\n\n")
+	for i, node := range cluster {
+		sb.WriteString(fmt.Sprintf("%d. [%s] %s\n", i+1, node.CreatedAt.Format("2006-01-02"), node.Content))
+	}
+	return sb.String()
+}
+
+// estimatePromptTokens mirrors the provider layer's rough chars/4
+// heuristic without importing it
+func estimatePromptTokens(text string) int {
+	return len(text)/4 + 1
+}
+
+// episodeTagList pulls the tag list from a node's metadata
+func episodeTagList(node *MemoryNode) []string {
+	if node.Metadata == nil {
+		return nil
+	}
+	switch raw := node.Metadata["tags"].(type) {
+	case []string:
+		return raw
+	case []interface{}:
+		tags := make([]string, 0, len(raw))
+		for _, entry := range raw {
+			if tag, ok := entry.(string); ok {
+				tags = append(tags, tag)
+			}
+		}
+		return tags
+	}
+	return nil
+}
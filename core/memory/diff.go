@@ -0,0 +1,319 @@
+package memory
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Diff and merge over graph snapshots: two echoself instances (or a
+// fork and its parent) exchange memories by diffing against a common
+// base snapshot and merging the results. Conflicts — both sides
+// changed the same node or edge — are resolved by policy and reported
+// so callers can audit what was kept.
+
+// ConflictPolicy selects the winner when both sides changed the same
+// entry
+type ConflictPolicy string
+
+const (
+	// PreferOurs keeps the local change
+	PreferOurs ConflictPolicy = "ours"
+	// PreferTheirs keeps the remote change
+	PreferTheirs ConflictPolicy = "theirs"
+	// PreferNewest keeps whichever side updated most recently
+	PreferNewest ConflictPolicy = "newest"
+)
+
+// GraphDiff describes how snapshot b differs from snapshot a
+type GraphDiff struct {
+	AddedNodes        []*MemoryNode `json:"added_nodes"`
+	RemovedNodes      []*MemoryNode `json:"removed_nodes"`
+	ModifiedNodes     []*MemoryNode `json:"modified_nodes"` // b's version
+	AddedEdges        []*MemoryEdge `json:"added_edges"`
+	RemovedEdges      []*MemoryEdge `json:"removed_edges"`
+	ModifiedEdges     []*MemoryEdge `json:"modified_edges"` // b's version
+	AddedHyperEdges   []*HyperEdge  `json:"added_hyperedges"`
+	RemovedHyperEdges []*HyperEdge  `json:"removed_hyperedges"`
+}
+
+// Empty reports whether the diff contains no changes
+func (d *GraphDiff) Empty() bool {
+	return len(d.AddedNodes) == 0 && len(d.RemovedNodes) == 0 && len(d.ModifiedNodes) == 0 &&
+		len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0 && len(d.ModifiedEdges) == 0 &&
+		len(d.AddedHyperEdges) == 0 && len(d.RemovedHyperEdges) == 0
+}
+
+// MergeConflict records one contested entry and how it was resolved
+type MergeConflict struct {
+	Kind       string `json:"kind"` // "node" or "edge"
+	ID         string `json:"id"`
+	Resolution string `json:"resolution"` // "ours" or "theirs"
+}
+
+// Diff computes the changes that turn snapshot a into snapshot b
+func Diff(a, b *GraphSnapshot) *GraphDiff {
+	diff := &GraphDiff{}
+
+	aNodes := nodesByID(a)
+	bNodes := nodesByID(b)
+	for id, bNode := range bNodes {
+		aNode, existed := aNodes[id]
+		if !existed {
+			diff.AddedNodes = append(diff.AddedNodes, bNode)
+		} else if nodeChanged(aNode, bNode) {
+			diff.ModifiedNodes = append(diff.ModifiedNodes, bNode)
+		}
+	}
+	for id, aNode := range aNodes {
+		if _, exists := bNodes[id]; !exists {
+			diff.RemovedNodes = append(diff.RemovedNodes, aNode)
+		}
+	}
+
+	aEdges := edgesByID(a)
+	bEdges := edgesByID(b)
+	for id, bEdge := range bEdges {
+		aEdge, existed := aEdges[id]
+		if !existed {
+			diff.AddedEdges = append(diff.AddedEdges, bEdge)
+		} else if edgeChanged(aEdge, bEdge) {
+			diff.ModifiedEdges = append(diff.ModifiedEdges, bEdge)
+		}
+	}
+	for id, aEdge := range aEdges {
+		if _, exists := bEdges[id]; !exists {
+			diff.RemovedEdges = append(diff.RemovedEdges, aEdge)
+		}
+	}
+
+	aHyper := hyperEdgesByID(a)
+	bHyper := hyperEdgesByID(b)
+	for id, bEdge := range bHyper {
+		if _, exists := aHyper[id]; !exists {
+			diff.AddedHyperEdges = append(diff.AddedHyperEdges, bEdge)
+		}
+	}
+	for id, aEdge := range aHyper {
+		if _, exists := bHyper[id]; !exists {
+			diff.RemovedHyperEdges = append(diff.RemovedHyperEdges, aEdge)
+		}
+	}
+
+	return diff
+}
+
+// Merge reconciles two snapshots that diverged from a common base.
+// Non-conflicting changes from both sides are kept; contested entries
+// are resolved by policy. Deletion on one side loses to modification
+// on the other (a memory someone still cares about survives).
+func Merge(base, ours, theirs *GraphSnapshot, policy ConflictPolicy) (*GraphSnapshot, []MergeConflict, error) {
+	if base == nil || ours == nil || theirs == nil {
+		return nil, nil, fmt.Errorf("all three snapshots required")
+	}
+	switch policy {
+	case PreferOurs, PreferTheirs, PreferNewest:
+	default:
+		return nil, nil, fmt.Errorf("unknown conflict policy %q", policy)
+	}
+
+	conflicts := make([]MergeConflict, 0)
+	baseNodes := nodesByID(base)
+	ourNodes := nodesByID(ours)
+	theirNodes := nodesByID(theirs)
+
+	mergedNodes := make(map[string]*MemoryNode)
+	for id := range unionKeys(ourNodes, theirNodes) {
+		ourNode, inOurs := ourNodes[id]
+		theirNode, inTheirs := theirNodes[id]
+		baseNode, inBase := baseNodes[id]
+
+		switch {
+		case inOurs && !inTheirs:
+			// Theirs deleted it, or we added it
+			if !inBase || nodeChanged(baseNode, ourNode) {
+				mergedNodes[id] = ourNode
+			}
+		case inTheirs && !inOurs:
+			if !inBase || nodeChanged(baseNode, theirNode) {
+				mergedNodes[id] = theirNode
+			}
+		default: // present on both sides
+			ourChanged := !inBase || nodeChanged(baseNode, ourNode)
+			theirChanged := !inBase || nodeChanged(baseNode, theirNode)
+			if ourChanged && theirChanged && nodeChanged(ourNode, theirNode) {
+				winner, resolution := resolveNode(ourNode, theirNode, policy)
+				mergedNodes[id] = winner
+				conflicts = append(conflicts, MergeConflict{Kind: "node", ID: id, Resolution: resolution})
+			} else if theirChanged {
+				mergedNodes[id] = theirNode
+			} else {
+				mergedNodes[id] = ourNode
+			}
+		}
+	}
+
+	baseEdges := edgesByID(base)
+	ourEdges := edgesByID(ours)
+	theirEdges := edgesByID(theirs)
+
+	mergedEdges := make(map[string]*MemoryEdge)
+	for id := range unionKeys(ourEdges, theirEdges) {
+		ourEdge, inOurs := ourEdges[id]
+		theirEdge, inTheirs := theirEdges[id]
+		baseEdge, inBase := baseEdges[id]
+
+		switch {
+		case inOurs && !inTheirs:
+			if !inBase || edgeChanged(baseEdge, ourEdge) {
+				mergedEdges[id] = ourEdge
+			}
+		case inTheirs && !inOurs:
+			if !inBase || edgeChanged(baseEdge, theirEdge) {
+				mergedEdges[id] = theirEdge
+			}
+		default:
+			ourChanged := !inBase || edgeChanged(baseEdge, ourEdge)
+			theirChanged := !inBase || edgeChanged(baseEdge, theirEdge)
+			if ourChanged && theirChanged && edgeChanged(ourEdge, theirEdge) {
+				winner := ourEdge
+				resolution := "ours"
+				if policy == PreferTheirs {
+					winner, resolution = theirEdge, "theirs"
+				}
+				mergedEdges[id] = winner
+				conflicts = append(conflicts, MergeConflict{Kind: "edge", ID: id, Resolution: resolution})
+			} else if theirChanged {
+				mergedEdges[id] = theirEdge
+			} else {
+				mergedEdges[id] = ourEdge
+			}
+		}
+	}
+
+	// Hyperedges: keep the union of both sides, minus base entries
+	// deleted by either
+	ourHyper := hyperEdgesByID(ours)
+	theirHyper := hyperEdgesByID(theirs)
+	mergedHyper := make(map[string]*HyperEdge)
+	for id, hyperedge := range ourHyper {
+		if _, inTheirs := theirHyper[id]; inTheirs || !hasHyperEdge(base, id) {
+			mergedHyper[id] = hyperedge
+		}
+	}
+	for id, hyperedge := range theirHyper {
+		if _, kept := mergedHyper[id]; kept {
+			continue
+		}
+		if !hasHyperEdge(base, id) {
+			mergedHyper[id] = hyperedge
+		}
+	}
+
+	merged := &GraphSnapshot{
+		Nodes:      make([]*MemoryNode, 0, len(mergedNodes)),
+		Edges:      make([]*MemoryEdge, 0, len(mergedEdges)),
+		HyperEdges: make([]*HyperEdge, 0, len(mergedHyper)),
+	}
+	for _, node := range mergedNodes {
+		merged.Nodes = append(merged.Nodes, node)
+	}
+	// Drop edges whose endpoints did not survive the node merge
+	for _, edge := range mergedEdges {
+		if mergedNodes[edge.SourceID] != nil && mergedNodes[edge.TargetID] != nil {
+			merged.Edges = append(merged.Edges, edge)
+		}
+	}
+	for _, hyperedge := range mergedHyper {
+		members := make([]string, 0, len(hyperedge.NodeIDs))
+		for _, nodeID := range hyperedge.NodeIDs {
+			if mergedNodes[nodeID] != nil {
+				members = append(members, nodeID)
+			}
+		}
+		if len(members) > 0 {
+			kept := *hyperedge
+			kept.NodeIDs = members
+			merged.HyperEdges = append(merged.HyperEdges, &kept)
+		}
+	}
+
+	return merged, conflicts, nil
+}
+
+// resolveNode picks the winning node version under a policy
+func resolveNode(ours, theirs *MemoryNode, policy ConflictPolicy) (*MemoryNode, string) {
+	switch policy {
+	case PreferTheirs:
+		return theirs, "theirs"
+	case PreferNewest:
+		if theirs.UpdatedAt.After(ours.UpdatedAt) {
+			return theirs, "theirs"
+		}
+		return ours, "ours"
+	default:
+		return ours, "ours"
+	}
+}
+
+// nodeChanged compares the meaningful node fields (timestamps alone
+// don't count)
+func nodeChanged(a, b *MemoryNode) bool {
+	return a.Type != b.Type ||
+		a.Content != b.Content ||
+		a.Importance != b.Importance ||
+		!reflect.DeepEqual(a.Metadata, b.Metadata)
+}
+
+// edgeChanged compares the meaningful edge fields
+func edgeChanged(a, b *MemoryEdge) bool {
+	return a.SourceID != b.SourceID ||
+		a.TargetID != b.TargetID ||
+		a.Type != b.Type ||
+		a.Weight != b.Weight ||
+		!reflect.DeepEqual(a.Metadata, b.Metadata)
+}
+
+func nodesByID(snapshot *GraphSnapshot) map[string]*MemoryNode {
+	nodes := make(map[string]*MemoryNode, len(snapshot.Nodes))
+	for _, node := range snapshot.Nodes {
+		nodes[node.ID] = node
+	}
+	return nodes
+}
+
+func edgesByID(snapshot *GraphSnapshot) map[string]*MemoryEdge {
+	edges := make(map[string]*MemoryEdge, len(snapshot.Edges))
+	for _, edge := range snapshot.Edges {
+		edges[edge.ID] = edge
+	}
+	return edges
+}
+
+func hyperEdgesByID(snapshot *GraphSnapshot) map[string]*HyperEdge {
+	hyperedges := make(map[string]*HyperEdge, len(snapshot.HyperEdges))
+	for _, hyperedge := range snapshot.HyperEdges {
+		hyperedges[hyperedge.ID] = hyperedge
+	}
+	return hyperedges
+}
+
+func hasHyperEdge(snapshot *GraphSnapshot, id string) bool {
+	for _, hyperedge := range snapshot.HyperEdges {
+		if hyperedge.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// unionKeys merges the key sets of two same-key-type maps
+func unionKeys[V any](a, b map[string]V) map[string]struct{} {
+	keys := make(map[string]struct{}, len(a)+len(b))
+	for k := range a {
+		keys[k] = struct{}{}
+	}
+	for k := range b {
+		keys[k] = struct{}{}
+	}
+	return keys
+}
@@ -0,0 +1,236 @@
+package memory
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Edge-type schemas: relations like "caused-by", "analogous-to", and
+// "learned-during" only stay queryable if every instance has the
+// expected shape. A registry declares the expected arity, member node
+// types, and required attributes per relation; inserts are validated
+// against it once enabled. Unregistered relation types pass unless
+// strict mode is on.
+
+// HyperEdgeSchema constrains one hyperedge relation type
+type HyperEdgeSchema struct {
+	Type string `json:"type"`
+	// MinArity/MaxArity bound the member count; MaxArity 0 means
+	// unbounded
+	MinArity int `json:"min_arity"`
+	MaxArity int `json:"max_arity"`
+	// MemberTypes restricts member node types; empty allows any
+	MemberTypes []NodeType `json:"member_types,omitempty"`
+	// RequiredAttributes must be present in the edge metadata
+	RequiredAttributes []string `json:"required_attributes,omitempty"`
+}
+
+// EdgeSchema constrains one binary edge relation type
+type EdgeSchema struct {
+	Type EdgeType `json:"type"`
+	// SourceTypes/TargetTypes restrict endpoint node types; empty
+	// allows any
+	SourceTypes []NodeType `json:"source_types,omitempty"`
+	TargetTypes []NodeType `json:"target_types,omitempty"`
+	// RequiredAttributes must be present in the edge metadata
+	RequiredAttributes []string `json:"required_attributes,omitempty"`
+}
+
+// EdgeSchemaRegistry holds the declared relation schemas
+type EdgeSchemaRegistry struct {
+	mu           sync.RWMutex
+	hyperSchemas map[string]*HyperEdgeSchema
+	edgeSchemas  map[EdgeType]*EdgeSchema
+	// strict rejects relation types without a registered schema
+	strict     bool
+	violations uint64
+}
+
+// NewEdgeSchemaRegistry creates an empty registry
+func NewEdgeSchemaRegistry(strict bool) *EdgeSchemaRegistry {
+	return &EdgeSchemaRegistry{
+		hyperSchemas: make(map[string]*HyperEdgeSchema),
+		edgeSchemas:  make(map[EdgeType]*EdgeSchema),
+		strict:       strict,
+	}
+}
+
+// RegisterHyperEdgeSchema declares the schema for one hyperedge type
+func (reg *EdgeSchemaRegistry) RegisterHyperEdgeSchema(schema HyperEdgeSchema) error {
+	if schema.Type == "" {
+		return fmt.Errorf("schema type required")
+	}
+	if schema.MaxArity > 0 && schema.MaxArity < schema.MinArity {
+		return fmt.Errorf("max arity %d below min arity %d", schema.MaxArity, schema.MinArity)
+	}
+
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.hyperSchemas[schema.Type] = &schema
+	return nil
+}
+
+// RegisterEdgeSchema declares the schema for one binary edge type
+func (reg *EdgeSchemaRegistry) RegisterEdgeSchema(schema EdgeSchema) error {
+	if schema.Type == "" {
+		return fmt.Errorf("schema type required")
+	}
+
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.edgeSchemas[schema.Type] = &schema
+	return nil
+}
+
+// Violations returns the number of rejected inserts
+func (reg *EdgeSchemaRegistry) Violations() uint64 {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+	return reg.violations
+}
+
+// validateHyperEdge checks one hyperedge against the registry; the
+// caller supplies a node-type lookup since it holds the graph lock
+func (reg *EdgeSchemaRegistry) validateHyperEdge(hyperedge *HyperEdge, nodeType func(string) (NodeType, bool)) error {
+	reg.mu.Lock()
+	schema, registered := reg.hyperSchemas[hyperedge.Type]
+	strict := reg.strict
+	if !registered && strict {
+		reg.violations++
+	}
+	reg.mu.Unlock()
+
+	if !registered {
+		if strict {
+			return fmt.Errorf("no schema registered for hyperedge type %q", hyperedge.Type)
+		}
+		return nil
+	}
+
+	if err := reg.checkHyperEdge(schema, hyperedge, nodeType); err != nil {
+		reg.mu.Lock()
+		reg.violations++
+		reg.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (reg *EdgeSchemaRegistry) checkHyperEdge(schema *HyperEdgeSchema, hyperedge *HyperEdge, nodeType func(string) (NodeType, bool)) error {
+	arity := len(hyperedge.NodeIDs)
+	if arity < schema.MinArity {
+		return fmt.Errorf("hyperedge type %q requires at least %d member(s), got %d",
+			schema.Type, schema.MinArity, arity)
+	}
+	if schema.MaxArity > 0 && arity > schema.MaxArity {
+		return fmt.Errorf("hyperedge type %q allows at most %d member(s), got %d",
+			schema.Type, schema.MaxArity, arity)
+	}
+
+	if len(schema.MemberTypes) > 0 {
+		allowed := make(map[NodeType]bool, len(schema.MemberTypes))
+		for _, t := range schema.MemberTypes {
+			allowed[t] = true
+		}
+		for _, nodeID := range hyperedge.NodeIDs {
+			memberType, ok := nodeType(nodeID)
+			if ok && !allowed[memberType] {
+				return fmt.Errorf("hyperedge type %q does not allow member node type %q (node %s)",
+					schema.Type, memberType, nodeID)
+			}
+		}
+	}
+
+	for _, attr := range schema.RequiredAttributes {
+		if hyperedge.Metadata == nil {
+			return fmt.Errorf("hyperedge type %q requires attribute %q", schema.Type, attr)
+		}
+		if _, ok := hyperedge.Metadata[attr]; !ok {
+			return fmt.Errorf("hyperedge type %q requires attribute %q", schema.Type, attr)
+		}
+	}
+	return nil
+}
+
+// validateEdge checks one binary edge against the registry
+func (reg *EdgeSchemaRegistry) validateEdge(edge *MemoryEdge, nodeType func(string) (NodeType, bool)) error {
+	reg.mu.Lock()
+	schema, registered := reg.edgeSchemas[edge.Type]
+	strict := reg.strict
+	if !registered && strict {
+		reg.violations++
+	}
+	reg.mu.Unlock()
+
+	if !registered {
+		if strict {
+			return fmt.Errorf("no schema registered for edge type %q", edge.Type)
+		}
+		return nil
+	}
+
+	if err := reg.checkEdge(schema, edge, nodeType); err != nil {
+		reg.mu.Lock()
+		reg.violations++
+		reg.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (reg *EdgeSchemaRegistry) checkEdge(schema *EdgeSchema, edge *MemoryEdge, nodeType func(string) (NodeType, bool)) error {
+	if len(schema.SourceTypes) > 0 {
+		if sourceType, ok := nodeType(edge.SourceID); ok && !containsNodeType(schema.SourceTypes, sourceType) {
+			return fmt.Errorf("edge type %q does not allow source node type %q", schema.Type, sourceType)
+		}
+	}
+	if len(schema.TargetTypes) > 0 {
+		if targetType, ok := nodeType(edge.TargetID); ok && !containsNodeType(schema.TargetTypes, targetType) {
+			return fmt.Errorf("edge type %q does not allow target node type %q", schema.Type, targetType)
+		}
+	}
+
+	for _, attr := range schema.RequiredAttributes {
+		if edge.Metadata == nil {
+			return fmt.Errorf("edge type %q requires attribute %q", schema.Type, attr)
+		}
+		if _, ok := edge.Metadata[attr]; !ok {
+			return fmt.Errorf("edge type %q requires attribute %q", schema.Type, attr)
+		}
+	}
+	return nil
+}
+
+func containsNodeType(types []NodeType, target NodeType) bool {
+	for _, t := range types {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
+
+// EnableEdgeSchemas attaches a schema registry to the graph; all
+// subsequent edge and hyperedge inserts are validated against it
+func (hg *HypergraphMemory) EnableEdgeSchemas(registry *EdgeSchemaRegistry) error {
+	if registry == nil {
+		return fmt.Errorf("registry required")
+	}
+
+	hg.mu.Lock()
+	defer hg.mu.Unlock()
+	if hg.edgeSchemas != nil {
+		return fmt.Errorf("edge schemas already enabled")
+	}
+	hg.edgeSchemas = registry
+	return nil
+}
+
+// nodeTypeLocked looks up a node's type; callers hold the lock
+func (hg *HypergraphMemory) nodeTypeLocked(nodeID string) (NodeType, bool) {
+	node, exists := hg.nodes[nodeID]
+	if !exists {
+		return "", false
+	}
+	return node.Type, true
+}
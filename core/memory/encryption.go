@@ -0,0 +1,266 @@
+package memory
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Encryption at rest: a long-running agent accumulates conversation
+// content that should not sit on disk in the clear. An Encryptor
+// wraps AES-GCM with a key from the environment (or any KMS the
+// caller fetches from); file helpers cover snapshot/journal writes and
+// an EncryptedGraphStore wrapper encrypts node and edge content
+// before it reaches any GraphPersistence backend.
+
+// encryptionEnvVar holds the key: 64 hex chars, base64 of 32 bytes,
+// or an arbitrary passphrase (hashed to a key)
+const encryptionEnvVar = "ECCO9_MEMORY_KEY"
+
+// encMagic prefixes every ciphertext so plaintext files from before
+// encryption was enabled remain readable
+var encMagic = []byte("E9E1")
+
+// Encryptor performs AES-GCM encryption with a fixed key
+type Encryptor struct {
+	aead cipher.AEAD
+}
+
+// NewEncryptor creates an encryptor from a 32-byte key
+func NewEncryptor(key []byte) (*Encryptor, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+	return &Encryptor{aead: aead}, nil
+}
+
+// NewEncryptorFromEnv reads the key from ECCO9_MEMORY_KEY. Hex and
+// base64 encodings of a 32-byte key are used directly; anything else
+// is treated as a passphrase and hashed.
+func NewEncryptorFromEnv() (*Encryptor, error) {
+	raw := os.Getenv(encryptionEnvVar)
+	if raw == "" {
+		return nil, fmt.Errorf("%s not set", encryptionEnvVar)
+	}
+
+	if decoded, err := hex.DecodeString(raw); err == nil && len(decoded) == 32 {
+		return NewEncryptor(decoded)
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(raw); err == nil && len(decoded) == 32 {
+		return NewEncryptor(decoded)
+	}
+	hashed := sha256.Sum256([]byte(raw))
+	return NewEncryptor(hashed[:])
+}
+
+// Encrypt seals plaintext with a random nonce, prefixed with the
+// format magic
+func (enc *Encryptor) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, enc.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	out := make([]byte, 0, len(encMagic)+len(nonce)+len(plaintext)+enc.aead.Overhead())
+	out = append(out, encMagic...)
+	out = append(out, nonce...)
+	return enc.aead.Seal(out, nonce, plaintext, nil), nil
+}
+
+// Decrypt opens a ciphertext produced by Encrypt
+func (enc *Encryptor) Decrypt(ciphertext []byte) ([]byte, error) {
+	if !IsEncrypted(ciphertext) {
+		return nil, fmt.Errorf("data is not encrypted")
+	}
+	body := ciphertext[len(encMagic):]
+	nonceSize := enc.aead.NonceSize()
+	if len(body) < nonceSize {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	plaintext, err := enc.aead.Open(nil, body[:nonceSize], body[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("decryption failed (wrong key?): %w", err)
+	}
+	return plaintext, nil
+}
+
+// IsEncrypted reports whether data carries the encryption magic
+func IsEncrypted(data []byte) bool {
+	return len(data) >= len(encMagic) && string(data[:len(encMagic)]) == string(encMagic)
+}
+
+// WriteEncryptedFile encrypts and writes data to path
+func (enc *Encryptor) WriteEncryptedFile(path string, data []byte) error {
+	ciphertext, err := enc.Encrypt(data)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, ciphertext, 0600); err != nil {
+		return fmt.Errorf("failed to write encrypted file: %w", err)
+	}
+	return nil
+}
+
+// ReadEncryptedFile reads path, decrypting if needed. Plaintext files
+// written before encryption was enabled pass through unchanged, so
+// enabling encryption never strands old state.
+func (enc *Encryptor) ReadEncryptedFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	return enc.Decrypt(data)
+}
+
+// ExportToFileEncrypted writes an encrypted JSON snapshot of the graph
+func (hg *HypergraphMemory) ExportToFileEncrypted(path string, enc *Encryptor) error {
+	if enc == nil {
+		return fmt.Errorf("encryptor required")
+	}
+	data, err := json.Marshal(SnapshotEnvelope{
+		SchemaVersion: SnapshotSchemaVersion,
+		ExportedAt:    time.Now(),
+		Graph:         hg.Snapshot(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	return enc.WriteEncryptedFile(path, data)
+}
+
+// ImportFromFileEncrypted loads a snapshot written by
+// ExportToFileEncrypted (or a plaintext export)
+func (hg *HypergraphMemory) ImportFromFileEncrypted(path string, enc *Encryptor) error {
+	if enc == nil {
+		return fmt.Errorf("encryptor required")
+	}
+	data, err := enc.ReadEncryptedFile(path)
+	if err != nil {
+		return err
+	}
+
+	var envelope SnapshotEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	if envelope.Graph == nil {
+		return fmt.Errorf("snapshot contains no graph")
+	}
+	return hg.RestoreSnapshot(envelope.Graph)
+}
+
+// EncryptedGraphStore wraps a GraphPersistence backend, encrypting
+// node and edge content (and metadata) before it is stored
+type EncryptedGraphStore struct {
+	inner GraphPersistence
+	enc   *Encryptor
+}
+
+// NewEncryptedGraphStore wraps a backend with content encryption
+func NewEncryptedGraphStore(inner GraphPersistence, enc *Encryptor) (*EncryptedGraphStore, error) {
+	if inner == nil {
+		return nil, fmt.Errorf("inner store required")
+	}
+	if enc == nil {
+		return nil, fmt.Errorf("encryptor required")
+	}
+	return &EncryptedGraphStore{inner: inner, enc: enc}, nil
+}
+
+// StoreNode implements GraphPersistence; the stored copy carries
+// encrypted content and metadata
+func (store *EncryptedGraphStore) StoreNode(node *MemoryNode) error {
+	sealed := *node
+	ciphertext, err := store.sealFields(node.Content, node.Metadata)
+	if err != nil {
+		return err
+	}
+	sealed.Content = ciphertext
+	sealed.Metadata = map[string]interface{}{"encrypted": true}
+	return store.inner.StoreNode(&sealed)
+}
+
+// StoreEdge implements GraphPersistence
+func (store *EncryptedGraphStore) StoreEdge(edge *MemoryEdge) error {
+	if len(edge.Metadata) == 0 {
+		return store.inner.StoreEdge(edge)
+	}
+
+	sealed := *edge
+	ciphertext, err := store.sealFields("", edge.Metadata)
+	if err != nil {
+		return err
+	}
+	sealed.Metadata = map[string]interface{}{"encrypted": true, "payload": ciphertext}
+	return store.inner.StoreEdge(&sealed)
+}
+
+// DecryptNode reverses StoreNode's sealing on a loaded node
+func (store *EncryptedGraphStore) DecryptNode(node *MemoryNode) error {
+	if node.Metadata == nil || node.Metadata["encrypted"] != true {
+		return nil
+	}
+	content, metadata, err := store.openFields(node.Content)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt node %s: %w", node.ID, err)
+	}
+	node.Content = content
+	node.Metadata = metadata
+	return nil
+}
+
+// sealFields packs content and metadata into one base64 ciphertext
+func (store *EncryptedGraphStore) sealFields(content string, metadata map[string]interface{}) (string, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"content":  content,
+		"metadata": metadata,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	ciphertext, err := store.enc.Encrypt(payload)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// openFields reverses sealFields
+func (store *EncryptedGraphStore) openFields(sealed string) (string, map[string]interface{}, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to decode payload: %w", err)
+	}
+	plaintext, err := store.enc.Decrypt(ciphertext)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var payload struct {
+		Content  string                 `json:"content"`
+		Metadata map[string]interface{} `json:"metadata"`
+	}
+	if err := json.Unmarshal(plaintext, &payload); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+	return payload.Content, payload.Metadata, nil
+}
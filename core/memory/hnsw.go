@@ -0,0 +1,441 @@
+package memory
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+)
+
+// HNSW (hierarchical navigable small world) index over node embeddings.
+// The brute-force scan in FindSimilarNodes is fine for a few thousand
+// nodes but not for a long-lived agent; the index keeps semantic
+// retrieval sub-millisecond as the graph grows, with incremental
+// insert and delete so it stays consistent with the hypergraph.
+
+// HNSWIndex is an approximate nearest neighbor index using cosine
+// similarity
+type HNSWIndex struct {
+	mu sync.RWMutex
+
+	// Tuning parameters
+	m              int     // max connections per node per layer
+	efConstruction int     // candidate list size during insert
+	efSearch       int     // candidate list size during search
+	levelFactor    float64 // 1/ln(m), controls layer assignment
+
+	// Graph structure
+	vectors    map[string][]float64
+	neighbors  map[string][][]string // node ID -> per-layer neighbor lists
+	levels     map[string]int
+	entryPoint string
+	maxLevel   int
+
+	rng *rand.Rand
+}
+
+// NewHNSWIndex creates an index with sensible defaults (m=16, ef=200)
+func NewHNSWIndex() *HNSWIndex {
+	return NewHNSWIndexWithParams(16, 200, 64)
+}
+
+// NewHNSWIndexWithParams creates an index with explicit tuning
+// parameters
+func NewHNSWIndexWithParams(m, efConstruction, efSearch int) *HNSWIndex {
+	if m < 2 {
+		m = 2
+	}
+	return &HNSWIndex{
+		m:              m,
+		efConstruction: efConstruction,
+		efSearch:       efSearch,
+		levelFactor:    1.0 / math.Log(float64(m)),
+		vectors:        make(map[string][]float64),
+		neighbors:      make(map[string][][]string),
+		levels:         make(map[string]int),
+		rng:            rand.New(rand.NewSource(42)),
+	}
+}
+
+// Insert adds or replaces a vector in the index
+func (idx *HNSWIndex) Insert(id string, vector []float64) error {
+	if id == "" {
+		return fmt.Errorf("vector ID required")
+	}
+	if len(vector) == 0 {
+		return fmt.Errorf("empty vector for %s", id)
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if _, exists := idx.vectors[id]; exists {
+		idx.removeLocked(id)
+	}
+
+	level := idx.randomLevel()
+	idx.vectors[id] = vector
+	idx.levels[id] = level
+	idx.neighbors[id] = make([][]string, level+1)
+	for l := 0; l <= level; l++ {
+		idx.neighbors[id][l] = make([]string, 0, idx.m)
+	}
+
+	if idx.entryPoint == "" {
+		idx.entryPoint = id
+		idx.maxLevel = level
+		return nil
+	}
+
+	// Descend from the top layer to the insertion level greedily
+	current := idx.entryPoint
+	for l := idx.maxLevel; l > level; l-- {
+		current = idx.greedyClosest(vector, current, l)
+	}
+
+	// Insert into each layer from the node's level down
+	startLevel := level
+	if startLevel > idx.maxLevel {
+		startLevel = idx.maxLevel
+	}
+	for l := startLevel; l >= 0; l-- {
+		candidates := idx.searchLayer(vector, current, idx.efConstruction, l)
+		selected := idx.selectNeighbors(vector, candidates, idx.m)
+
+		for _, neighbor := range selected {
+			idx.neighbors[id][l] = append(idx.neighbors[id][l], neighbor)
+			idx.neighbors[neighbor][l] = append(idx.neighbors[neighbor][l], id)
+			if len(idx.neighbors[neighbor][l]) > idx.m {
+				idx.neighbors[neighbor][l] = idx.selectNeighbors(
+					idx.vectors[neighbor], idx.neighbors[neighbor][l], idx.m)
+			}
+		}
+		if len(selected) > 0 {
+			current = selected[0]
+		}
+	}
+
+	if level > idx.maxLevel {
+		idx.maxLevel = level
+		idx.entryPoint = id
+	}
+	return nil
+}
+
+// Delete removes a vector from the index
+func (idx *HNSWIndex) Delete(id string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.removeLocked(id)
+}
+
+// removeLocked unlinks a node from every layer; callers hold the lock
+func (idx *HNSWIndex) removeLocked(id string) {
+	layers, exists := idx.neighbors[id]
+	if !exists {
+		return
+	}
+
+	for l, neighborList := range layers {
+		for _, neighbor := range neighborList {
+			idx.neighbors[neighbor][l] = removeString(idx.neighbors[neighbor][l], id)
+		}
+	}
+
+	delete(idx.vectors, id)
+	delete(idx.neighbors, id)
+	delete(idx.levels, id)
+
+	if idx.entryPoint == id {
+		idx.entryPoint = ""
+		idx.maxLevel = 0
+		for other, level := range idx.levels {
+			if idx.entryPoint == "" || level > idx.maxLevel {
+				idx.entryPoint = other
+				idx.maxLevel = level
+			}
+		}
+	}
+}
+
+// Search returns the IDs of the topK most similar vectors, best first
+func (idx *HNSWIndex) Search(query []float64, topK int) []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if idx.entryPoint == "" || topK <= 0 {
+		return nil
+	}
+
+	current := idx.entryPoint
+	for l := idx.maxLevel; l > 0; l-- {
+		current = idx.greedyClosest(query, current, l)
+	}
+
+	ef := idx.efSearch
+	if ef < topK {
+		ef = topK
+	}
+	candidates := idx.searchLayer(query, current, ef, 0)
+	if len(candidates) > topK {
+		candidates = candidates[:topK]
+	}
+	return candidates
+}
+
+// Size returns the number of indexed vectors
+func (idx *HNSWIndex) Size() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.vectors)
+}
+
+// randomLevel draws a layer assignment from the standard exponential
+// distribution
+func (idx *HNSWIndex) randomLevel() int {
+	level := int(-math.Log(idx.rng.Float64()) * idx.levelFactor)
+	if level > 16 {
+		level = 16
+	}
+	return level
+}
+
+// greedyClosest walks a layer toward the query until no neighbor
+// improves on the current node
+func (idx *HNSWIndex) greedyClosest(query []float64, start string, layer int) string {
+	current := start
+	currentSim := cosineSimilarity(query, idx.vectors[current])
+
+	for {
+		improved := false
+		for _, neighbor := range idx.layerNeighbors(current, layer) {
+			sim := cosineSimilarity(query, idx.vectors[neighbor])
+			if sim > currentSim {
+				current = neighbor
+				currentSim = sim
+				improved = true
+			}
+		}
+		if !improved {
+			return current
+		}
+	}
+}
+
+// searchLayer performs a beam search on one layer, returning up to ef
+// candidate IDs sorted best first
+func (idx *HNSWIndex) searchLayer(query []float64, start string, ef, layer int) []string {
+	visited := map[string]bool{start: true}
+
+	startSim := cosineSimilarity(query, idx.vectors[start])
+	candidates := &maxSimHeap{{id: start, sim: startSim}}
+	results := &simHeap{{id: start, sim: startSim}}
+	heap.Init(candidates)
+	heap.Init(results)
+
+	for candidates.Len() > 0 {
+		// Pop the best unexplored candidate; once even it falls below
+		// the worst kept result, nothing reachable can improve the set
+		best := heap.Pop(candidates).(simEntry)
+		worst := (*results)[0]
+		if results.Len() >= ef && best.sim < worst.sim {
+			break
+		}
+
+		for _, neighbor := range idx.layerNeighbors(best.id, layer) {
+			if visited[neighbor] {
+				continue
+			}
+			visited[neighbor] = true
+
+			sim := cosineSimilarity(query, idx.vectors[neighbor])
+			if results.Len() < ef || sim > (*results)[0].sim {
+				heap.Push(candidates, simEntry{id: neighbor, sim: sim})
+				heap.Push(results, simEntry{id: neighbor, sim: sim})
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+
+	// Drain the min-heap and reverse into best-first order
+	sorted := make([]string, results.Len())
+	for i := results.Len() - 1; i >= 0; i-- {
+		sorted[i] = heap.Pop(results).(simEntry).id
+	}
+	return sorted
+}
+
+// selectNeighbors keeps the max most similar candidates
+func (idx *HNSWIndex) selectNeighbors(vector []float64, candidates []string, max int) []string {
+	if len(candidates) <= max {
+		return append([]string{}, candidates...)
+	}
+
+	entries := make([]simEntry, 0, len(candidates))
+	for _, id := range candidates {
+		entries = append(entries, simEntry{id: id, sim: cosineSimilarity(vector, idx.vectors[id])})
+	}
+	for i := 0; i < max; i++ {
+		bestIdx := i
+		for j := i + 1; j < len(entries); j++ {
+			if entries[j].sim > entries[bestIdx].sim {
+				bestIdx = j
+			}
+		}
+		entries[i], entries[bestIdx] = entries[bestIdx], entries[i]
+	}
+
+	selected := make([]string, max)
+	for i := 0; i < max; i++ {
+		selected[i] = entries[i].id
+	}
+	return selected
+}
+
+// layerNeighbors returns a node's neighbor list on one layer, tolerant
+// of nodes below that layer
+func (idx *HNSWIndex) layerNeighbors(id string, layer int) []string {
+	layers := idx.neighbors[id]
+	if layer >= len(layers) {
+		return nil
+	}
+	return layers[layer]
+}
+
+// simEntry pairs a node ID with its similarity to the query
+type simEntry struct {
+	id  string
+	sim float64
+}
+
+// simHeap is a min-heap by similarity (worst result on top), used for
+// the result set so the worst entry is cheap to evict
+type simHeap []simEntry
+
+func (h simHeap) Len() int            { return len(h) }
+func (h simHeap) Less(i, j int) bool  { return h[i].sim < h[j].sim }
+func (h simHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *simHeap) Push(x interface{}) { *h = append(*h, x.(simEntry)) }
+func (h *simHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	*h = old[:n-1]
+	return entry
+}
+
+// maxSimHeap is a max-heap by similarity (best candidate on top),
+// used for the exploration frontier
+type maxSimHeap []simEntry
+
+func (h maxSimHeap) Len() int            { return len(h) }
+func (h maxSimHeap) Less(i, j int) bool  { return h[i].sim > h[j].sim }
+func (h maxSimHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *maxSimHeap) Push(x interface{}) { *h = append(*h, x.(simEntry)) }
+func (h *maxSimHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	*h = old[:n-1]
+	return entry
+}
+
+func removeString(list []string, target string) []string {
+	for i, s := range list {
+		if s == target {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
+
+// EnableVectorIndex attaches an HNSW index to the graph and bulk-loads
+// any embeddings already present. Subsequent SetNodeEmbedding calls
+// keep it current.
+func (hg *HypergraphMemory) EnableVectorIndex() error {
+	hg.mu.Lock()
+	defer hg.mu.Unlock()
+
+	if hg.vectorIndex != nil {
+		return fmt.Errorf("vector index already enabled")
+	}
+
+	index := NewHNSWIndex()
+	for id, embedding := range hg.embeddings {
+		if err := index.Insert(id, embedding); err != nil {
+			return fmt.Errorf("failed to index node %s: %w", id, err)
+		}
+	}
+	hg.vectorIndex = index
+
+	fmt.Printf("🧭 Hypergraph: vector index enabled over %d embedding(s)\n", index.Size())
+	return nil
+}
+
+// SetNodeEmbedding stores a node's embedding and updates the vector
+// index when one is enabled
+func (hg *HypergraphMemory) SetNodeEmbedding(nodeID string, embedding []float64) error {
+	hg.mu.Lock()
+	defer hg.mu.Unlock()
+
+	if _, exists := hg.nodes[nodeID]; !exists {
+		return fmt.Errorf("node not found: %s", nodeID)
+	}
+
+	hg.embeddings[nodeID] = embedding
+	if hg.vectorIndex != nil {
+		return hg.vectorIndex.Insert(nodeID, embedding)
+	}
+	return nil
+}
+
+// RemoveNodeEmbedding drops a node's embedding from the graph and
+// index
+func (hg *HypergraphMemory) RemoveNodeEmbedding(nodeID string) {
+	hg.mu.Lock()
+	defer hg.mu.Unlock()
+
+	delete(hg.embeddings, nodeID)
+	if hg.vectorIndex != nil {
+		hg.vectorIndex.Delete(nodeID)
+	}
+}
+
+// SearchSimilar finds the topK nodes most similar to a query
+// embedding, using the HNSW index when enabled and falling back to a
+// linear scan otherwise
+func (hg *HypergraphMemory) SearchSimilar(queryEmbedding []float64, topK int) []*MemoryNode {
+	hg.mu.RLock()
+	defer hg.mu.RUnlock()
+
+	var ids []string
+	if hg.vectorIndex != nil {
+		ids = hg.vectorIndex.Search(queryEmbedding, topK)
+	} else {
+		entries := make([]simEntry, 0, len(hg.embeddings))
+		for id, embedding := range hg.embeddings {
+			entries = append(entries, simEntry{id: id, sim: cosineSimilarity(queryEmbedding, embedding)})
+		}
+		for i := 0; i < len(entries) && i < topK; i++ {
+			bestIdx := i
+			for j := i + 1; j < len(entries); j++ {
+				if entries[j].sim > entries[bestIdx].sim {
+					bestIdx = j
+				}
+			}
+			entries[i], entries[bestIdx] = entries[bestIdx], entries[i]
+			ids = append(ids, entries[i].id)
+		}
+	}
+
+	result := make([]*MemoryNode, 0, len(ids))
+	for _, id := range ids {
+		if node, exists := hg.nodes[id]; exists {
+			result = append(result, node)
+		}
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// angled returns a 2D unit vector whose cosine similarity to (1,0) is
+// exactly cos
+func angled(cos float64) []float64 {
+	return []float64{cos, math.Sqrt(1 - cos*cos)}
+}
+
+// TestSearchLayerExploresBestCandidateFirst builds a layer where the
+// top hit sits behind the best candidate. A frontier that pops the
+// worst candidate first terminates on it and never reaches "b".
+func TestSearchLayerExploresBestCandidateFirst(t *testing.T) {
+	idx := NewHNSWIndex()
+	vectors := map[string]float64{
+		"s": 0.5, "a": 0.9, "b": 0.99, "c": 0.8, "d": 0.6,
+	}
+	for id, cos := range vectors {
+		idx.vectors[id] = angled(cos)
+		idx.levels[id] = 0
+	}
+	link := func(x, y string) {
+		idx.neighbors[x] = [][]string{append(idx.layerNeighbors(x, 0), y)}
+		idx.neighbors[y] = [][]string{append(idx.layerNeighbors(y, 0), x)}
+	}
+	link("s", "a")
+	link("s", "c")
+	link("s", "d")
+	link("a", "b")
+
+	query := []float64{1, 0}
+	found := idx.searchLayer(query, "s", 2, 0)
+	if len(found) != 2 || found[0] != "b" || found[1] != "a" {
+		t.Errorf("searchLayer returned %v, want [b a]", found)
+	}
+}
+
+func TestSearchRecallAgainstBruteForce(t *testing.T) {
+	const (
+		dims    = 16
+		count   = 500
+		queries = 20
+		topK    = 10
+	)
+
+	rng := rand.New(rand.NewSource(7))
+	randomVector := func() []float64 {
+		v := make([]float64, dims)
+		for i := range v {
+			v[i] = rng.NormFloat64()
+		}
+		return v
+	}
+
+	idx := NewHNSWIndex()
+	stored := make(map[string][]float64, count)
+	for i := 0; i < count; i++ {
+		id := fmt.Sprintf("node-%d", i)
+		vector := randomVector()
+		stored[id] = vector
+		if err := idx.Insert(id, vector); err != nil {
+			t.Fatalf("insert failed: %v", err)
+		}
+	}
+
+	var hits, expected int
+	for q := 0; q < queries; q++ {
+		query := randomVector()
+
+		entries := make([]simEntry, 0, count)
+		for id, vector := range stored {
+			entries = append(entries, simEntry{id: id, sim: cosineSimilarity(query, vector)})
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].sim > entries[j].sim })
+		exact := make(map[string]bool, topK)
+		for _, entry := range entries[:topK] {
+			exact[entry.id] = true
+		}
+
+		for _, id := range idx.Search(query, topK) {
+			if exact[id] {
+				hits++
+			}
+		}
+		expected += topK
+	}
+
+	if recall := float64(hits) / float64(expected); recall < 0.9 {
+		t.Errorf("recall %.2f below 0.9 (%d/%d exact neighbors found)", recall, hits, expected)
+	}
+}
@@ -9,6 +9,14 @@ import (
 	"github.com/google/uuid"
 )
 
+// GraphPersistence is the storage backend contract for the hypergraph.
+// SupabasePersistence and SQLiteGraphStore both satisfy it, so the
+// same in-memory graph can sit over either backend.
+type GraphPersistence interface {
+	StoreNode(node *MemoryNode) error
+	StoreEdge(edge *MemoryEdge) error
+}
+
 // HypergraphMemory implements a true hypergraph structure for knowledge representation
 type HypergraphMemory struct {
 	mu           sync.RWMutex
@@ -28,13 +36,29 @@ type HypergraphMemory struct {
 	
 	// Semantic embeddings (for future similarity search)
 	embeddings   map[string][]float64
-	
+
+	// Optional ANN index over embeddings (see hnsw.go)
+	vectorIndex  *HNSWIndex
+
+	// Optional write-ahead log (see wal.go)
+	wal          *WriteAheadLog
+
+	// Optional maintenance jobs (see pruning.go, salience.go)
+	pruner       *graphPruner
+	salience     *salienceJob
+
+	// Optional relation schema validation (see edge_schema.go)
+	edgeSchemas  *EdgeSchemaRegistry
+
+	// Optional change subscriptions (see subscriptions.go)
+	subscriptions *subscriptionHub
+
 	// Persistence layer
-	persistence  *SupabasePersistence
+	persistence  GraphPersistence
 }
 
 // NewHypergraphMemory creates a new hypergraph memory structure
-func NewHypergraphMemory(persistence *SupabasePersistence) *HypergraphMemory {
+func NewHypergraphMemory(persistence GraphPersistence) *HypergraphMemory {
 	return &HypergraphMemory{
 		nodes:      make(map[string]*MemoryNode),
 		edges:      make(map[string]*MemoryEdge),
@@ -50,9 +74,17 @@ func NewHypergraphMemory(persistence *SupabasePersistence) *HypergraphMemory {
 
 // AddNode adds a node to the hypergraph
 func (hg *HypergraphMemory) AddNode(node *MemoryNode) error {
+	if err := hg.addNodeLocked(node); err != nil {
+		return err
+	}
+	hg.notifyChange(ChangeEvent{Op: ChangeInsert, Kind: "node", Node: node})
+	return hg.logMutation(walEntry{Op: "add_node", Node: node})
+}
+
+func (hg *HypergraphMemory) addNodeLocked(node *MemoryNode) error {
 	hg.mu.Lock()
 	defer hg.mu.Unlock()
-	
+
 	if node.ID == "" {
 		node.ID = uuid.New().String()
 	}
@@ -90,9 +122,17 @@ func (hg *HypergraphMemory) AddNode(node *MemoryNode) error {
 
 // AddEdge adds an edge to the hypergraph
 func (hg *HypergraphMemory) AddEdge(edge *MemoryEdge) error {
+	if err := hg.addEdgeLocked(edge); err != nil {
+		return err
+	}
+	hg.notifyChange(ChangeEvent{Op: ChangeInsert, Kind: "edge", Edge: edge})
+	return hg.logMutation(walEntry{Op: "add_edge", Edge: edge})
+}
+
+func (hg *HypergraphMemory) addEdgeLocked(edge *MemoryEdge) error {
 	hg.mu.Lock()
 	defer hg.mu.Unlock()
-	
+
 	if edge.ID == "" {
 		edge.ID = uuid.New().String()
 	}
@@ -107,7 +147,14 @@ func (hg *HypergraphMemory) AddEdge(edge *MemoryEdge) error {
 	if _, exists := hg.nodes[edge.TargetID]; !exists {
 		return fmt.Errorf("target node not found: %s", edge.TargetID)
 	}
-	
+
+	// Validate against the schema registry if one is attached
+	if hg.edgeSchemas != nil {
+		if err := hg.edgeSchemas.validateEdge(edge, hg.nodeTypeLocked); err != nil {
+			return err
+		}
+	}
+
 	// Add to main storage
 	hg.edges[edge.ID] = edge
 	
@@ -127,9 +174,17 @@ func (hg *HypergraphMemory) AddEdge(edge *MemoryEdge) error {
 
 // AddHyperEdge adds a hyperedge (multi-way relationship) to the hypergraph
 func (hg *HypergraphMemory) AddHyperEdge(hyperedge *HyperEdge) error {
+	if err := hg.addHyperEdgeLocked(hyperedge); err != nil {
+		return err
+	}
+	hg.notifyChange(ChangeEvent{Op: ChangeInsert, Kind: "hyperedge", HyperEdge: hyperedge})
+	return hg.logMutation(walEntry{Op: "add_hyperedge", HyperEdge: hyperedge})
+}
+
+func (hg *HypergraphMemory) addHyperEdgeLocked(hyperedge *HyperEdge) error {
 	hg.mu.Lock()
 	defer hg.mu.Unlock()
-	
+
 	if hyperedge.ID == "" {
 		hyperedge.ID = uuid.New().String()
 	}
@@ -143,7 +198,14 @@ func (hg *HypergraphMemory) AddHyperEdge(hyperedge *HyperEdge) error {
 			return fmt.Errorf("node not found in hyperedge: %s", nodeID)
 		}
 	}
-	
+
+	// Validate against the schema registry if one is attached
+	if hg.edgeSchemas != nil {
+		if err := hg.edgeSchemas.validateHyperEdge(hyperedge, hg.nodeTypeLocked); err != nil {
+			return err
+		}
+	}
+
 	// Add to main storage
 	hg.hyperedges[hyperedge.ID] = hyperedge
 	
@@ -0,0 +1,195 @@
+package memory
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// JSON import/export for the hypergraph: memories can be seeded from a
+// file, backed up, and moved between persistence backends. Exports
+// carry a schema version so older archives stay importable as the node
+// and edge formats evolve.
+
+// SnapshotSchemaVersion is the current export format version
+const SnapshotSchemaVersion = 1
+
+// SnapshotEnvelope wraps a graph snapshot with versioning metadata
+type SnapshotEnvelope struct {
+	SchemaVersion int            `json:"schema_version"`
+	ExportedAt    time.Time      `json:"exported_at"`
+	Graph         *GraphSnapshot `json:"graph"`
+}
+
+// jsonlRecord is one line of a JSONL export; exactly one of the
+// payload fields is set, discriminated by Kind
+type jsonlRecord struct {
+	Kind      string      `json:"kind"` // "header", "node", "edge", "hyperedge"
+	Version   int         `json:"version,omitempty"`
+	Node      *MemoryNode `json:"node,omitempty"`
+	Edge      *MemoryEdge `json:"edge,omitempty"`
+	HyperEdge *HyperEdge  `json:"hyperedge,omitempty"`
+}
+
+// ExportJSON writes the full graph as a single versioned JSON document
+func (hg *HypergraphMemory) ExportJSON(w io.Writer) error {
+	envelope := SnapshotEnvelope{
+		SchemaVersion: SnapshotSchemaVersion,
+		ExportedAt:    time.Now(),
+		Graph:         hg.Snapshot(),
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(envelope); err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	return nil
+}
+
+// ImportJSON loads a versioned JSON export into the graph
+func (hg *HypergraphMemory) ImportJSON(r io.Reader) error {
+	var envelope SnapshotEnvelope
+	if err := json.NewDecoder(r).Decode(&envelope); err != nil {
+		return fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+
+	if envelope.SchemaVersion > SnapshotSchemaVersion {
+		return fmt.Errorf("snapshot schema version %d is newer than supported version %d",
+			envelope.SchemaVersion, SnapshotSchemaVersion)
+	}
+	if envelope.Graph == nil {
+		return fmt.Errorf("snapshot contains no graph")
+	}
+
+	return hg.RestoreSnapshot(envelope.Graph)
+}
+
+// ExportJSONL writes the graph as JSONL: a header line followed by one
+// record per node, edge, and hyperedge. The streaming format suits
+// large graphs and line-oriented tooling.
+func (hg *HypergraphMemory) ExportJSONL(w io.Writer) error {
+	snapshot := hg.Snapshot()
+	encoder := json.NewEncoder(w)
+
+	if err := encoder.Encode(jsonlRecord{Kind: "header", Version: SnapshotSchemaVersion}); err != nil {
+		return fmt.Errorf("failed to encode header: %w", err)
+	}
+	for _, node := range snapshot.Nodes {
+		if err := encoder.Encode(jsonlRecord{Kind: "node", Node: node}); err != nil {
+			return fmt.Errorf("failed to encode node %s: %w", node.ID, err)
+		}
+	}
+	for _, edge := range snapshot.Edges {
+		if err := encoder.Encode(jsonlRecord{Kind: "edge", Edge: edge}); err != nil {
+			return fmt.Errorf("failed to encode edge %s: %w", edge.ID, err)
+		}
+	}
+	for _, hyperedge := range snapshot.HyperEdges {
+		if err := encoder.Encode(jsonlRecord{Kind: "hyperedge", HyperEdge: hyperedge}); err != nil {
+			return fmt.Errorf("failed to encode hyperedge %s: %w", hyperedge.ID, err)
+		}
+	}
+	return nil
+}
+
+// ImportJSONL loads a JSONL export into the graph. Records arrive in
+// export order (nodes before the edges that reference them), so each
+// line can be applied as it is read.
+func (hg *HypergraphMemory) ImportJSONL(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	lineNum := 0
+	nodes, edges, hyperedges := 0, 0, 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var record jsonlRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("failed to parse line %d: %w", lineNum, err)
+		}
+
+		switch record.Kind {
+		case "header":
+			if record.Version > SnapshotSchemaVersion {
+				return fmt.Errorf("snapshot schema version %d is newer than supported version %d",
+					record.Version, SnapshotSchemaVersion)
+			}
+		case "node":
+			if record.Node == nil {
+				return fmt.Errorf("line %d: node record without node", lineNum)
+			}
+			if err := hg.AddNode(record.Node); err != nil {
+				return fmt.Errorf("failed to import node at line %d: %w", lineNum, err)
+			}
+			nodes++
+		case "edge":
+			if record.Edge == nil {
+				return fmt.Errorf("line %d: edge record without edge", lineNum)
+			}
+			if err := hg.AddEdge(record.Edge); err != nil {
+				return fmt.Errorf("failed to import edge at line %d: %w", lineNum, err)
+			}
+			edges++
+		case "hyperedge":
+			if record.HyperEdge == nil {
+				return fmt.Errorf("line %d: hyperedge record without hyperedge", lineNum)
+			}
+			if err := hg.AddHyperEdge(record.HyperEdge); err != nil {
+				return fmt.Errorf("failed to import hyperedge at line %d: %w", lineNum, err)
+			}
+			hyperedges++
+		default:
+			return fmt.Errorf("line %d: unknown record kind %q", lineNum, record.Kind)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read import stream: %w", err)
+	}
+
+	fmt.Printf("💾 Hypergraph: imported %d node(s), %d edge(s), %d hyperedge(s) from JSONL\n",
+		nodes, edges, hyperedges)
+
+	return nil
+}
+
+// ExportToFile writes the graph to a file, choosing JSONL when the
+// path ends in .jsonl and the JSON envelope otherwise
+func (hg *HypergraphMemory) ExportToFile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create export file: %w", err)
+	}
+	defer file.Close()
+
+	if isJSONLPath(path) {
+		return hg.ExportJSONL(file)
+	}
+	return hg.ExportJSON(file)
+}
+
+// ImportFromFile loads a previously exported graph from a file
+func (hg *HypergraphMemory) ImportFromFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer file.Close()
+
+	if isJSONLPath(path) {
+		return hg.ImportJSONL(file)
+	}
+	return hg.ImportJSON(file)
+}
+
+func isJSONLPath(path string) bool {
+	return len(path) > 6 && path[len(path)-6:] == ".jsonl"
+}
@@ -0,0 +1,287 @@
+package memory
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// Schema migrations for the Supabase backend. NewSupabasePersistence
+// assumes the tables already exist; the migration manager creates and
+// upgrades them instead, tracking applied versions in a
+// schema_migrations table so Migrate() is safe to call on every
+// startup. Execution goes through an exec_sql RPC function (see
+// migration 0's bootstrap note) because PostgREST does not expose DDL.
+
+// Migration is one versioned schema change
+type Migration struct {
+	Version int
+	Name    string
+	SQL     string
+}
+
+// MigrationManager applies pending migrations against Supabase
+type MigrationManager struct {
+	client     *SupabaseClient
+	migrations []Migration
+}
+
+// NewMigrationManager creates a manager preloaded with the built-in
+// migrations
+func NewMigrationManager(client *SupabaseClient) *MigrationManager {
+	return &MigrationManager{
+		client:     client,
+		migrations: append([]Migration{}, builtinMigrations...),
+	}
+}
+
+// RegisterMigration adds a custom migration; versions must be unique
+func (mm *MigrationManager) RegisterMigration(migration Migration) error {
+	if migration.Version <= 0 {
+		return fmt.Errorf("migration version must be positive")
+	}
+	for _, existing := range mm.migrations {
+		if existing.Version == migration.Version {
+			return fmt.Errorf("migration version %d already registered (%s)", migration.Version, existing.Name)
+		}
+	}
+	mm.migrations = append(mm.migrations, migration)
+	return nil
+}
+
+// Migrate applies all pending migrations in version order
+func (mm *MigrationManager) Migrate() error {
+	if err := mm.ensureMigrationsTable(); err != nil {
+		return fmt.Errorf("failed to prepare migrations table: %w", err)
+	}
+
+	applied, err := mm.appliedVersions()
+	if err != nil {
+		return fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	pending := make([]Migration, 0)
+	for _, migration := range mm.migrations {
+		if !applied[migration.Version] {
+			pending = append(pending, migration)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].Version < pending[j].Version })
+
+	if len(pending) == 0 {
+		fmt.Printf("💾 Schema up to date (version %d)\n", mm.currentVersion(applied))
+		return nil
+	}
+
+	for _, migration := range pending {
+		fmt.Printf("💾 Applying migration %d: %s\n", migration.Version, migration.Name)
+		if err := mm.execSQL(migration.SQL); err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Name, err)
+		}
+		record := map[string]interface{}{
+			"version":    migration.Version,
+			"name":       migration.Name,
+			"applied_at": time.Now().Format(time.RFC3339),
+		}
+		if err := mm.client.Insert("schema_migrations", record); err != nil {
+			return fmt.Errorf("failed to record migration %d: %w", migration.Version, err)
+		}
+	}
+
+	fmt.Printf("✅ Applied %d migration(s)\n", len(pending))
+	return nil
+}
+
+// ensureMigrationsTable creates the tracking table on first run
+func (mm *MigrationManager) ensureMigrationsTable() error {
+	return mm.execSQL(`
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    version INT PRIMARY KEY,
+    name TEXT NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);`)
+}
+
+// appliedVersions reads the set of already-applied migration versions
+func (mm *MigrationManager) appliedVersions() (map[int]bool, error) {
+	results, err := mm.client.Query("schema_migrations", map[string]interface{}{}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]bool)
+	for _, row := range results {
+		if version, ok := row["version"].(float64); ok {
+			applied[int(version)] = true
+		}
+	}
+	return applied, nil
+}
+
+func (mm *MigrationManager) currentVersion(applied map[int]bool) int {
+	max := 0
+	for version := range applied {
+		if version > max {
+			max = version
+		}
+	}
+	return max
+}
+
+// execSQL runs DDL through the exec_sql RPC function. The function
+// itself must be created once via the Supabase dashboard:
+//
+//	CREATE OR REPLACE FUNCTION exec_sql(query TEXT)
+//	RETURNS VOID LANGUAGE plpgsql SECURITY DEFINER AS $$
+//	BEGIN EXECUTE query; END; $$;
+func (mm *MigrationManager) execSQL(query string) error {
+	payload, err := json.Marshal(map[string]interface{}{"query": query})
+	if err != nil {
+		return fmt.Errorf("failed to marshal query: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/rest/v1/rpc/exec_sql", mm.client.url)
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("apikey", mm.client.key)
+	req.Header.Set("Authorization", "Bearer "+mm.client.key)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := mm.client.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("exec_sql failed with status %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// builtinMigrations track SCHEMA.sql; new schema changes get a new
+// entry here rather than editing earlier ones
+var builtinMigrations = []Migration{
+	{
+		Version: 1,
+		Name:    "core hypergraph tables",
+		SQL: `
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE EXTENSION IF NOT EXISTS "vector";
+
+CREATE TABLE IF NOT EXISTS memory_nodes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    type VARCHAR(50) NOT NULL,
+    content TEXT NOT NULL,
+    embedding vector(1536),
+    metadata JSONB DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    importance FLOAT NOT NULL DEFAULT 0.5,
+    access_count INT DEFAULT 0,
+    last_accessed TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS memory_edges (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    source_id UUID NOT NULL REFERENCES memory_nodes(id) ON DELETE CASCADE,
+    target_id UUID NOT NULL REFERENCES memory_nodes(id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL,
+    weight FLOAT NOT NULL DEFAULT 1.0,
+    metadata JSONB DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    strengthened_count INT DEFAULT 0,
+    last_activated TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS hyperedges (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    node_ids UUID[] NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    metadata JSONB DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);`,
+	},
+	{
+		Version: 2,
+		Name:    "episodic and identity tables",
+		SQL: `
+CREATE TABLE IF NOT EXISTS episodes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    timestamp TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    context TEXT NOT NULL,
+    importance FLOAT NOT NULL DEFAULT 0.5,
+    node_ids UUID[] NOT NULL,
+    metadata JSONB DEFAULT '{}',
+    consolidated BOOLEAN DEFAULT FALSE,
+    dream_session_id UUID
+);
+
+CREATE TABLE IF NOT EXISTS identity_snapshots (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    timestamp TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    coherence FLOAT NOT NULL,
+    state JSONB NOT NULL,
+    metadata JSONB DEFAULT '{}'
+);
+
+CREATE TABLE IF NOT EXISTS dream_journals (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    timestamp TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    dream_state VARCHAR(50) NOT NULL,
+    memories_consolidated INT DEFAULT 0,
+    patterns_synthesized INT DEFAULT 0,
+    insights TEXT[],
+    metadata JSONB DEFAULT '{}'
+);`,
+	},
+	{
+		Version: 3,
+		Name:    "query indexes",
+		SQL: `
+CREATE INDEX IF NOT EXISTS idx_memory_nodes_type ON memory_nodes(type);
+CREATE INDEX IF NOT EXISTS idx_memory_nodes_importance ON memory_nodes(importance DESC);
+CREATE INDEX IF NOT EXISTS idx_memory_nodes_created_at ON memory_nodes(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_memory_nodes_embedding ON memory_nodes USING ivfflat (embedding vector_cosine_ops);
+CREATE INDEX IF NOT EXISTS idx_memory_edges_source ON memory_edges(source_id);
+CREATE INDEX IF NOT EXISTS idx_memory_edges_target ON memory_edges(target_id);
+CREATE INDEX IF NOT EXISTS idx_memory_edges_type ON memory_edges(type);
+CREATE INDEX IF NOT EXISTS idx_hyperedges_node_ids ON hyperedges USING GIN(node_ids);
+CREATE INDEX IF NOT EXISTS idx_episodes_timestamp ON episodes(timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_episodes_node_ids ON episodes USING GIN(node_ids);
+CREATE INDEX IF NOT EXISTS idx_identity_snapshots_timestamp ON identity_snapshots(timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_dream_journals_timestamp ON dream_journals(timestamp DESC);`,
+	},
+	{
+		Version: 4,
+		Name:    "row level security policies",
+		SQL: `
+ALTER TABLE memory_nodes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE memory_edges ENABLE ROW LEVEL SECURITY;
+ALTER TABLE hyperedges ENABLE ROW LEVEL SECURITY;
+ALTER TABLE episodes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE identity_snapshots ENABLE ROW LEVEL SECURITY;
+ALTER TABLE dream_journals ENABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Enable all operations for service role" ON memory_nodes;
+CREATE POLICY "Enable all operations for service role" ON memory_nodes FOR ALL USING (true);
+DROP POLICY IF EXISTS "Enable all operations for service role" ON memory_edges;
+CREATE POLICY "Enable all operations for service role" ON memory_edges FOR ALL USING (true);
+DROP POLICY IF EXISTS "Enable all operations for service role" ON hyperedges;
+CREATE POLICY "Enable all operations for service role" ON hyperedges FOR ALL USING (true);
+DROP POLICY IF EXISTS "Enable all operations for service role" ON episodes;
+CREATE POLICY "Enable all operations for service role" ON episodes FOR ALL USING (true);
+DROP POLICY IF EXISTS "Enable all operations for service role" ON identity_snapshots;
+CREATE POLICY "Enable all operations for service role" ON identity_snapshots FOR ALL USING (true);
+DROP POLICY IF EXISTS "Enable all operations for service role" ON dream_journals;
+CREATE POLICY "Enable all operations for service role" ON dream_journals FOR ALL USING (true);`,
+	},
+}
@@ -0,0 +1,280 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TTL and pruning: a long-running agent cannot keep every memory.
+// Nodes may carry expiry times, the graph may carry a size budget, and
+// a maintenance job enforces both during rest cycles. Pinned nodes
+// (identity, core goals) are never pruned regardless of policy.
+
+const (
+	metaExpiresAt = "expires_at"
+	metaPinned    = "pinned"
+)
+
+// PruningPolicy controls what the maintenance job removes
+type PruningPolicy struct {
+	// MaxNodes caps the graph size; 0 disables the budget
+	MaxNodes int
+	// DefaultTTL expires nodes without an explicit TTL; 0 disables
+	DefaultTTL time.Duration
+	// TTLByType overrides DefaultTTL per node type
+	TTLByType map[NodeType]time.Duration
+}
+
+// episodicTypes are pruned before semantic knowledge when the graph
+// exceeds its budget
+var episodicTypes = map[NodeType]bool{
+	NodeEvent:      true,
+	NodeThought:    true,
+	NodeExperience: true,
+}
+
+// SetNodeTTL sets an expiry on a node; it becomes eligible for
+// removal on the next pruning pass after the TTL elapses
+func (hg *HypergraphMemory) SetNodeTTL(nodeID string, ttl time.Duration) error {
+	hg.mu.Lock()
+	defer hg.mu.Unlock()
+
+	node, exists := hg.nodes[nodeID]
+	if !exists {
+		return fmt.Errorf("node not found: %s", nodeID)
+	}
+	if node.Metadata == nil {
+		node.Metadata = make(map[string]interface{})
+	}
+	node.Metadata[metaExpiresAt] = time.Now().Add(ttl).Format(time.RFC3339)
+	return nil
+}
+
+// PinNode marks a node as never prunable
+func (hg *HypergraphMemory) PinNode(nodeID string) error {
+	return hg.setPinned(nodeID, true)
+}
+
+// UnpinNode makes a node prunable again
+func (hg *HypergraphMemory) UnpinNode(nodeID string) error {
+	return hg.setPinned(nodeID, false)
+}
+
+func (hg *HypergraphMemory) setPinned(nodeID string, pinned bool) error {
+	hg.mu.Lock()
+	defer hg.mu.Unlock()
+
+	node, exists := hg.nodes[nodeID]
+	if !exists {
+		return fmt.Errorf("node not found: %s", nodeID)
+	}
+	if node.Metadata == nil {
+		node.Metadata = make(map[string]interface{})
+	}
+	if pinned {
+		node.Metadata[metaPinned] = true
+	} else {
+		delete(node.Metadata, metaPinned)
+	}
+	return nil
+}
+
+// RemoveNode deletes a node along with its incident edges and its
+// hyperedge memberships
+func (hg *HypergraphMemory) RemoveNode(nodeID string) error {
+	hg.mu.Lock()
+	if _, exists := hg.nodes[nodeID]; !exists {
+		hg.mu.Unlock()
+		return fmt.Errorf("node not found: %s", nodeID)
+	}
+	node := hg.nodes[nodeID]
+	hg.removeNodeLocked(nodeID)
+	hg.mu.Unlock()
+
+	hg.notifyChange(ChangeEvent{Op: ChangeRemove, Kind: "node", Node: node})
+	return hg.logMutation(walEntry{Op: "remove_node", Node: &MemoryNode{ID: nodeID}})
+}
+
+// removeNodeLocked unlinks a node from every structure; callers hold
+// the write lock
+func (hg *HypergraphMemory) removeNodeLocked(nodeID string) {
+	node := hg.nodes[nodeID]
+
+	// Incident edges, both directions
+	for _, edgeID := range append(append([]string{}, hg.outgoing[nodeID]...), hg.incoming[nodeID]...) {
+		edge, exists := hg.edges[edgeID]
+		if !exists {
+			continue
+		}
+		hg.outgoing[edge.SourceID] = removeString(hg.outgoing[edge.SourceID], edgeID)
+		hg.incoming[edge.TargetID] = removeString(hg.incoming[edge.TargetID], edgeID)
+		delete(hg.edges, edgeID)
+	}
+	delete(hg.outgoing, nodeID)
+	delete(hg.incoming, nodeID)
+
+	// Hyperedge memberships; empty hyperedges go with the node
+	for hyperedgeID, hyperedge := range hg.hyperedges {
+		hyperedge.NodeIDs = removeString(hyperedge.NodeIDs, nodeID)
+		if len(hyperedge.NodeIDs) == 0 {
+			delete(hg.hyperedges, hyperedgeID)
+		}
+	}
+
+	// Indices and embeddings
+	if node != nil {
+		hg.typeIndex[node.Type] = removeString(hg.typeIndex[node.Type], nodeID)
+	}
+	hg.timeIndex = removeString(hg.timeIndex, nodeID)
+	delete(hg.embeddings, nodeID)
+	if hg.vectorIndex != nil {
+		hg.vectorIndex.Delete(nodeID)
+	}
+
+	delete(hg.nodes, nodeID)
+}
+
+// Prune applies a policy once: expired nodes first, then the
+// lowest-value nodes until the graph fits its budget. Returns the
+// number of nodes removed.
+func (hg *HypergraphMemory) Prune(policy PruningPolicy) int {
+	hg.mu.Lock()
+	defer hg.mu.Unlock()
+
+	now := time.Now()
+	removed := 0
+
+	// Pass 1: TTL expiry
+	for nodeID, node := range hg.nodes {
+		if isPinned(node) {
+			continue
+		}
+		if nodeExpired(node, policy, now) {
+			hg.removeNodeLocked(nodeID)
+			removed++
+		}
+	}
+
+	// Pass 2: size budget — episodic before semantic, lowest salience
+	// first, oldest first on ties
+	if policy.MaxNodes > 0 && len(hg.nodes) > policy.MaxNodes {
+		candidates := make([]*MemoryNode, 0, len(hg.nodes))
+		for _, node := range hg.nodes {
+			if !isPinned(node) {
+				candidates = append(candidates, node)
+			}
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			a, b := candidates[i], candidates[j]
+			if episodicTypes[a.Type] != episodicTypes[b.Type] {
+				return episodicTypes[a.Type]
+			}
+			if a.Importance != b.Importance {
+				return a.Importance < b.Importance
+			}
+			return a.CreatedAt.Before(b.CreatedAt)
+		})
+
+		for _, node := range candidates {
+			if len(hg.nodes) <= policy.MaxNodes {
+				break
+			}
+			hg.removeNodeLocked(node.ID)
+			removed++
+		}
+	}
+
+	if removed > 0 {
+		fmt.Printf("🍂 Hypergraph: pruned %d node(s), %d remaining\n", removed, len(hg.nodes))
+	}
+	return removed
+}
+
+// isPinned reports whether a node is protected from pruning
+func isPinned(node *MemoryNode) bool {
+	if node.Metadata == nil {
+		return false
+	}
+	pinned, _ := node.Metadata[metaPinned].(bool)
+	return pinned
+}
+
+// nodeExpired checks a node's explicit expiry, then the policy TTLs
+func nodeExpired(node *MemoryNode, policy PruningPolicy, now time.Time) bool {
+	if node.Metadata != nil {
+		if raw, ok := node.Metadata[metaExpiresAt].(string); ok {
+			if expiry, err := time.Parse(time.RFC3339, raw); err == nil {
+				return now.After(expiry)
+			}
+		}
+	}
+
+	ttl := policy.DefaultTTL
+	if typeTTL, ok := policy.TTLByType[node.Type]; ok {
+		ttl = typeTTL
+	}
+	if ttl <= 0 {
+		return false
+	}
+	return now.Sub(node.CreatedAt) > ttl
+}
+
+// graphPruner runs Prune on an interval
+type graphPruner struct {
+	policy PruningPolicy
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// StartPruning runs the maintenance job periodically (rest cycles
+// typically use 5-15 minute intervals)
+func (hg *HypergraphMemory) StartPruning(interval time.Duration, policy PruningPolicy) error {
+	hg.mu.Lock()
+	if hg.pruner != nil {
+		hg.mu.Unlock()
+		return fmt.Errorf("pruning already running")
+	}
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+	pruner := &graphPruner{
+		policy: policy,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	hg.pruner = pruner
+	hg.mu.Unlock()
+
+	go func() {
+		defer close(pruner.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				hg.Prune(pruner.policy)
+			case <-pruner.stop:
+				return
+			}
+		}
+	}()
+
+	fmt.Printf("🍂 Hypergraph: pruning every %v (budget %d nodes)\n", interval, policy.MaxNodes)
+	return nil
+}
+
+// StopPruning halts the maintenance job
+func (hg *HypergraphMemory) StopPruning() error {
+	hg.mu.Lock()
+	pruner := hg.pruner
+	hg.pruner = nil
+	hg.mu.Unlock()
+
+	if pruner == nil {
+		return fmt.Errorf("pruning not running")
+	}
+	close(pruner.stop)
+	<-pruner.done
+	return nil
+}
@@ -0,0 +1,242 @@
+package memory
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// PageRank-style salience: a node's importance should reflect its
+// position in the graph, not just the score it was stored with. A
+// personalized PageRank seeded from identity and goal nodes spreads
+// salience outward along weighted edges, so memories connected to what
+// the agent cares about score higher than well-connected trivia. The
+// result is blended into each node's Importance rather than replacing
+// it, and a periodic job keeps scores fresh as the graph grows.
+
+// SalienceConfig controls the personalized PageRank pass
+type SalienceConfig struct {
+	// Damping is the PageRank damping factor; default 0.85
+	Damping float64
+	// Iterations bounds the power-iteration loop; default 20
+	Iterations int
+	// Tolerance stops early when ranks converge; default 1e-6
+	Tolerance float64
+	// Blend is the weight of the rank in the updated importance
+	// (importance = (1-Blend)*old + Blend*rank); default 0.3
+	Blend float64
+	// SeedTypes are the node types the walk teleports to; default
+	// goal nodes
+	SeedTypes []NodeType
+	// SeedIDs adds specific nodes (e.g. the identity anchor) to the
+	// seed set
+	SeedIDs []string
+}
+
+func (config *SalienceConfig) applyDefaults() {
+	if config.Damping <= 0 || config.Damping >= 1 {
+		config.Damping = 0.85
+	}
+	if config.Iterations <= 0 {
+		config.Iterations = 20
+	}
+	if config.Tolerance <= 0 {
+		config.Tolerance = 1e-6
+	}
+	if config.Blend <= 0 || config.Blend > 1 {
+		config.Blend = 0.3
+	}
+	if len(config.SeedTypes) == 0 && len(config.SeedIDs) == 0 {
+		config.SeedTypes = []NodeType{NodeGoal}
+	}
+}
+
+// ComputeSalience runs personalized PageRank and returns each node's
+// rank scaled so the highest-ranked node scores 1.0
+func (hg *HypergraphMemory) ComputeSalience(config SalienceConfig) map[string]float64 {
+	config.applyDefaults()
+
+	hg.mu.RLock()
+	defer hg.mu.RUnlock()
+
+	if len(hg.nodes) == 0 {
+		return map[string]float64{}
+	}
+
+	// Teleport distribution: uniform over the seed set, falling back
+	// to uniform over all nodes when no seed exists yet
+	seeds := make(map[string]bool)
+	for _, seedType := range config.SeedTypes {
+		for _, nodeID := range hg.typeIndex[seedType] {
+			seeds[nodeID] = true
+		}
+	}
+	for _, nodeID := range config.SeedIDs {
+		if _, exists := hg.nodes[nodeID]; exists {
+			seeds[nodeID] = true
+		}
+	}
+	teleport := make(map[string]float64, len(hg.nodes))
+	if len(seeds) > 0 {
+		share := 1.0 / float64(len(seeds))
+		for nodeID := range seeds {
+			teleport[nodeID] = share
+		}
+	} else {
+		share := 1.0 / float64(len(hg.nodes))
+		for nodeID := range hg.nodes {
+			teleport[nodeID] = share
+		}
+	}
+
+	// Outgoing weight totals for normalizing each node's edges
+	outWeight := make(map[string]float64, len(hg.nodes))
+	for _, edgeIDs := range hg.outgoing {
+		for _, edgeID := range edgeIDs {
+			edge := hg.edges[edgeID]
+			outWeight[edge.SourceID] += edgeWeightOrDefault(edge)
+		}
+	}
+
+	ranks := make(map[string]float64, len(hg.nodes))
+	for nodeID := range hg.nodes {
+		ranks[nodeID] = teleport[nodeID]
+	}
+
+	for iteration := 0; iteration < config.Iterations; iteration++ {
+		next := make(map[string]float64, len(hg.nodes))
+		dangling := 0.0
+		for nodeID, rank := range ranks {
+			if outWeight[nodeID] == 0 {
+				dangling += rank
+				continue
+			}
+			for _, edgeID := range hg.outgoing[nodeID] {
+				edge := hg.edges[edgeID]
+				next[edge.TargetID] += rank * edgeWeightOrDefault(edge) / outWeight[nodeID]
+			}
+		}
+
+		delta := 0.0
+		for nodeID := range hg.nodes {
+			// Dangling mass teleports back to the seeds too
+			rank := (1-config.Damping)*teleport[nodeID] +
+				config.Damping*(next[nodeID]+dangling*teleport[nodeID])
+			delta += math.Abs(rank - ranks[nodeID])
+			ranks[nodeID] = rank
+		}
+		if delta < config.Tolerance {
+			break
+		}
+	}
+
+	// Scale so the top node scores 1.0, making ranks comparable with
+	// the 0..1 importance scale
+	max := 0.0
+	for _, rank := range ranks {
+		if rank > max {
+			max = rank
+		}
+	}
+	if max > 0 {
+		for nodeID := range ranks {
+			ranks[nodeID] /= max
+		}
+	}
+	return ranks
+}
+
+// ApplySalience blends a PageRank pass into node importance scores.
+// Pinned nodes keep their importance. Returns the number of nodes
+// updated.
+func (hg *HypergraphMemory) ApplySalience(config SalienceConfig) int {
+	config.applyDefaults()
+	ranks := hg.ComputeSalience(config)
+
+	hg.mu.Lock()
+	defer hg.mu.Unlock()
+
+	updated := 0
+	for nodeID, rank := range ranks {
+		node, exists := hg.nodes[nodeID]
+		if !exists || isPinned(node) {
+			continue
+		}
+		blended := (1-config.Blend)*node.Importance + config.Blend*rank
+		if blended != node.Importance {
+			node.Importance = blended
+			node.UpdatedAt = time.Now()
+			updated++
+		}
+	}
+	return updated
+}
+
+func edgeWeightOrDefault(edge *MemoryEdge) float64 {
+	if edge.Weight > 0 {
+		return edge.Weight
+	}
+	return 1.0
+}
+
+// salienceJob runs ApplySalience on an interval
+type salienceJob struct {
+	config SalienceConfig
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// StartSalienceUpdates recomputes salience periodically (the rest
+// cycle typically uses 10-30 minute intervals)
+func (hg *HypergraphMemory) StartSalienceUpdates(interval time.Duration, config SalienceConfig) error {
+	hg.mu.Lock()
+	if hg.salience != nil {
+		hg.mu.Unlock()
+		return fmt.Errorf("salience updates already running")
+	}
+	if interval <= 0 {
+		interval = 15 * time.Minute
+	}
+	job := &salienceJob{
+		config: config,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	hg.salience = job
+	hg.mu.Unlock()
+
+	go func() {
+		defer close(job.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				updated := hg.ApplySalience(job.config)
+				if updated > 0 {
+					fmt.Printf("🧭 Hypergraph: salience pass updated %d node(s)\n", updated)
+				}
+			case <-job.stop:
+				return
+			}
+		}
+	}()
+
+	fmt.Printf("🧭 Hypergraph: salience updates every %v\n", interval)
+	return nil
+}
+
+// StopSalienceUpdates halts the periodic salience job
+func (hg *HypergraphMemory) StopSalienceUpdates() error {
+	hg.mu.Lock()
+	job := hg.salience
+	hg.salience = nil
+	hg.mu.Unlock()
+
+	if job == nil {
+		return fmt.Errorf("salience updates not running")
+	}
+	close(job.stop)
+	<-job.done
+	return nil
+}
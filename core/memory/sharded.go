@@ -0,0 +1,262 @@
+package memory
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sharded hypergraph store: HypergraphMemory serializes every
+// operation behind one RWMutex, which becomes the bottleneck once
+// many goroutines (dream cycle, introspection, chat) hit the graph at
+// once. ShardedHypergraph spreads nodes across shards keyed by node
+// ID — node lookups are lock-free (sync.Map) and writes only contend
+// within a shard. Edges live in the shard of their source node;
+// cross-shard operations lock shards in index order so they can never
+// deadlock.
+
+// defaultShardCount balances contention against per-shard overhead
+const defaultShardCount = 32
+
+// graphShard holds one partition of the graph
+type graphShard struct {
+	// nodes maps node ID -> *MemoryNode; reads need no lock
+	nodes sync.Map
+
+	// mu guards the adjacency structures below
+	mu       sync.RWMutex
+	edges    map[string]*MemoryEdge
+	outgoing map[string][]*MemoryEdge // source node ID -> edges
+	incoming map[string][]*MemoryEdge // target node ID -> edges
+}
+
+// ShardedHypergraph is a concurrent node/edge store partitioned into
+// independently locked shards
+type ShardedHypergraph struct {
+	shards    []*graphShard
+	nodeCount int64
+	edgeCount int64
+}
+
+// NewShardedHypergraph creates a sharded store; shardCount <= 0 uses
+// the default
+func NewShardedHypergraph(shardCount int) *ShardedHypergraph {
+	if shardCount <= 0 {
+		shardCount = defaultShardCount
+	}
+	shards := make([]*graphShard, shardCount)
+	for i := range shards {
+		shards[i] = &graphShard{
+			edges:    make(map[string]*MemoryEdge),
+			outgoing: make(map[string][]*MemoryEdge),
+			incoming: make(map[string][]*MemoryEdge),
+		}
+	}
+	return &ShardedHypergraph{shards: shards}
+}
+
+// shardIndex maps an ID onto its shard
+func (sg *ShardedHypergraph) shardIndex(id string) int {
+	hasher := fnv.New32a()
+	hasher.Write([]byte(id))
+	return int(hasher.Sum32() % uint32(len(sg.shards)))
+}
+
+func (sg *ShardedHypergraph) shardFor(id string) *graphShard {
+	return sg.shards[sg.shardIndex(id)]
+}
+
+// AddNode adds a node, assigning an ID and timestamps as needed
+func (sg *ShardedHypergraph) AddNode(node *MemoryNode) error {
+	if node.ID == "" {
+		node.ID = uuid.New().String()
+	}
+	if node.CreatedAt.IsZero() {
+		node.CreatedAt = time.Now()
+	}
+	node.UpdatedAt = time.Now()
+
+	if _, existed := sg.shardFor(node.ID).nodes.Swap(node.ID, node); !existed {
+		atomic.AddInt64(&sg.nodeCount, 1)
+	}
+	return nil
+}
+
+// GetNode retrieves a node by ID without taking any lock
+func (sg *ShardedHypergraph) GetNode(nodeID string) (*MemoryNode, error) {
+	if value, ok := sg.shardFor(nodeID).nodes.Load(nodeID); ok {
+		return value.(*MemoryNode), nil
+	}
+	return nil, fmt.Errorf("node not found: %s", nodeID)
+}
+
+// AddEdge adds an edge; both endpoints must exist. The edge is
+// indexed in its source node's shard (outgoing) and its target node's
+// shard (incoming).
+func (sg *ShardedHypergraph) AddEdge(edge *MemoryEdge) error {
+	if edge.ID == "" {
+		edge.ID = uuid.New().String()
+	}
+	if edge.CreatedAt.IsZero() {
+		edge.CreatedAt = time.Now()
+	}
+
+	if _, err := sg.GetNode(edge.SourceID); err != nil {
+		return fmt.Errorf("source node not found: %s", edge.SourceID)
+	}
+	if _, err := sg.GetNode(edge.TargetID); err != nil {
+		return fmt.Errorf("target node not found: %s", edge.TargetID)
+	}
+
+	sourceIdx, targetIdx := sg.shardIndex(edge.SourceID), sg.shardIndex(edge.TargetID)
+	sg.lockPair(sourceIdx, targetIdx)
+	defer sg.unlockPair(sourceIdx, targetIdx)
+
+	sourceShard, targetShard := sg.shards[sourceIdx], sg.shards[targetIdx]
+	if _, exists := sourceShard.edges[edge.ID]; exists {
+		return nil
+	}
+	sourceShard.edges[edge.ID] = edge
+	sourceShard.outgoing[edge.SourceID] = append(sourceShard.outgoing[edge.SourceID], edge)
+	targetShard.incoming[edge.TargetID] = append(targetShard.incoming[edge.TargetID], edge)
+	atomic.AddInt64(&sg.edgeCount, 1)
+	return nil
+}
+
+// lockPair acquires write locks on two shards in index order so
+// concurrent cross-shard operations cannot deadlock
+func (sg *ShardedHypergraph) lockPair(a, b int) {
+	if a == b {
+		sg.shards[a].mu.Lock()
+		return
+	}
+	if a > b {
+		a, b = b, a
+	}
+	sg.shards[a].mu.Lock()
+	sg.shards[b].mu.Lock()
+}
+
+func (sg *ShardedHypergraph) unlockPair(a, b int) {
+	if a == b {
+		sg.shards[a].mu.Unlock()
+		return
+	}
+	sg.shards[a].mu.Unlock()
+	sg.shards[b].mu.Unlock()
+}
+
+// GetOutgoingEdges returns edges originating from a node
+func (sg *ShardedHypergraph) GetOutgoingEdges(nodeID string) []*MemoryEdge {
+	shard := sg.shardFor(nodeID)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	return append([]*MemoryEdge(nil), shard.outgoing[nodeID]...)
+}
+
+// GetIncomingEdges returns edges pointing at a node
+func (sg *ShardedHypergraph) GetIncomingEdges(nodeID string) []*MemoryEdge {
+	shard := sg.shardFor(nodeID)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	return append([]*MemoryEdge(nil), shard.incoming[nodeID]...)
+}
+
+// RemoveNode removes a node and every edge touching it
+func (sg *ShardedHypergraph) RemoveNode(nodeID string) error {
+	shard := sg.shardFor(nodeID)
+	if _, existed := shard.nodes.LoadAndDelete(nodeID); !existed {
+		return fmt.Errorf("node not found: %s", nodeID)
+	}
+	atomic.AddInt64(&sg.nodeCount, -1)
+
+	// Collect incident edges, then unlink each with pairwise locking
+	shard.mu.RLock()
+	incident := make([]*MemoryEdge, 0, len(shard.outgoing[nodeID])+len(shard.incoming[nodeID]))
+	incident = append(incident, shard.outgoing[nodeID]...)
+	incident = append(incident, shard.incoming[nodeID]...)
+	shard.mu.RUnlock()
+
+	for _, edge := range incident {
+		sg.removeEdge(edge)
+	}
+	return nil
+}
+
+// removeEdge unlinks one edge from both endpoint shards
+func (sg *ShardedHypergraph) removeEdge(edge *MemoryEdge) {
+	sourceIdx, targetIdx := sg.shardIndex(edge.SourceID), sg.shardIndex(edge.TargetID)
+	sg.lockPair(sourceIdx, targetIdx)
+	defer sg.unlockPair(sourceIdx, targetIdx)
+
+	sourceShard, targetShard := sg.shards[sourceIdx], sg.shards[targetIdx]
+	if _, exists := sourceShard.edges[edge.ID]; !exists {
+		return
+	}
+	delete(sourceShard.edges, edge.ID)
+	sourceShard.outgoing[edge.SourceID] = removeEdgeRef(sourceShard.outgoing[edge.SourceID], edge.ID)
+	targetShard.incoming[edge.TargetID] = removeEdgeRef(targetShard.incoming[edge.TargetID], edge.ID)
+	atomic.AddInt64(&sg.edgeCount, -1)
+}
+
+func removeEdgeRef(edges []*MemoryEdge, edgeID string) []*MemoryEdge {
+	for i, edge := range edges {
+		if edge.ID == edgeID {
+			return append(edges[:i], edges[i+1:]...)
+		}
+	}
+	return edges
+}
+
+// GetNodeCount returns the number of nodes
+func (sg *ShardedHypergraph) GetNodeCount() int {
+	return int(atomic.LoadInt64(&sg.nodeCount))
+}
+
+// GetEdgeCount returns the number of edges
+func (sg *ShardedHypergraph) GetEdgeCount() int {
+	return int(atomic.LoadInt64(&sg.edgeCount))
+}
+
+// Snapshot captures the full graph for export or migration into a
+// HypergraphMemory. Taken shard by shard, so it is consistent per
+// shard but not across concurrent writers.
+func (sg *ShardedHypergraph) Snapshot() *GraphSnapshot {
+	snapshot := &GraphSnapshot{}
+	for _, shard := range sg.shards {
+		shard.nodes.Range(func(_, value interface{}) bool {
+			snapshot.Nodes = append(snapshot.Nodes, value.(*MemoryNode))
+			return true
+		})
+		shard.mu.RLock()
+		for _, edge := range shard.edges {
+			snapshot.Edges = append(snapshot.Edges, edge)
+		}
+		shard.mu.RUnlock()
+	}
+	sort.Slice(snapshot.Nodes, func(i, j int) bool {
+		return snapshot.Nodes[i].CreatedAt.Before(snapshot.Nodes[j].CreatedAt)
+	})
+	return snapshot
+}
+
+// LoadSnapshot bulk-loads a snapshot (e.g. exported from a
+// HypergraphMemory) into the sharded store
+func (sg *ShardedHypergraph) LoadSnapshot(snapshot *GraphSnapshot) error {
+	for _, node := range snapshot.Nodes {
+		if err := sg.AddNode(node); err != nil {
+			return err
+		}
+	}
+	for _, edge := range snapshot.Edges {
+		if err := sg.AddEdge(edge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
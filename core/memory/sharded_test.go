@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+// Benchmarks comparing the single-lock HypergraphMemory against the
+// sharded store under parallel mixed read/write load. Run with:
+//
+//	go test -bench Hypergraph -cpu 1,4,16 ./core/memory/
+const benchSeedNodes = 1000
+
+func seedNodeIDs() []string {
+	ids := make([]string, benchSeedNodes)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("bench-node-%d", i)
+	}
+	return ids
+}
+
+func BenchmarkHypergraphMemoryParallel(b *testing.B) {
+	hg := NewHypergraphMemory(nil)
+	ids := seedNodeIDs()
+	for _, id := range ids {
+		hg.AddNode(&MemoryNode{ID: id, Type: NodeConcept, Content: id})
+	}
+
+	var counter int64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			i := atomic.AddInt64(&counter, 1)
+			if i%10 == 0 {
+				hg.AddNode(&MemoryNode{
+					ID:      fmt.Sprintf("bench-extra-%d", i),
+					Type:    NodeEvent,
+					Content: "write",
+				})
+			} else {
+				hg.GetNode(ids[int(i)%len(ids)])
+			}
+		}
+	})
+}
+
+func BenchmarkShardedHypergraphParallel(b *testing.B) {
+	sg := NewShardedHypergraph(0)
+	ids := seedNodeIDs()
+	for _, id := range ids {
+		sg.AddNode(&MemoryNode{ID: id, Type: NodeConcept, Content: id})
+	}
+
+	var counter int64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			i := atomic.AddInt64(&counter, 1)
+			if i%10 == 0 {
+				sg.AddNode(&MemoryNode{
+					ID:      fmt.Sprintf("bench-extra-%d", i),
+					Type:    NodeEvent,
+					Content: "write",
+				})
+			} else {
+				sg.GetNode(ids[int(i)%len(ids)])
+			}
+		}
+	})
+}
+
+func TestShardedHypergraphBasics(t *testing.T) {
+	sg := NewShardedHypergraph(4)
+	a := &MemoryNode{ID: "a", Type: NodeConcept, Content: "a"}
+	c := &MemoryNode{ID: "b", Type: NodeConcept, Content: "b"}
+	if err := sg.AddNode(a); err != nil {
+		t.Fatalf("AddNode: %v", err)
+	}
+	if err := sg.AddNode(c); err != nil {
+		t.Fatalf("AddNode: %v", err)
+	}
+	if err := sg.AddEdge(&MemoryEdge{SourceID: "a", TargetID: "b", Type: EdgeSimilarTo}); err != nil {
+		t.Fatalf("AddEdge: %v", err)
+	}
+
+	if got := sg.GetNodeCount(); got != 2 {
+		t.Errorf("node count = %d, want 2", got)
+	}
+	if got := len(sg.GetOutgoingEdges("a")); got != 1 {
+		t.Errorf("outgoing edges = %d, want 1", got)
+	}
+	if got := len(sg.GetIncomingEdges("b")); got != 1 {
+		t.Errorf("incoming edges = %d, want 1", got)
+	}
+
+	if err := sg.RemoveNode("a"); err != nil {
+		t.Fatalf("RemoveNode: %v", err)
+	}
+	if got := sg.GetEdgeCount(); got != 0 {
+		t.Errorf("edge count after removal = %d, want 0", got)
+	}
+}
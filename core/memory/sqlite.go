@@ -0,0 +1,364 @@
+package memory
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// SQLite backend for the hypergraph: a durable single-binary
+// deployment option alongside the in-memory graph and Supabase. The
+// store satisfies GraphPersistence, so it drops into
+// NewHypergraphMemory unchanged; LoadGraph rehydrates a graph from
+// disk on startup.
+
+// SQLiteGraphStore persists hypergraph contents to a local SQLite
+// database
+type SQLiteGraphStore struct {
+	mu     sync.Mutex
+	db     *sql.DB
+	dbPath string
+}
+
+// NewSQLiteGraphStore opens (or creates) a hypergraph database at
+// dbPath
+func NewSQLiteGraphStore(dbPath string) (*SQLiteGraphStore, error) {
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// SQLite works best with a single writer connection
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	store := &SQLiteGraphStore{db: db, dbPath: dbPath}
+	if err := store.initSchema(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize schema: %w", err)
+	}
+	return store, nil
+}
+
+// initSchema creates the hypergraph tables if they don't exist
+func (s *SQLiteGraphStore) initSchema() error {
+	schema := `
+	CREATE TABLE IF NOT EXISTS hypergraph_nodes (
+		id TEXT PRIMARY KEY,
+		type TEXT NOT NULL,
+		content TEXT NOT NULL,
+		embedding TEXT,
+		importance REAL DEFAULT 0.5,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS hypergraph_edges (
+		id TEXT PRIMARY KEY,
+		source_id TEXT NOT NULL,
+		target_id TEXT NOT NULL,
+		type TEXT NOT NULL,
+		weight REAL DEFAULT 1.0,
+		created_at DATETIME NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS hypergraph_hyperedges (
+		id TEXT PRIMARY KEY,
+		type TEXT NOT NULL,
+		created_at DATETIME NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS hypergraph_memberships (
+		hyperedge_id TEXT NOT NULL,
+		node_id TEXT NOT NULL,
+		position INTEGER NOT NULL,
+		PRIMARY KEY (hyperedge_id, node_id)
+	);
+
+	CREATE TABLE IF NOT EXISTS hypergraph_attributes (
+		owner_id TEXT NOT NULL,
+		key TEXT NOT NULL,
+		value TEXT NOT NULL,
+		PRIMARY KEY (owner_id, key)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_hg_nodes_type ON hypergraph_nodes(type);
+	CREATE INDEX IF NOT EXISTS idx_hg_edges_source ON hypergraph_edges(source_id);
+	CREATE INDEX IF NOT EXISTS idx_hg_edges_target ON hypergraph_edges(target_id);
+	CREATE INDEX IF NOT EXISTS idx_hg_memberships_node ON hypergraph_memberships(node_id);
+	`
+
+	_, err := s.db.Exec(schema)
+	return err
+}
+
+// StoreNode implements GraphPersistence
+func (s *SQLiteGraphStore) StoreNode(node *MemoryNode) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var embedding []byte
+	if len(node.Embedding) > 0 {
+		var err error
+		embedding, err = json.Marshal(node.Embedding)
+		if err != nil {
+			return fmt.Errorf("failed to marshal embedding: %w", err)
+		}
+	}
+
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO hypergraph_nodes (id, type, content, embedding, importance, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		node.ID, string(node.Type), node.Content, string(embedding),
+		node.Importance, node.CreatedAt, node.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store node: %w", err)
+	}
+
+	return s.storeAttributes(node.ID, node.Metadata)
+}
+
+// StoreEdge implements GraphPersistence
+func (s *SQLiteGraphStore) StoreEdge(edge *MemoryEdge) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO hypergraph_edges (id, source_id, target_id, type, weight, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		edge.ID, edge.SourceID, edge.TargetID, string(edge.Type), edge.Weight, edge.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store edge: %w", err)
+	}
+
+	return s.storeAttributes(edge.ID, edge.Metadata)
+}
+
+// StoreHyperEdge persists a multi-way relationship and its memberships
+func (s *SQLiteGraphStore) StoreHyperEdge(hyperedge *HyperEdge) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`
+		INSERT OR REPLACE INTO hypergraph_hyperedges (id, type, created_at)
+		VALUES (?, ?, ?)`,
+		hyperedge.ID, hyperedge.Type, hyperedge.CreatedAt); err != nil {
+		return fmt.Errorf("failed to store hyperedge: %w", err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM hypergraph_memberships WHERE hyperedge_id = ?`, hyperedge.ID); err != nil {
+		return fmt.Errorf("failed to clear memberships: %w", err)
+	}
+	for position, nodeID := range hyperedge.NodeIDs {
+		if _, err := tx.Exec(`
+			INSERT INTO hypergraph_memberships (hyperedge_id, node_id, position)
+			VALUES (?, ?, ?)`,
+			hyperedge.ID, nodeID, position); err != nil {
+			return fmt.Errorf("failed to store membership: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit hyperedge: %w", err)
+	}
+	return s.storeAttributes(hyperedge.ID, hyperedge.Metadata)
+}
+
+// storeAttributes replaces the metadata rows for one owner; callers
+// hold the lock
+func (s *SQLiteGraphStore) storeAttributes(ownerID string, metadata map[string]interface{}) error {
+	if _, err := s.db.Exec(`DELETE FROM hypergraph_attributes WHERE owner_id = ?`, ownerID); err != nil {
+		return fmt.Errorf("failed to clear attributes: %w", err)
+	}
+	for key, value := range metadata {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to marshal attribute %s: %w", key, err)
+		}
+		if _, err := s.db.Exec(`
+			INSERT INTO hypergraph_attributes (owner_id, key, value)
+			VALUES (?, ?, ?)`,
+			ownerID, key, string(encoded)); err != nil {
+			return fmt.Errorf("failed to store attribute %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// loadAttributes reads the metadata rows for one owner
+func (s *SQLiteGraphStore) loadAttributes(ownerID string) (map[string]interface{}, error) {
+	rows, err := s.db.Query(`SELECT key, value FROM hypergraph_attributes WHERE owner_id = ?`, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attributes: %w", err)
+	}
+	defer rows.Close()
+
+	metadata := make(map[string]interface{})
+	for rows.Next() {
+		var key, encoded string
+		if err := rows.Scan(&key, &encoded); err != nil {
+			return nil, fmt.Errorf("failed to scan attribute: %w", err)
+		}
+		var value interface{}
+		if err := json.Unmarshal([]byte(encoded), &value); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal attribute %s: %w", key, err)
+		}
+		metadata[key] = value
+	}
+	return metadata, rows.Err()
+}
+
+// LoadGraph reads the full database contents into a snapshot, which
+// can be restored into a HypergraphMemory on startup
+func (s *SQLiteGraphStore) LoadGraph() (*GraphSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := &GraphSnapshot{
+		Nodes:      make([]*MemoryNode, 0),
+		Edges:      make([]*MemoryEdge, 0),
+		HyperEdges: make([]*HyperEdge, 0),
+	}
+
+	nodeRows, err := s.db.Query(`
+		SELECT id, type, content, embedding, importance, created_at, updated_at
+		FROM hypergraph_nodes ORDER BY created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query nodes: %w", err)
+	}
+	for nodeRows.Next() {
+		node := &MemoryNode{}
+		var nodeType, embedding string
+		if err := nodeRows.Scan(&node.ID, &nodeType, &node.Content, &embedding,
+			&node.Importance, &node.CreatedAt, &node.UpdatedAt); err != nil {
+			nodeRows.Close()
+			return nil, fmt.Errorf("failed to scan node: %w", err)
+		}
+		node.Type = NodeType(nodeType)
+		if embedding != "" {
+			if err := json.Unmarshal([]byte(embedding), &node.Embedding); err != nil {
+				nodeRows.Close()
+				return nil, fmt.Errorf("failed to unmarshal embedding for %s: %w", node.ID, err)
+			}
+		}
+		snapshot.Nodes = append(snapshot.Nodes, node)
+	}
+	nodeRows.Close()
+
+	edgeRows, err := s.db.Query(`
+		SELECT id, source_id, target_id, type, weight, created_at
+		FROM hypergraph_edges ORDER BY created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query edges: %w", err)
+	}
+	for edgeRows.Next() {
+		edge := &MemoryEdge{}
+		var edgeType string
+		if err := edgeRows.Scan(&edge.ID, &edge.SourceID, &edge.TargetID,
+			&edgeType, &edge.Weight, &edge.CreatedAt); err != nil {
+			edgeRows.Close()
+			return nil, fmt.Errorf("failed to scan edge: %w", err)
+		}
+		edge.Type = EdgeType(edgeType)
+		snapshot.Edges = append(snapshot.Edges, edge)
+	}
+	edgeRows.Close()
+
+	hyperRows, err := s.db.Query(`
+		SELECT id, type, created_at FROM hypergraph_hyperedges ORDER BY created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hyperedges: %w", err)
+	}
+	for hyperRows.Next() {
+		hyperedge := &HyperEdge{}
+		if err := hyperRows.Scan(&hyperedge.ID, &hyperedge.Type, &hyperedge.CreatedAt); err != nil {
+			hyperRows.Close()
+			return nil, fmt.Errorf("failed to scan hyperedge: %w", err)
+		}
+		snapshot.HyperEdges = append(snapshot.HyperEdges, hyperedge)
+	}
+	hyperRows.Close()
+
+	// Fill in memberships and metadata after the cursor queries finish
+	for _, hyperedge := range snapshot.HyperEdges {
+		memberRows, err := s.db.Query(`
+			SELECT node_id FROM hypergraph_memberships
+			WHERE hyperedge_id = ? ORDER BY position`, hyperedge.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query memberships: %w", err)
+		}
+		for memberRows.Next() {
+			var nodeID string
+			if err := memberRows.Scan(&nodeID); err != nil {
+				memberRows.Close()
+				return nil, fmt.Errorf("failed to scan membership: %w", err)
+			}
+			hyperedge.NodeIDs = append(hyperedge.NodeIDs, nodeID)
+		}
+		memberRows.Close()
+
+		if hyperedge.Metadata, err = s.loadAttributes(hyperedge.ID); err != nil {
+			return nil, err
+		}
+	}
+	for _, node := range snapshot.Nodes {
+		if node.Metadata, err = s.loadAttributes(node.ID); err != nil {
+			return nil, err
+		}
+	}
+	for _, edge := range snapshot.Edges {
+		if edge.Metadata, err = s.loadAttributes(edge.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshot, nil
+}
+
+// GetStats returns row counts and database size
+func (s *SQLiteGraphStore) GetStats() (map[string]interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := map[string]interface{}{"db_path": s.dbPath}
+
+	counts := map[string]string{
+		"node_count":      "hypergraph_nodes",
+		"edge_count":      "hypergraph_edges",
+		"hyperedge_count": "hypergraph_hyperedges",
+	}
+	for key, table := range counts {
+		var count int
+		if err := s.db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count); err == nil {
+			stats[key] = count
+		}
+	}
+
+	var pageCount, pageSize int64
+	s.db.QueryRow("PRAGMA page_count").Scan(&pageCount)
+	s.db.QueryRow("PRAGMA page_size").Scan(&pageSize)
+	stats["db_size_bytes"] = pageCount * pageSize
+
+	return stats, nil
+}
+
+// Close closes the database connection
+func (s *SQLiteGraphStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.db.Close()
+}
@@ -0,0 +1,196 @@
+package memory
+
+import (
+	"sync"
+	"time"
+)
+
+// Change subscriptions: the consciousness stream reacts to memories
+// written by the dream cycle without polling. Listeners register a
+// filter and receive insert/remove events on a buffered channel; a
+// slow listener drops events rather than stalling graph mutations.
+
+// Change operations
+const (
+	ChangeInsert = "insert"
+	ChangeRemove = "remove"
+)
+
+// ChangeEvent is one graph mutation delivered to subscribers
+type ChangeEvent struct {
+	Op        string      `json:"op"`   // "insert" or "remove"
+	Kind      string      `json:"kind"` // "node", "edge", "hyperedge"
+	Timestamp time.Time   `json:"timestamp"`
+	Node      *MemoryNode `json:"node,omitempty"`
+	Edge      *MemoryEdge `json:"edge,omitempty"`
+	HyperEdge *HyperEdge  `json:"hyperedge,omitempty"`
+}
+
+// ChangeFilter narrows which events a subscriber receives; zero-value
+// fields match everything
+type ChangeFilter struct {
+	// Ops restricts to specific operations
+	Ops []string
+	// Kinds restricts to "node", "edge", or "hyperedge" events
+	Kinds []string
+	// NodeTypes restricts node events to specific types
+	NodeTypes []NodeType
+	// EdgeTypes restricts edge events to specific types
+	EdgeTypes []EdgeType
+}
+
+// matches reports whether an event passes the filter
+func (f ChangeFilter) matches(event ChangeEvent) bool {
+	if len(f.Ops) > 0 && !containsString(f.Ops, event.Op) {
+		return false
+	}
+	if len(f.Kinds) > 0 && !containsString(f.Kinds, event.Kind) {
+		return false
+	}
+	if len(f.NodeTypes) > 0 {
+		if event.Node == nil || !containsNodeType(f.NodeTypes, event.Node.Type) {
+			return false
+		}
+	}
+	if len(f.EdgeTypes) > 0 {
+		if event.Edge == nil || !containsEdgeType(f.EdgeTypes, event.Edge.Type) {
+			return false
+		}
+	}
+	return true
+}
+
+// Subscription is one registered listener
+type Subscription struct {
+	id      uint64
+	filter  ChangeFilter
+	events  chan ChangeEvent
+	hub     *subscriptionHub
+	dropped uint64
+}
+
+// Events returns the channel delivering matching change events
+func (sub *Subscription) Events() <-chan ChangeEvent {
+	return sub.events
+}
+
+// Dropped returns the number of events lost because the subscriber
+// fell behind
+func (sub *Subscription) Dropped() uint64 {
+	sub.hub.mu.RLock()
+	defer sub.hub.mu.RUnlock()
+	return sub.dropped
+}
+
+// Cancel removes the subscription and closes its channel
+func (sub *Subscription) Cancel() {
+	sub.hub.remove(sub.id)
+}
+
+// subscriptionHub fans mutations out to subscribers
+type subscriptionHub struct {
+	mu     sync.RWMutex
+	nextID uint64
+	subs   map[uint64]*Subscription
+}
+
+func newSubscriptionHub() *subscriptionHub {
+	return &subscriptionHub{subs: make(map[uint64]*Subscription)}
+}
+
+func (hub *subscriptionHub) add(filter ChangeFilter, buffer int) *Subscription {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	hub.nextID++
+	sub := &Subscription{
+		id:     hub.nextID,
+		filter: filter,
+		events: make(chan ChangeEvent, buffer),
+		hub:    hub,
+	}
+	hub.subs[sub.id] = sub
+	return sub
+}
+
+func (hub *subscriptionHub) remove(id uint64) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if sub, exists := hub.subs[id]; exists {
+		delete(hub.subs, id)
+		close(sub.events)
+	}
+}
+
+// publish delivers an event to every matching subscriber without
+// blocking
+func (hub *subscriptionHub) publish(event ChangeEvent) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	for _, sub := range hub.subs {
+		if !sub.filter.matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			sub.dropped++
+		}
+	}
+}
+
+// Subscribe registers a change listener with a default buffer of 64
+// events
+func (hg *HypergraphMemory) Subscribe(filter ChangeFilter) *Subscription {
+	return hg.SubscribeBuffered(filter, 64)
+}
+
+// SubscribeBuffered registers a change listener with an explicit
+// channel buffer size
+func (hg *HypergraphMemory) SubscribeBuffered(filter ChangeFilter, buffer int) *Subscription {
+	hg.mu.Lock()
+	if hg.subscriptions == nil {
+		hg.subscriptions = newSubscriptionHub()
+	}
+	hub := hg.subscriptions
+	hg.mu.Unlock()
+
+	if buffer <= 0 {
+		buffer = 64
+	}
+	return hub.add(filter, buffer)
+}
+
+// notifyChange publishes a mutation to subscribers; runs outside the
+// graph lock alongside WAL logging
+func (hg *HypergraphMemory) notifyChange(event ChangeEvent) {
+	hg.mu.RLock()
+	hub := hg.subscriptions
+	hg.mu.RUnlock()
+	if hub == nil {
+		return
+	}
+
+	event.Timestamp = time.Now()
+	hub.publish(event)
+}
+
+func containsString(list []string, target string) bool {
+	for _, s := range list {
+		if s == target {
+			return true
+		}
+	}
+	return false
+}
+
+func containsEdgeType(types []EdgeType, target EdgeType) bool {
+	for _, t := range types {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
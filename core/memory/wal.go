@@ -0,0 +1,276 @@
+package memory
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Write-ahead log for hypergraph mutations. Every AddNode/AddEdge/
+// AddHyperEdge is appended and fsynced before the call returns, so a
+// kill -9 mid-consolidation loses at most the mutation in flight.
+// Recovery on startup replays a checkpoint snapshot plus the log tail;
+// periodic compaction folds the log back into the checkpoint to keep
+// replay time bounded.
+
+const (
+	walLogName        = "wal.log"
+	walCheckpointName = "checkpoint.json"
+	// walCompactThreshold is the number of logged mutations that
+	// triggers automatic compaction
+	walCompactThreshold = 10000
+)
+
+// walEntry is one logged mutation
+type walEntry struct {
+	Seq       uint64      `json:"seq"`
+	Op        string      `json:"op"` // "add_node", "add_edge", "add_hyperedge"
+	Timestamp time.Time   `json:"timestamp"`
+	Node      *MemoryNode `json:"node,omitempty"`
+	Edge      *MemoryEdge `json:"edge,omitempty"`
+	HyperEdge *HyperEdge  `json:"hyperedge,omitempty"`
+}
+
+// WriteAheadLog persists hypergraph mutations to an append-only file
+type WriteAheadLog struct {
+	mu      sync.Mutex
+	dir     string
+	file    *os.File
+	seq     uint64
+	pending int // entries since last compaction
+}
+
+// openWAL opens (or creates) the log file in dir
+func openWAL(dir string) (*WriteAheadLog, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create WAL directory: %w", err)
+	}
+
+	file, err := os.OpenFile(filepath.Join(dir, walLogName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open WAL: %w", err)
+	}
+
+	return &WriteAheadLog{dir: dir, file: file}, nil
+}
+
+// append durably writes one entry, returning the number of entries
+// logged since the last compaction
+func (wal *WriteAheadLog) append(entry walEntry) (int, error) {
+	wal.mu.Lock()
+	defer wal.mu.Unlock()
+
+	wal.seq++
+	entry.Seq = wal.seq
+	entry.Timestamp = time.Now()
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return wal.pending, fmt.Errorf("failed to marshal WAL entry: %w", err)
+	}
+	if _, err := wal.file.Write(append(data, '\n')); err != nil {
+		return wal.pending, fmt.Errorf("failed to write WAL entry: %w", err)
+	}
+	if err := wal.file.Sync(); err != nil {
+		return wal.pending, fmt.Errorf("failed to sync WAL: %w", err)
+	}
+
+	wal.pending++
+	return wal.pending, nil
+}
+
+// Close flushes and closes the log file
+func (wal *WriteAheadLog) Close() error {
+	wal.mu.Lock()
+	defer wal.mu.Unlock()
+	return wal.file.Close()
+}
+
+// EnableWAL attaches a write-ahead log to the graph, recovering any
+// state found in dir first. Call before the graph starts receiving
+// mutations.
+func (hg *HypergraphMemory) EnableWAL(dir string) error {
+	hg.mu.Lock()
+	if hg.wal != nil {
+		hg.mu.Unlock()
+		return fmt.Errorf("WAL already enabled")
+	}
+	hg.mu.Unlock()
+
+	// Recover before attaching, so replayed mutations are not re-logged
+	recovered, err := hg.recoverWAL(dir)
+	if err != nil {
+		return fmt.Errorf("WAL recovery failed: %w", err)
+	}
+
+	wal, err := openWAL(dir)
+	if err != nil {
+		return err
+	}
+
+	hg.mu.Lock()
+	hg.wal = wal
+	hg.mu.Unlock()
+
+	if recovered > 0 {
+		fmt.Printf("💾 WAL: recovered %d mutation(s) from %s\n", recovered, dir)
+	}
+	return nil
+}
+
+// recoverWAL loads the checkpoint snapshot and replays the log tail;
+// returns the number of replayed log entries
+func (hg *HypergraphMemory) recoverWAL(dir string) (int, error) {
+	checkpointPath := filepath.Join(dir, walCheckpointName)
+	if file, err := os.Open(checkpointPath); err == nil {
+		importErr := hg.ImportJSON(file)
+		file.Close()
+		if importErr != nil {
+			return 0, fmt.Errorf("failed to load checkpoint: %w", importErr)
+		}
+	} else if !os.IsNotExist(err) {
+		return 0, fmt.Errorf("failed to open checkpoint: %w", err)
+	}
+
+	logPath := filepath.Join(dir, walLogName)
+	file, err := os.Open(logPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to open WAL: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	replayed := 0
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry walEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			// A torn final write from a crash is expected; stop there
+			fmt.Printf("⚠️ WAL: stopping replay at corrupt entry after seq %d\n", entry.Seq)
+			break
+		}
+
+		switch entry.Op {
+		case "add_node":
+			if entry.Node != nil {
+				if err := hg.AddNode(entry.Node); err != nil {
+					return replayed, fmt.Errorf("failed to replay node %s: %w", entry.Node.ID, err)
+				}
+			}
+		case "add_edge":
+			if entry.Edge != nil {
+				if err := hg.AddEdge(entry.Edge); err != nil {
+					return replayed, fmt.Errorf("failed to replay edge %s: %w", entry.Edge.ID, err)
+				}
+			}
+		case "add_hyperedge":
+			if entry.HyperEdge != nil {
+				if err := hg.AddHyperEdge(entry.HyperEdge); err != nil {
+					return replayed, fmt.Errorf("failed to replay hyperedge %s: %w", entry.HyperEdge.ID, err)
+				}
+			}
+		case "remove_node":
+			if entry.Node != nil {
+				// Ignore missing nodes: the removal may precede a
+				// checkpoint that already excludes them
+				hg.RemoveNode(entry.Node.ID)
+			}
+		}
+		replayed++
+	}
+	if err := scanner.Err(); err != nil {
+		return replayed, fmt.Errorf("failed to read WAL: %w", err)
+	}
+	return replayed, nil
+}
+
+// CompactWAL writes a fresh checkpoint snapshot and truncates the log.
+// The checkpoint is written to a temp file and renamed so a crash
+// during compaction leaves the previous checkpoint intact.
+func (hg *HypergraphMemory) CompactWAL() error {
+	hg.mu.RLock()
+	wal := hg.wal
+	hg.mu.RUnlock()
+	if wal == nil {
+		return fmt.Errorf("WAL not enabled")
+	}
+
+	wal.mu.Lock()
+	defer wal.mu.Unlock()
+
+	tmpPath := filepath.Join(wal.dir, walCheckpointName+".tmp")
+	tmp, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to create checkpoint: %w", err)
+	}
+	if err := hg.ExportJSON(tmp); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to sync checkpoint: %w", err)
+	}
+	tmp.Close()
+
+	if err := os.Rename(tmpPath, filepath.Join(wal.dir, walCheckpointName)); err != nil {
+		return fmt.Errorf("failed to install checkpoint: %w", err)
+	}
+
+	// Truncate the log now that its contents live in the checkpoint
+	wal.file.Close()
+	file, err := os.OpenFile(filepath.Join(wal.dir, walLogName), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to truncate WAL: %w", err)
+	}
+	wal.file = file
+	compacted := wal.pending
+	wal.pending = 0
+
+	fmt.Printf("💾 WAL: compacted %d entry(ies) into checkpoint\n", compacted)
+	return nil
+}
+
+// logMutation appends a mutation when the WAL is enabled. Runs outside
+// the graph lock: the Add* methods apply the mutation first, then log,
+// so compaction (which snapshots the graph while holding the WAL lock)
+// never truncates an entry the checkpoint missed.
+func (hg *HypergraphMemory) logMutation(entry walEntry) error {
+	hg.mu.RLock()
+	wal := hg.wal
+	hg.mu.RUnlock()
+	if wal == nil {
+		return nil
+	}
+
+	pending, err := wal.append(entry)
+	if err != nil {
+		return err
+	}
+
+	// Trigger compaction in the background once the log grows large;
+	// CompactWAL takes its own locks, so it must not run inline here
+	if pending >= walCompactThreshold {
+		go func() {
+			if err := hg.CompactWAL(); err != nil {
+				fmt.Printf("⚠️ WAL: compaction failed: %v\n", err)
+			}
+		}()
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+package scheme
+
+import (
+	"fmt"
+
+	"github.com/EchoCog/echollama/core/ecco9"
+	"github.com/EchoCog/echollama/core/memory"
+)
+
+// BindHypergraph registers Scheme primitives for querying and
+// mutating the hypergraph memory, giving the symbolic layer direct
+// access to the sub-symbolic substrate:
+//
+//	(graph-add-node "thought" "content" 0.7)  => node id
+//	(graph-node "id")                          => (id type content importance)
+//	(graph-link "src" "dst" "leads_to" 0.8)   => edge id
+//	(graph-nodes-of-type "concept")            => list of node ids
+//	(graph-neighbors "id")                     => list of target node ids
+//	(graph-node-count) / (graph-edge-count)
+func (sm *SchemeMetamodel) BindHypergraph(hg *memory.HypergraphMemory) {
+	sm.environment.Define("graph-add-node", &Primitive{
+		Name: "graph-add-node",
+		Fn: func(args []Value) (Value, error) {
+			if len(args) < 2 || len(args) > 3 {
+				return nil, fmt.Errorf("graph-add-node requires type, content, and optional importance")
+			}
+			nodeType, err := stringArg("graph-add-node", args, 0)
+			if err != nil {
+				return nil, err
+			}
+			content, err := stringArg("graph-add-node", args, 1)
+			if err != nil {
+				return nil, err
+			}
+			importance := 0.5
+			if len(args) == 3 {
+				num, ok := args[2].(*Number)
+				if !ok {
+					return nil, fmt.Errorf("graph-add-node importance must be a number")
+				}
+				importance = num.Value
+			}
+
+			node := &memory.MemoryNode{
+				Type:       memory.NodeType(nodeType),
+				Content:    content,
+				Metadata:   map[string]interface{}{"source": "scheme"},
+				Importance: importance,
+			}
+			if err := hg.AddNode(node); err != nil {
+				return nil, err
+			}
+			return &String{Value: node.ID}, nil
+		},
+	})
+
+	sm.environment.Define("graph-node", &Primitive{
+		Name: "graph-node",
+		Fn: func(args []Value) (Value, error) {
+			id, err := stringArg("graph-node", args, 0)
+			if err != nil {
+				return nil, err
+			}
+			node, err := hg.GetNode(id)
+			if err != nil {
+				return nil, err
+			}
+			return listToCons([]Value{
+				&String{Value: node.ID},
+				&String{Value: string(node.Type)},
+				&String{Value: node.Content},
+				&Number{Value: node.Importance},
+			}), nil
+		},
+	})
+
+	sm.environment.Define("graph-link", &Primitive{
+		Name: "graph-link",
+		Fn: func(args []Value) (Value, error) {
+			if len(args) < 3 || len(args) > 4 {
+				return nil, fmt.Errorf("graph-link requires source, target, edge type, and optional weight")
+			}
+			sourceID, err := stringArg("graph-link", args, 0)
+			if err != nil {
+				return nil, err
+			}
+			targetID, err := stringArg("graph-link", args, 1)
+			if err != nil {
+				return nil, err
+			}
+			edgeType, err := stringArg("graph-link", args, 2)
+			if err != nil {
+				return nil, err
+			}
+			weight := 0.5
+			if len(args) == 4 {
+				num, ok := args[3].(*Number)
+				if !ok {
+					return nil, fmt.Errorf("graph-link weight must be a number")
+				}
+				weight = num.Value
+			}
+
+			edge := &memory.MemoryEdge{
+				SourceID: sourceID,
+				TargetID: targetID,
+				Type:     memory.EdgeType(edgeType),
+				Weight:   weight,
+			}
+			if err := hg.AddEdge(edge); err != nil {
+				return nil, err
+			}
+			return &String{Value: edge.ID}, nil
+		},
+	})
+
+	sm.environment.Define("graph-nodes-of-type", &Primitive{
+		Name: "graph-nodes-of-type",
+		Fn: func(args []Value) (Value, error) {
+			nodeType, err := stringArg("graph-nodes-of-type", args, 0)
+			if err != nil {
+				return nil, err
+			}
+			nodes := hg.GetNodesByType(memory.NodeType(nodeType))
+			ids := make([]Value, len(nodes))
+			for i, node := range nodes {
+				ids[i] = &String{Value: node.ID}
+			}
+			return listToCons(ids), nil
+		},
+	})
+
+	sm.environment.Define("graph-neighbors", &Primitive{
+		Name: "graph-neighbors",
+		Fn: func(args []Value) (Value, error) {
+			id, err := stringArg("graph-neighbors", args, 0)
+			if err != nil {
+				return nil, err
+			}
+			edges := hg.GetOutgoingEdges(id)
+			targets := make([]Value, len(edges))
+			for i, edge := range edges {
+				targets[i] = &String{Value: edge.TargetID}
+			}
+			return listToCons(targets), nil
+		},
+	})
+
+	sm.environment.Define("graph-node-count", &Primitive{
+		Name: "graph-node-count",
+		Fn: func(args []Value) (Value, error) {
+			return &Number{Value: float64(hg.GetNodeCount())}, nil
+		},
+	})
+
+	sm.environment.Define("graph-edge-count", &Primitive{
+		Name: "graph-edge-count",
+		Fn: func(args []Value) (Value, error) {
+			return &Number{Value: float64(hg.GetEdgeCount())}, nil
+		},
+	})
+}
+
+// BindPlatform registers Scheme primitives for issuing ecco9 device
+// commands, so symbolic reasoning can act on the cognitive hardware:
+//
+//	(device-list)                => list of device ids
+//	(device-write "id" "data")   => bytes written
+//	(device-read "id" 256)       => string read from device
+//	(npu-infer "prompt")         => inference via the first LLM device
+func (sm *SchemeMetamodel) BindPlatform(platform *ecco9.Platform) {
+	sm.environment.Define("device-list", &Primitive{
+		Name: "device-list",
+		Fn: func(args []Value) (Value, error) {
+			ids := make([]Value, 0, len(platform.Devices))
+			for id := range platform.Devices {
+				ids = append(ids, &String{Value: id})
+			}
+			return listToCons(ids), nil
+		},
+	})
+
+	sm.environment.Define("device-write", &Primitive{
+		Name: "device-write",
+		Fn: func(args []Value) (Value, error) {
+			id, err := stringArg("device-write", args, 0)
+			if err != nil {
+				return nil, err
+			}
+			data, err := stringArg("device-write", args, 1)
+			if err != nil {
+				return nil, err
+			}
+			device, err := platform.GetDevice(id)
+			if err != nil {
+				return nil, err
+			}
+			n, err := device.Write([]byte(data))
+			if err != nil {
+				return nil, err
+			}
+			return &Number{Value: float64(n)}, nil
+		},
+	})
+
+	sm.environment.Define("device-read", &Primitive{
+		Name: "device-read",
+		Fn: func(args []Value) (Value, error) {
+			id, err := stringArg("device-read", args, 0)
+			if err != nil {
+				return nil, err
+			}
+			size := 4096.0
+			if len(args) > 1 {
+				num, ok := args[1].(*Number)
+				if !ok {
+					return nil, fmt.Errorf("device-read size must be a number")
+				}
+				size = num.Value
+			}
+			device, err := platform.GetDevice(id)
+			if err != nil {
+				return nil, err
+			}
+			buffer := make([]byte, int(size))
+			n, err := device.Read(buffer)
+			if err != nil {
+				return nil, err
+			}
+			return &String{Value: string(buffer[:n])}, nil
+		},
+	})
+
+	sm.environment.Define("npu-infer", &Primitive{
+		Name: "npu-infer",
+		Fn: func(args []Value) (Value, error) {
+			prompt, err := stringArg("npu-infer", args, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			device := firstDeviceOfType(platform, ecco9.DeviceTypeLLM)
+			if device == nil {
+				return nil, fmt.Errorf("no LLM device registered")
+			}
+
+			if _, err := device.Write([]byte(prompt)); err != nil {
+				return nil, fmt.Errorf("inference write failed: %w", err)
+			}
+
+			buffer := make([]byte, 64*1024)
+			n, err := device.Read(buffer)
+			if err != nil {
+				return nil, fmt.Errorf("inference read failed: %w", err)
+			}
+			return &String{Value: string(buffer[:n])}, nil
+		},
+	})
+}
+
+// firstDeviceOfType finds a registered device of the given type
+func firstDeviceOfType(platform *ecco9.Platform, deviceType ecco9.DeviceType) ecco9.CognitiveDevice {
+	for _, device := range platform.Devices {
+		if device.GetType() == deviceType {
+			return device
+		}
+	}
+	return nil
+}
+
+// stringArg extracts a required string argument for a primitive
+func stringArg(name string, args []Value, index int) (string, error) {
+	if index >= len(args) {
+		return "", fmt.Errorf("%s requires at least %d arguments", name, index+1)
+	}
+	str, ok := args[index].(*String)
+	if !ok {
+		return "", fmt.Errorf("%s argument %d must be a string", name, index+1)
+	}
+	return str.Value, nil
+}
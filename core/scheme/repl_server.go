@@ -0,0 +1,238 @@
+package scheme
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// REPL resource limits, bounding what a single operator session can do
+const (
+	// MaxExpressionLength bounds one input expression
+	MaxExpressionLength = 64 * 1024
+
+	// DefaultEvalTimeout bounds one evaluation
+	DefaultEvalTimeout = 5 * time.Second
+
+	// MaxSessions bounds concurrent TCP sessions
+	MaxSessions = 16
+)
+
+// REPLSession is one interactive session against the metamodel.
+// Each session evaluates in its own child environment, so session
+// defines are isolated while shared cognitive bindings stay visible.
+type REPLSession struct {
+	id  int
+	env *Environment
+	sm  *SchemeMetamodel
+}
+
+// REPLServer exposes the Scheme metamodel interactively over stdin
+// and/or a TCP endpoint so operators can inspect and script the
+// running cognitive state symbolically.
+type REPLServer struct {
+	mu sync.Mutex
+	sm *SchemeMetamodel
+
+	listener    net.Listener
+	evalTimeout time.Duration
+
+	sessions    int
+	nextSession int
+	running     bool
+}
+
+// NewREPLServer creates a REPL server over the given metamodel
+func NewREPLServer(sm *SchemeMetamodel) *REPLServer {
+	return &REPLServer{
+		sm:          sm,
+		evalTimeout: DefaultEvalTimeout,
+	}
+}
+
+// SetEvalTimeout overrides the per-evaluation time limit
+func (rs *REPLServer) SetEvalTimeout(d time.Duration) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if d > 0 {
+		rs.evalTimeout = d
+	}
+}
+
+// ServeStdin runs an interactive REPL on stdin/stdout, returning when
+// input is exhausted or the operator enters ,quit
+func (rs *REPLServer) ServeStdin() error {
+	session := rs.newSession()
+	fmt.Println("🎭 Scheme REPL — cognitive grammar kernel (,quit to exit)")
+	return rs.serve(session, os.Stdin, os.Stdout)
+}
+
+// ListenTCP starts accepting REPL sessions on a TCP address
+func (rs *REPLServer) ListenTCP(addr string) error {
+	rs.mu.Lock()
+	if rs.running {
+		rs.mu.Unlock()
+		return fmt.Errorf("REPL server already running")
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		rs.mu.Unlock()
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	rs.listener = listener
+	rs.running = true
+	rs.mu.Unlock()
+
+	fmt.Printf("🎭 Scheme REPL: listening on %s\n", listener.Addr())
+
+	go rs.acceptLoop(listener)
+	return nil
+}
+
+// Stop closes the TCP listener; in-flight sessions finish their
+// current evaluation and then disconnect
+func (rs *REPLServer) Stop() {
+	rs.mu.Lock()
+	listener := rs.listener
+	rs.listener = nil
+	rs.running = false
+	rs.mu.Unlock()
+
+	if listener != nil {
+		listener.Close()
+	}
+}
+
+// acceptLoop admits TCP sessions up to the session limit
+func (rs *REPLServer) acceptLoop(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		rs.mu.Lock()
+		if rs.sessions >= MaxSessions {
+			rs.mu.Unlock()
+			fmt.Fprintln(conn, "; too many sessions, try again later")
+			conn.Close()
+			continue
+		}
+		rs.sessions++
+		rs.mu.Unlock()
+
+		go func(conn net.Conn) {
+			defer func() {
+				conn.Close()
+				rs.mu.Lock()
+				rs.sessions--
+				rs.mu.Unlock()
+			}()
+
+			session := rs.newSession()
+			fmt.Fprintf(conn, "; Scheme REPL session %d (,quit to exit)\n", session.id)
+			rs.serve(session, conn, conn)
+		}(conn)
+	}
+}
+
+// newSession creates an isolated session environment layered over the
+// shared metamodel environment
+func (rs *REPLServer) newSession() *REPLSession {
+	rs.mu.Lock()
+	rs.nextSession++
+	id := rs.nextSession
+	rs.mu.Unlock()
+
+	return &REPLSession{
+		id:  id,
+		env: NewEnvironment(rs.sm.environment),
+		sm:  rs.sm,
+	}
+}
+
+// serve runs the read-eval-print loop for one session
+func (rs *REPLServer) serve(session *REPLSession, in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 4096), MaxExpressionLength)
+
+	fmt.Fprint(out, "echo> ")
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			// Nothing to evaluate
+		case line == ",quit" || line == ",q":
+			fmt.Fprintln(out, "; goodbye")
+			return nil
+		case line == ",env":
+			fmt.Fprintf(out, "; session %d, %d local bindings\n",
+				session.id, len(session.env.bindings))
+		default:
+			result, err := rs.evalWithLimits(session, line)
+			if err != nil {
+				fmt.Fprintf(out, "; error: %v\n", err)
+			} else {
+				fmt.Fprintln(out, result.String())
+			}
+		}
+
+		fmt.Fprint(out, "echo> ")
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("REPL input failed: %w", err)
+	}
+	return nil
+}
+
+// evalWithLimits evaluates one expression in the session environment,
+// enforcing the expression length and evaluation time limits
+func (rs *REPLServer) evalWithLimits(session *REPLSession, expr string) (Value, error) {
+	if len(expr) > MaxExpressionLength {
+		return nil, fmt.Errorf("expression exceeds %d byte limit", MaxExpressionLength)
+	}
+
+	rs.mu.Lock()
+	timeout := rs.evalTimeout
+	rs.mu.Unlock()
+
+	type evalResult struct {
+		value Value
+		err   error
+	}
+	done := make(chan evalResult, 1)
+
+	go func() {
+		value, err := session.sm.EvalIn(expr, session.env)
+		done <- evalResult{value, err}
+	}()
+
+	select {
+	case result := <-done:
+		return result.value, result.err
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("evaluation exceeded %v limit", timeout)
+	}
+}
+
+// EvalIn evaluates a Scheme expression in a specific environment,
+// used by REPL sessions to keep their defines isolated
+func (sm *SchemeMetamodel) EvalIn(expr string, env *Environment) (Value, error) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	value, err := sm.parser.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.evaluator.Eval(value, env)
+}
@@ -0,0 +1,310 @@
+package sensory
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/EchoCog/echollama/core/echobeats"
+)
+
+// StimulusSink receives high-salience feed items as external stimuli.
+// StreamOfConsciousness satisfies this interface.
+type StimulusSink interface {
+	AddExternalStimulus(stimulus string, stimulusType string)
+}
+
+// FeedItem is one entry extracted from an RSS/Atom feed
+type FeedItem struct {
+	FeedURL   string    `json:"feed_url"`
+	Title     string    `json:"title"`
+	Summary   string    `json:"summary"`
+	Link      string    `json:"link"`
+	Published time.Time `json:"published"`
+	Salience  float64   `json:"salience"`
+}
+
+// FeedIngestionPipeline polls configured RSS/Atom feeds, scores items
+// against the interest pattern system, and injects high-salience items
+// into the consciousness stream as external stimuli.
+type FeedIngestionPipeline struct {
+	mu           sync.RWMutex
+	ctx          context.Context
+	cancel       context.CancelFunc
+	feedURLs     []string
+	interests    *echobeats.InterestPatternSystem
+	sink         StimulusSink
+	httpClient   *http.Client
+	pollInterval time.Duration
+
+	// Items below this salience are discarded
+	salienceThreshold float64
+
+	// Deduplication of already-seen item links
+	seenLinks map[string]bool
+
+	// Metrics
+	itemsIngested uint64
+	itemsInjected uint64
+
+	running bool
+}
+
+// NewFeedIngestionPipeline creates a pipeline over the given feeds
+func NewFeedIngestionPipeline(feedURLs []string, interests *echobeats.InterestPatternSystem, sink StimulusSink) *FeedIngestionPipeline {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &FeedIngestionPipeline{
+		ctx:               ctx,
+		cancel:            cancel,
+		feedURLs:          feedURLs,
+		interests:         interests,
+		sink:              sink,
+		httpClient:        &http.Client{Timeout: 30 * time.Second},
+		pollInterval:      15 * time.Minute,
+		salienceThreshold: 0.5,
+		seenLinks:         make(map[string]bool),
+	}
+}
+
+// Start begins the feed polling loop
+func (fp *FeedIngestionPipeline) Start() error {
+	fp.mu.Lock()
+	if fp.running {
+		fp.mu.Unlock()
+		return fmt.Errorf("feed ingestion already running")
+	}
+	fp.running = true
+	fp.mu.Unlock()
+
+	fmt.Printf("📡 Feed ingestion: watching %d feed(s)\n", len(fp.feedURLs))
+	go fp.pollLoop()
+
+	return nil
+}
+
+// Stop halts feed polling
+func (fp *FeedIngestionPipeline) Stop() {
+	fp.mu.Lock()
+	fp.running = false
+	fp.mu.Unlock()
+
+	fp.cancel()
+}
+
+// pollLoop polls all feeds on the configured interval
+func (fp *FeedIngestionPipeline) pollLoop() {
+	// Initial poll shortly after start
+	fp.PollOnce()
+
+	ticker := time.NewTicker(fp.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-fp.ctx.Done():
+			return
+		case <-ticker.C:
+			fp.PollOnce()
+		}
+	}
+}
+
+// PollOnce fetches every feed once, returning the items that passed
+// the salience threshold and were injected as stimuli
+func (fp *FeedIngestionPipeline) PollOnce() []FeedItem {
+	injected := make([]FeedItem, 0)
+
+	for _, feedURL := range fp.feedURLs {
+		items, err := fp.fetchFeed(feedURL)
+		if err != nil {
+			fmt.Printf("⚠️  Feed %s: %v\n", feedURL, err)
+			continue
+		}
+
+		for _, item := range items {
+			fp.mu.Lock()
+			if fp.seenLinks[item.Link] {
+				fp.mu.Unlock()
+				continue
+			}
+			fp.seenLinks[item.Link] = true
+			fp.itemsIngested++
+			fp.mu.Unlock()
+
+			item.Salience = fp.scoreItem(item)
+			if item.Salience < fp.salienceThreshold {
+				continue
+			}
+
+			fp.inject(item)
+			injected = append(injected, item)
+		}
+	}
+
+	return injected
+}
+
+// scoreItem scores a feed item against current interest patterns
+func (fp *FeedIngestionPipeline) scoreItem(item FeedItem) float64 {
+	if fp.interests == nil {
+		return 0.5
+	}
+
+	_, titleScore := fp.interests.ShouldEngage(item.Title)
+	_, summaryScore := fp.interests.ShouldEngage(item.Summary)
+
+	// Title relevance dominates; the summary refines it
+	return 0.7*titleScore + 0.3*summaryScore
+}
+
+// inject delivers a high-salience item into the consciousness stream
+func (fp *FeedIngestionPipeline) inject(item FeedItem) {
+	if fp.sink == nil {
+		return
+	}
+
+	stimulus := item.Title
+	if item.Summary != "" {
+		stimulus += " — " + truncateSummary(item.Summary, 200)
+	}
+
+	fp.sink.AddExternalStimulus(stimulus, "feed_item")
+
+	fp.mu.Lock()
+	fp.itemsInjected++
+	fp.mu.Unlock()
+
+	// High-salience items also reinforce the matching interest
+	fp.interests.RecordEngagement(item.Title, 0, item.Salience, map[string]interface{}{
+		"source": item.FeedURL,
+		"link":   item.Link,
+	})
+}
+
+// rssFeed covers RSS 2.0 documents
+type rssFeed struct {
+	Channel struct {
+		Items []struct {
+			Title       string `xml:"title"`
+			Link        string `xml:"link"`
+			Description string `xml:"description"`
+			PubDate     string `xml:"pubDate"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+// atomFeed covers Atom documents
+type atomFeed struct {
+	Entries []struct {
+		Title   string `xml:"title"`
+		Summary string `xml:"summary"`
+		Updated string `xml:"updated"`
+		Link    struct {
+			Href string `xml:"href,attr"`
+		} `xml:"link"`
+	} `xml:"entry"`
+}
+
+// fetchFeed downloads and parses one feed, handling RSS and Atom
+func (fp *FeedIngestionPipeline) fetchFeed(feedURL string) ([]FeedItem, error) {
+	req, err := http.NewRequestWithContext(fp.ctx, http.MethodGet, feedURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := fp.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("feed returned %d", resp.StatusCode)
+	}
+
+	// Cap feed size at 2MB
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 2*1024*1024))
+	if err != nil {
+		return nil, err
+	}
+
+	// Try RSS first, then Atom
+	var rss rssFeed
+	if err := xml.Unmarshal(body, &rss); err == nil && len(rss.Channel.Items) > 0 {
+		items := make([]FeedItem, 0, len(rss.Channel.Items))
+		for _, entry := range rss.Channel.Items {
+			published, _ := time.Parse(time.RFC1123Z, entry.PubDate)
+			items = append(items, FeedItem{
+				FeedURL:   feedURL,
+				Title:     entry.Title,
+				Summary:   stripTags(entry.Description),
+				Link:      entry.Link,
+				Published: published,
+			})
+		}
+		return items, nil
+	}
+
+	var atom atomFeed
+	if err := xml.Unmarshal(body, &atom); err == nil && len(atom.Entries) > 0 {
+		items := make([]FeedItem, 0, len(atom.Entries))
+		for _, entry := range atom.Entries {
+			published, _ := time.Parse(time.RFC3339, entry.Updated)
+			items = append(items, FeedItem{
+				FeedURL:   feedURL,
+				Title:     entry.Title,
+				Summary:   stripTags(entry.Summary),
+				Link:      entry.Link.Href,
+				Published: published,
+			})
+		}
+		return items, nil
+	}
+
+	return nil, fmt.Errorf("unrecognized feed format")
+}
+
+// GetMetrics returns pipeline metrics
+func (fp *FeedIngestionPipeline) GetMetrics() map[string]interface{} {
+	fp.mu.RLock()
+	defer fp.mu.RUnlock()
+
+	return map[string]interface{}{
+		"feeds":          len(fp.feedURLs),
+		"items_ingested": fp.itemsIngested,
+		"items_injected": fp.itemsInjected,
+		"running":        fp.running,
+	}
+}
+
+// Helper functions
+
+// stripTags removes HTML tags from feed summaries
+func stripTags(s string) string {
+	var sb strings.Builder
+	inTag := false
+	for _, r := range s {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>':
+			inTag = false
+		case !inTag:
+			sb.WriteRune(r)
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+func truncateSummary(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max] + "..."
+}
@@ -0,0 +1,177 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/EchoCog/echollama/core/llm"
+)
+
+// LLM capture: RecordingProvider wraps a real provider and records
+// every exchange into the session; ReplayProvider serves those
+// recorded responses back, keyed by prompt, so a replayed run needs
+// no API keys and gets byte-identical completions.
+
+// llmExchange is the payload recorded for each generation
+type llmExchange struct {
+	Provider  string `json:"provider"`
+	PromptKey string `json:"prompt_key"`
+	Prompt    string `json:"prompt"`
+	Response  string `json:"response"`
+	Error     string `json:"error,omitempty"`
+}
+
+// promptKey fingerprints a prompt+system pair for replay lookup
+func promptKey(prompt, systemPrompt string) string {
+	sum := sha256.Sum256([]byte(systemPrompt + "\x00" + prompt))
+	return hex.EncodeToString(sum[:8])
+}
+
+// RecordingProvider wraps a live provider, capturing its outputs
+type RecordingProvider struct {
+	inner    llm.LLMProvider
+	recorder *Recorder
+}
+
+// NewRecordingProvider wraps a provider so its responses land in the
+// session
+func NewRecordingProvider(inner llm.LLMProvider, recorder *Recorder) *RecordingProvider {
+	return &RecordingProvider{inner: inner, recorder: recorder}
+}
+
+func (p *RecordingProvider) Name() string    { return p.inner.Name() }
+func (p *RecordingProvider) Available() bool { return p.inner.Available() }
+func (p *RecordingProvider) MaxTokens() int  { return p.inner.MaxTokens() }
+
+// Model exposes the wrapped provider's model so capability lookups see
+// through the recording layer
+func (p *RecordingProvider) Model() string {
+	if namer, ok := p.inner.(llm.ModelNamer); ok {
+		return namer.Model()
+	}
+	return "default"
+}
+
+// Generate delegates to the live provider and records the exchange
+func (p *RecordingProvider) Generate(ctx context.Context, prompt string, opts llm.GenerateOptions) (string, error) {
+	response, err := p.inner.Generate(ctx, prompt, opts)
+
+	exchange := llmExchange{
+		Provider:  p.inner.Name(),
+		PromptKey: promptKey(prompt, opts.SystemPrompt),
+		Prompt:    prompt,
+		Response:  response,
+	}
+	if err != nil {
+		exchange.Error = err.Error()
+	}
+	p.recorder.Record(EventLLM, p.inner.Name(), exchange)
+
+	return response, err
+}
+
+// StreamGenerate collects the live stream and records the assembled
+// response once it completes
+func (p *RecordingProvider) StreamGenerate(ctx context.Context, prompt string, opts llm.GenerateOptions) (<-chan llm.StreamChunk, error) {
+	inner, err := p.inner.StreamGenerate(ctx, prompt, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan llm.StreamChunk)
+	go func() {
+		defer close(out)
+		var sb strings.Builder
+		for chunk := range inner {
+			sb.WriteString(chunk.Content)
+			out <- chunk
+		}
+		p.recorder.Record(EventLLM, p.inner.Name(), llmExchange{
+			Provider:  p.inner.Name(),
+			PromptKey: promptKey(prompt, opts.SystemPrompt),
+			Prompt:    prompt,
+			Response:  sb.String(),
+		})
+	}()
+	return out, nil
+}
+
+// ReplayProvider serves recorded responses; it is always available
+// and needs no API keys
+type ReplayProvider struct {
+	name string
+	// byKey maps prompt fingerprints to recorded responses, in
+	// recording order (repeated prompts replay in sequence)
+	byKey map[string][]llmExchange
+	used  map[string]int
+}
+
+// NewReplayProvider builds a provider from a session's LLM events
+func NewReplayProvider(replayer *Replayer, providerName string) (*ReplayProvider, error) {
+	p := &ReplayProvider{
+		name:  providerName,
+		byKey: make(map[string][]llmExchange),
+		used:  make(map[string]int),
+	}
+
+	for _, event := range replayer.EventsOfKind(EventLLM) {
+		var exchange llmExchange
+		if err := json.Unmarshal(event.Payload, &exchange); err != nil {
+			return nil, fmt.Errorf("corrupt LLM event %d: %w", event.Seq, err)
+		}
+		if providerName != "" && exchange.Provider != providerName {
+			continue
+		}
+		p.byKey[exchange.PromptKey] = append(p.byKey[exchange.PromptKey], exchange)
+	}
+	if len(p.byKey) == 0 {
+		return nil, fmt.Errorf("session contains no LLM events for provider %q", providerName)
+	}
+	if p.name == "" {
+		p.name = "replay"
+	}
+	return p, nil
+}
+
+func (p *ReplayProvider) Name() string    { return p.name }
+func (p *ReplayProvider) Available() bool { return true }
+func (p *ReplayProvider) MaxTokens() int  { return 100000 }
+
+// Generate returns the recorded response for this prompt
+func (p *ReplayProvider) Generate(ctx context.Context, prompt string, opts llm.GenerateOptions) (string, error) {
+	key := promptKey(prompt, opts.SystemPrompt)
+	recorded := p.byKey[key]
+	if len(recorded) == 0 {
+		return "", fmt.Errorf("no recorded response for prompt (key %s)", key)
+	}
+
+	index := p.used[key]
+	if index >= len(recorded) {
+		index = len(recorded) - 1 // repeat the last response past the end
+	}
+	p.used[key]++
+
+	exchange := recorded[index]
+	if exchange.Error != "" {
+		return "", fmt.Errorf("recorded error: %s", exchange.Error)
+	}
+	return exchange.Response, nil
+}
+
+// StreamGenerate replays the recorded response as a single chunk
+func (p *ReplayProvider) StreamGenerate(ctx context.Context, prompt string, opts llm.GenerateOptions) (<-chan llm.StreamChunk, error) {
+	response, err := p.Generate(ctx, prompt, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan llm.StreamChunk, 2)
+	out <- llm.StreamChunk{Content: response}
+	out <- llm.StreamChunk{Done: true}
+	close(out)
+	return out, nil
+}
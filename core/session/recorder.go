@@ -0,0 +1,180 @@
+package session
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Session record/replay: autonomous runs are hard to debug because
+// they depend on live LLM responses and scheduler timing. A Recorder
+// captures every external input, scheduler event, and LLM/NPU output
+// as a JSONL stream; a Replayer feeds the same session back so a run
+// can be reproduced deterministically on a machine with no API keys.
+
+// Event kinds recorded in a session
+const (
+	EventInput     = "input"
+	EventScheduler = "scheduler"
+	EventLLM       = "llm"
+	EventNPU       = "npu"
+)
+
+// Event is one captured occurrence
+type Event struct {
+	Seq       int             `json:"seq"`
+	Timestamp time.Time       `json:"timestamp"`
+	Kind      string          `json:"kind"`
+	Name      string          `json:"name"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+}
+
+// Recorder appends events to a session file
+type Recorder struct {
+	mu   sync.Mutex
+	file *os.File
+	path string
+	seq  int
+}
+
+// NewRecorder opens (or creates) a session file for appending
+func NewRecorder(path string) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session file: %w", err)
+	}
+	fmt.Printf("🎙️ Session: recording to %s\n", path)
+	return &Recorder{file: file, path: path}, nil
+}
+
+// Record appends one event; payload may be any JSON-encodable value
+func (r *Recorder) Record(kind, name string, payload interface{}) error {
+	var raw json.RawMessage
+	if payload != nil {
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to encode payload: %w", err)
+		}
+		raw = encoded
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.seq++
+	event := Event{
+		Seq:       r.seq,
+		Timestamp: time.Now(),
+		Kind:      kind,
+		Name:      name,
+		Payload:   raw,
+	}
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	if _, err := r.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write event: %w", err)
+	}
+	return nil
+}
+
+// Close flushes and closes the session file
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.file.Sync(); err != nil {
+		return err
+	}
+	return r.file.Close()
+}
+
+// Replayer walks a recorded session
+type Replayer struct {
+	mu     sync.Mutex
+	events []Event
+	cursor int
+}
+
+// LoadSession reads a recorded session file
+func LoadSession(path string) (*Replayer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session file: %w", err)
+	}
+	defer file.Close()
+
+	replayer := &Replayer{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var event Event
+		if err := json.Unmarshal(line, &event); err != nil {
+			return nil, fmt.Errorf("corrupt session entry at line %d: %w", len(replayer.events)+1, err)
+		}
+		replayer.events = append(replayer.events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read session: %w", err)
+	}
+
+	fmt.Printf("📼 Session: loaded %d event(s) from %s\n", len(replayer.events), path)
+	return replayer, nil
+}
+
+// Len returns the total number of recorded events
+func (rp *Replayer) Len() int {
+	return len(rp.events)
+}
+
+// Next returns the next event in order, or nil at the end
+func (rp *Replayer) Next() *Event {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	if rp.cursor >= len(rp.events) {
+		return nil
+	}
+	event := &rp.events[rp.cursor]
+	rp.cursor++
+	return event
+}
+
+// NextOfKind advances to the next event of the given kind, skipping
+// others, or nil when none remain
+func (rp *Replayer) NextOfKind(kind string) *Event {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	for rp.cursor < len(rp.events) {
+		event := &rp.events[rp.cursor]
+		rp.cursor++
+		if event.Kind == kind {
+			return event
+		}
+	}
+	return nil
+}
+
+// Rewind restarts the replay from the beginning
+func (rp *Replayer) Rewind() {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	rp.cursor = 0
+}
+
+// EventsOfKind returns every event of a kind, in order
+func (rp *Replayer) EventsOfKind(kind string) []Event {
+	matched := make([]Event, 0)
+	for _, event := range rp.events {
+		if event.Kind == kind {
+			matched = append(matched, event)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"sync"
+	"time"
+)
+
+// VirtualClock is an injectable time source: the simulation advances
+// it explicitly, so a week of wake/dream cycles runs in milliseconds
+// and every run sees the same timestamps.
+type VirtualClock struct {
+	mu  sync.RWMutex
+	now time.Time
+}
+
+// NewVirtualClock starts a clock at the given instant
+func NewVirtualClock(start time.Time) *VirtualClock {
+	return &VirtualClock{now: start}
+}
+
+// Now returns the current virtual time
+func (vc *VirtualClock) Now() time.Time {
+	vc.mu.RLock()
+	defer vc.mu.RUnlock()
+	return vc.now
+}
+
+// Advance moves the clock forward and returns the new time
+func (vc *VirtualClock) Advance(d time.Duration) time.Time {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	vc.now = vc.now.Add(d)
+	return vc.now
+}
@@ -0,0 +1,249 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/EchoCog/echollama/core/deeptreeecho"
+	"github.com/EchoCog/echollama/core/ecco9/drivers"
+	"github.com/EchoCog/echollama/core/echobeats"
+	"github.com/EchoCog/echollama/core/echodream"
+	"github.com/EchoCog/echollama/core/memory"
+)
+
+// End-to-end simulation harness: the live system only shows whether
+// its invariants hold after days of wall-clock runtime. This harness
+// runs the real subsystems — the deeptreeecho wake/rest manager, the
+// EchoBeats scheduler, echodream consolidation, the NPU driver, and a
+// hypergraph memory — under an injected virtual clock, compressing
+// weeks into milliseconds. After a run it asserts the properties the
+// architecture promises: fatigue stays bounded, memory stays within
+// budget, and entelechy fitness never regresses.
+
+// SimulationConfig tunes a simulated run
+type SimulationConfig struct {
+	// Duration is the virtual time span to simulate; default 24h
+	Duration time.Duration
+	// TickInterval is the virtual time per step; default 1 minute
+	TickInterval time.Duration
+	// MemoryBudget bounds hypergraph nodes; default 500
+	MemoryBudget int
+	// Seed makes experience importance deterministic; default 42
+	Seed int64
+}
+
+func (config *SimulationConfig) applyDefaults() {
+	if config.Duration <= 0 {
+		config.Duration = 24 * time.Hour
+	}
+	if config.TickInterval <= 0 {
+		config.TickInterval = time.Minute
+	}
+	if config.MemoryBudget <= 0 {
+		config.MemoryBudget = 500
+	}
+	if config.Seed == 0 {
+		config.Seed = 42
+	}
+}
+
+// SimulationReport summarizes a run and its invariant checks
+type SimulationReport struct {
+	Ticks             int           `json:"ticks"`
+	SimulatedDuration time.Duration `json:"simulated_duration"`
+	WallClock         time.Duration `json:"wall_clock"`
+
+	Thoughts    int `json:"thoughts"`
+	DreamCycles int `json:"dream_cycles"`
+	MemoryNodes int `json:"memory_nodes"`
+
+	MaxFatigue     float64 `json:"max_fatigue"`
+	InitialFitness float64 `json:"initial_fitness"`
+	FinalFitness   float64 `json:"final_fitness"`
+
+	// Violations lists every invariant breach; empty means the run
+	// passed
+	Violations []string `json:"violations"`
+}
+
+// Passed reports whether every invariant held
+func (report *SimulationReport) Passed() bool {
+	return len(report.Violations) == 0
+}
+
+// Simulation wires the real subsystems over a shared virtual clock.
+// None of the subsystems' own real-time loops are started: the harness
+// drives their Tick/Step entry points after each clock advance, so a
+// simulated minute costs microseconds.
+type Simulation struct {
+	config SimulationConfig
+	clock  *VirtualClock
+	rng    *rand.Rand
+
+	wakeRest   *deeptreeecho.AutonomousWakeRestManager
+	beats      *echobeats.EchoBeats
+	dream      *echodream.EchoDream
+	npu        *drivers.NPUDriver
+	hypergraph *memory.HypergraphMemory
+
+	thoughts    int
+	dreamCycles int
+}
+
+// NewSimulation builds a simulation with the given config
+func NewSimulation(config SimulationConfig) *Simulation {
+	config.applyDefaults()
+
+	sim := &Simulation{
+		config:     config,
+		clock:      NewVirtualClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		rng:        rand.New(rand.NewSource(config.Seed)),
+		hypergraph: memory.NewHypergraphMemory(nil),
+	}
+
+	// Wake/rest decisions and fatigue run through the real manager and
+	// its pluggable fatigue model, clocked by the virtual time source
+	sim.wakeRest = deeptreeecho.NewAutonomousWakeRestManager()
+	sim.wakeRest.SetTimeSource(sim.clock.Now)
+	sim.wakeRest.SetCallbacks(nil, nil, sim.onDreamStart, sim.onDreamEnd)
+
+	// Experiences arrive through the real EchoBeats queue as recurring
+	// scheduled events rather than a hand-rolled loop
+	sim.beats = echobeats.NewEchoBeats()
+	sim.beats.SetTimeSource(sim.clock.Now)
+	sim.beats.RegisterHandler(echobeats.EventPerception, sim.onExperience)
+
+	sim.dream = echodream.NewEchoDream()
+	sim.dream.SetTimeSource(sim.clock.Now)
+
+	sim.npu = drivers.NewNPUDriver(nil)
+	sim.npu.Load(nil)
+
+	return sim
+}
+
+// Clock exposes the simulation's time source
+func (sim *Simulation) Clock() *VirtualClock {
+	return sim.clock
+}
+
+// Run executes the full simulated span and checks the invariants
+func (sim *Simulation) Run() *SimulationReport {
+	started := time.Now()
+	report := &SimulationReport{
+		SimulatedDuration: sim.config.Duration,
+		InitialFitness:    sim.npu.AssessEntelechy().Fitness,
+	}
+	lastFitness := report.InitialFitness
+
+	// A recurring perception event delivers one simulated experience
+	// per tick through the scheduler's priority queue
+	sim.beats.ScheduleEvent(&echobeats.CognitiveEvent{
+		Type:        echobeats.EventPerception,
+		Priority:    50,
+		Timestamp:   sim.clock.Now(),
+		ScheduledAt: sim.clock.Now().Add(sim.config.TickInterval),
+		Payload:     "simulated experience",
+		Recurring:   true,
+		Interval:    sim.config.TickInterval,
+	})
+
+	ticks := int(sim.config.Duration / sim.config.TickInterval)
+	for tick := 0; tick < ticks; tick++ {
+		sim.clock.Advance(sim.config.TickInterval)
+		report.Ticks++
+
+		// Deliver due events, then evaluate wake/rest transitions
+		sim.beats.Tick()
+		sim.wakeRest.Tick()
+
+		if sim.wakeRest.IsDreaming() {
+			sim.stepDream()
+		}
+
+		// Invariant: fatigue bounded
+		fatigue := sim.wakeRest.LoadManager().GetFatigueLevel()
+		if fatigue < 0 || fatigue > 1.0 {
+			report.Violations = append(report.Violations,
+				fmt.Sprintf("tick %d: fatigue %.3f outside [0,1]", tick, fatigue))
+		}
+		if fatigue > report.MaxFatigue {
+			report.MaxFatigue = fatigue
+		}
+
+		// Invariant: memory bounded (pruning runs each dream, so
+		// allow headroom of one wake phase between dreams)
+		if count := sim.hypergraph.GetNodeCount(); count > sim.config.MemoryBudget*2 {
+			report.Violations = append(report.Violations,
+				fmt.Sprintf("tick %d: %d memory nodes exceed twice the %d budget", tick, count, sim.config.MemoryBudget))
+		}
+
+		// Invariant: fitness non-decreasing
+		if fitness := sim.npu.AssessEntelechy().Fitness; fitness < lastFitness {
+			report.Violations = append(report.Violations,
+				fmt.Sprintf("tick %d: fitness regressed %.4f -> %.4f", tick, lastFitness, fitness))
+		} else {
+			lastFitness = fitness
+		}
+	}
+
+	report.Thoughts = sim.thoughts
+	report.DreamCycles = sim.dreamCycles
+	report.MemoryNodes = sim.hypergraph.GetNodeCount()
+	report.FinalFitness = lastFitness
+	report.WallClock = time.Since(started)
+
+	status := "✅"
+	if !report.Passed() {
+		status = "❌"
+	}
+	fmt.Printf("%s Simulation: %v in %v, %d thoughts, %d dreams, fitness %.2f -> %.2f, %d violation(s)\n",
+		status, report.SimulatedDuration, report.WallClock, report.Thoughts,
+		report.DreamCycles, report.InitialFitness, report.FinalFitness, len(report.Violations))
+	return report
+}
+
+// onExperience handles one scheduled perception event: while awake it
+// stores an episode in the hypergraph, queues it for dream-time
+// consolidation, and reports the resulting cognitive load
+func (sim *Simulation) onExperience(event *echobeats.CognitiveEvent) error {
+	if !sim.wakeRest.IsAwake() {
+		return nil
+	}
+
+	sim.thoughts++
+	importance := sim.rng.Float64() * 0.5
+	content := fmt.Sprintf("simulated experience %d", sim.thoughts)
+
+	sim.hypergraph.AddNode(&memory.MemoryNode{
+		Type:       memory.NodeEvent,
+		Content:    content,
+		Importance: importance,
+		CreatedAt:  sim.clock.Now(),
+		Metadata:   map[string]interface{}{"source": "simulation"},
+	})
+	sim.dream.AddEpisodicMemory(content, importance)
+	sim.wakeRest.UpdateCognitiveLoad(0.5 + importance)
+	return nil
+}
+
+// onDreamStart begins an echodream consolidation cycle when the
+// wake/rest manager enters the dream state
+func (sim *Simulation) onDreamStart() error {
+	sim.dreamCycles++
+	return sim.dream.Start()
+}
+
+// onDreamEnd closes the consolidation cycle on waking
+func (sim *Simulation) onDreamEnd() error {
+	return sim.dream.Stop()
+}
+
+// stepDream runs one dreaming tick: advance a dream phase, let the NPU
+// self-optimization pass nudge fitness, and prune memory to budget
+func (sim *Simulation) stepDream() {
+	sim.dream.Step()
+	sim.npu.SelfOptimize(1)
+	sim.hypergraph.Prune(memory.PruningPolicy{MaxNodes: sim.config.MemoryBudget})
+}
@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSimulationInvariantsHold(t *testing.T) {
+	sim := NewSimulation(SimulationConfig{
+		Duration:     48 * time.Hour,
+		TickInterval: time.Minute,
+		MemoryBudget: 200,
+	})
+
+	report := sim.Run()
+	if !report.Passed() {
+		t.Fatalf("invariants violated: %v", report.Violations)
+	}
+	if report.DreamCycles == 0 {
+		t.Error("expected at least one dream cycle over 48 simulated hours")
+	}
+	if report.FinalFitness < report.InitialFitness {
+		t.Errorf("fitness regressed: %.4f -> %.4f", report.InitialFitness, report.FinalFitness)
+	}
+	if report.MemoryNodes > 400 {
+		t.Errorf("memory unbounded: %d nodes", report.MemoryNodes)
+	}
+}
+
+func TestSimulationDeterministic(t *testing.T) {
+	run := func() *SimulationReport {
+		return NewSimulation(SimulationConfig{
+			Duration:     6 * time.Hour,
+			TickInterval: time.Minute,
+		}).Run()
+	}
+
+	first, second := run(), run()
+	if first.Thoughts != second.Thoughts || first.DreamCycles != second.DreamCycles ||
+		first.FinalFitness != second.FinalFitness {
+		t.Errorf("runs diverged: %+v vs %+v", first, second)
+	}
+}
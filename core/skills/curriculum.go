@@ -0,0 +1,165 @@
+package skills
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/EchoCog/echollama/core/llm"
+)
+
+// CurriculumGenerator uses the LLM provider manager to break a target
+// skill into graded practice exercises, evaluate practice responses,
+// and adjust difficulty - turning simulated practice into real
+// self-directed training.
+type CurriculumGenerator struct {
+	llmManager *llm.ProviderManager
+}
+
+// NewCurriculumGenerator creates a curriculum generator backed by the
+// given provider manager
+func NewCurriculumGenerator(llmManager *llm.ProviderManager) *CurriculumGenerator {
+	return &CurriculumGenerator{llmManager: llmManager}
+}
+
+// curriculumExercise is the JSON shape the LLM is asked to produce
+type curriculumExercise struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Difficulty  float64 `json:"difficulty"`
+	Prompt      string  `json:"prompt"`
+}
+
+// GenerateCurriculum asks the LLM for graded exercises for a skill,
+// from slightly below the current level up toward the target level
+func (cg *CurriculumGenerator) GenerateCurriculum(ctx context.Context, skill *Skill, count int) ([]*PracticeScenario, error) {
+	if cg.llmManager == nil || !cg.llmManager.Available() {
+		return nil, fmt.Errorf("no LLM provider available for curriculum generation")
+	}
+
+	prompt := fmt.Sprintf(
+		`Design %d practice exercises for the cognitive skill "%s" (%s).
+Current proficiency: %.2f, target: %.2f (scale 0.0-1.0).
+Grade the exercises from difficulty %.2f up to %.2f.
+Respond with a JSON array only, each element:
+{"name": "...", "description": "...", "difficulty": 0.0-1.0, "prompt": "the exercise prompt"}`,
+		count, skill.Name, skill.Description,
+		skill.CurrentLevel, skill.TargetLevel,
+		clamp(skill.CurrentLevel-0.1, 0.0, 1.0), skill.TargetLevel,
+	)
+
+	opts := llm.DefaultGenerateOptions()
+	opts.Temperature = 0.8
+	opts.MaxTokens = 1000
+
+	response, err := cg.llmManager.Generate(ctx, prompt, opts)
+	if err != nil {
+		return nil, fmt.Errorf("curriculum generation failed: %w", err)
+	}
+
+	exercises, err := parseCurriculumResponse(response)
+	if err != nil {
+		return nil, err
+	}
+
+	scenarios := make([]*PracticeScenario, 0, len(exercises))
+	for _, ex := range exercises {
+		scenarios = append(scenarios, &PracticeScenario{
+			ID:          uuid.New().String(),
+			SkillID:     skill.ID,
+			Name:        ex.Name,
+			Description: ex.Description,
+			Difficulty:  clamp(ex.Difficulty, 0.0, 1.0),
+			Prompt:      ex.Prompt,
+			Evaluation: EvaluationCriteria{
+				Metrics:    []string{"quality", "depth"},
+				Thresholds: map[string]float64{"quality": 0.6},
+				Weights:    map[string]float64{"quality": 0.6, "depth": 0.4},
+			},
+			TimeLimit: 120 * time.Second,
+		})
+	}
+
+	return scenarios, nil
+}
+
+// parseCurriculumResponse extracts the JSON array from an LLM response
+// that may be wrapped in prose or code fences
+func parseCurriculumResponse(response string) ([]curriculumExercise, error) {
+	start := strings.Index(response, "[")
+	end := strings.LastIndex(response, "]")
+	if start < 0 || end <= start {
+		return nil, fmt.Errorf("no JSON array found in curriculum response")
+	}
+
+	var exercises []curriculumExercise
+	if err := json.Unmarshal([]byte(response[start:end+1]), &exercises); err != nil {
+		return nil, fmt.Errorf("failed to parse curriculum response: %w", err)
+	}
+
+	return exercises, nil
+}
+
+// AttemptExercise has the LLM actually attempt an exercise, then
+// evaluates the response, returning the attempt text and a score in [0, 1]
+func (cg *CurriculumGenerator) AttemptExercise(ctx context.Context, skill *Skill, scenario *PracticeScenario) (string, float64, error) {
+	if cg.llmManager == nil || !cg.llmManager.Available() {
+		return "", 0, fmt.Errorf("no LLM provider available for practice")
+	}
+
+	opts := llm.DefaultGenerateOptions()
+	attempt, err := cg.llmManager.Generate(ctx, scenario.Prompt, opts)
+	if err != nil {
+		return "", 0, fmt.Errorf("practice attempt failed: %w", err)
+	}
+
+	score, err := cg.evaluateAttempt(ctx, skill, scenario, attempt)
+	if err != nil {
+		// Keep the attempt but fall back to a neutral score
+		return attempt, 0.5, nil
+	}
+
+	return attempt, score, nil
+}
+
+// evaluateAttempt asks the LLM to grade an attempt against the exercise
+func (cg *CurriculumGenerator) evaluateAttempt(ctx context.Context, skill *Skill, scenario *PracticeScenario, attempt string) (float64, error) {
+	prompt := fmt.Sprintf(
+		`Evaluate this attempt at a "%s" exercise.
+Exercise: %s
+Attempt: %s
+Respond with only a single number between 0.0 (complete failure) and 1.0 (perfect).`,
+		skill.Name, scenario.Prompt, attempt,
+	)
+
+	opts := llm.DefaultGenerateOptions()
+	opts.Temperature = 0.2
+	opts.MaxTokens = 10
+
+	response, err := cg.llmManager.Generate(ctx, prompt, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	var score float64
+	if _, err := fmt.Sscanf(strings.TrimSpace(response), "%f", &score); err != nil {
+		return 0, fmt.Errorf("unparseable evaluation score: %q", response)
+	}
+
+	return clamp(score, 0.0, 1.0), nil
+}
+
+// AdjustDifficulty nudges future exercise selection: strong performance
+// raises the working difficulty, weak performance lowers it
+func AdjustDifficulty(scenario *PracticeScenario, score float64) {
+	switch {
+	case score > 0.8:
+		scenario.Difficulty = clamp(scenario.Difficulty+0.1, 0.0, 1.0)
+	case score < 0.4:
+		scenario.Difficulty = clamp(scenario.Difficulty-0.1, 0.0, 1.0)
+	}
+}
@@ -0,0 +1,197 @@
+package skills
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LearningCurveModel governs how proficiency responds to practice and
+// neglect: practice yields diminishing returns as mastery approaches,
+// and unused skills decay back toward a retention floor
+type LearningCurveModel struct {
+	// LearningRate scales the gain from one practice session
+	LearningRate float64
+
+	// DecayGracePeriod is how long a skill can go unpracticed before
+	// decay begins
+	DecayGracePeriod time.Duration
+
+	// DecayHalfLife is the half-life of the decayable portion of a
+	// skill once the grace period has elapsed
+	DecayHalfLife time.Duration
+
+	// RetentionFloor is the fraction of peak proficiency that never
+	// decays - well-learned skills are not fully forgotten
+	RetentionFloor float64
+}
+
+// NewLearningCurveModel creates a model with defaults tuned for the
+// practice cadence of the autonomous practice loop
+func NewLearningCurveModel() *LearningCurveModel {
+	return &LearningCurveModel{
+		LearningRate:     0.15,
+		DecayGracePeriod: 24 * time.Hour,
+		DecayHalfLife:    7 * 24 * time.Hour,
+		RetentionFloor:   0.3,
+	}
+}
+
+// PracticeGain returns the proficiency increase for a practice session.
+// Gains diminish as the skill approaches mastery and scale with how
+// well the session went and how hard the skill is.
+func (lc *LearningCurveModel) PracticeGain(currentLevel, sessionScore, difficulty float64) float64 {
+	headroom := 1.0 - currentLevel
+	difficultyFactor := 1.0 / (1.0 + difficulty)
+	return lc.LearningRate * sessionScore * headroom * difficultyFactor
+}
+
+// Decay returns the new proficiency after the skill has gone unpracticed
+// for the given duration. Within the grace period nothing is lost.
+func (lc *LearningCurveModel) Decay(currentLevel float64, sinceLastPractice time.Duration) float64 {
+	if sinceLastPractice <= lc.DecayGracePeriod {
+		return currentLevel
+	}
+
+	floor := currentLevel * lc.RetentionFloor
+	decayable := currentLevel - floor
+	elapsed := sinceLastPractice - lc.DecayGracePeriod
+	retained := math.Pow(0.5, elapsed.Hours()/lc.DecayHalfLife.Hours())
+
+	return floor + decayable*retained
+}
+
+// skillState is the serialized form of one skill's learnable state
+type skillState struct {
+	Name              string        `json:"name"`
+	CurrentLevel      float64       `json:"current_level"`
+	LastPracticed     time.Time     `json:"last_practiced"`
+	TotalPracticeTime time.Duration `json:"total_practice_time"`
+}
+
+// practiceSystemState is the serialized form of the practice system
+type practiceSystemState struct {
+	Skills            map[string]skillState `json:"skills"`
+	SessionsCompleted uint64                `json:"sessions_completed"`
+	SkillsImproved    uint64                `json:"skills_improved"`
+	SavedAt           time.Time             `json:"saved_at"`
+}
+
+// ExportState serializes skill proficiency for archives and transfer
+func (sps *SkillPracticeSystem) ExportState() ([]byte, error) {
+	sps.mu.RLock()
+	state := practiceSystemState{
+		Skills:            make(map[string]skillState, len(sps.skills)),
+		SessionsCompleted: sps.sessionsCompleted,
+		SkillsImproved:    sps.skillsImproved,
+		SavedAt:           time.Now(),
+	}
+	for _, skill := range sps.skills {
+		state.Skills[skill.Name] = skillState{
+			Name:              skill.Name,
+			CurrentLevel:      skill.CurrentLevel,
+			LastPracticed:     skill.LastPracticed,
+			TotalPracticeTime: skill.TotalPracticeTime,
+		}
+	}
+	sps.mu.RUnlock()
+
+	return json.MarshalIndent(state, "", "  ")
+}
+
+// SaveToFile persists skill proficiency so learning survives restarts
+func (sps *SkillPracticeSystem) SaveToFile(path string) error {
+	data, err := sps.ExportState()
+	if err != nil {
+		return fmt.Errorf("failed to marshal practice state: %w", err)
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create practice state directory: %w", err)
+		}
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write practice state: %w", err)
+	}
+
+	return nil
+}
+
+// LoadFromFile restores persisted proficiency, matching skills by name
+// since skill IDs are regenerated each run. A missing file is not an
+// error. Decay for the downtime is applied on load.
+func (sps *SkillPracticeSystem) LoadFromFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read practice state: %w", err)
+	}
+
+	return sps.ImportState(data)
+}
+
+// ImportState applies serialized proficiency produced by ExportState,
+// matching skills by name and applying decay for elapsed downtime
+func (sps *SkillPracticeSystem) ImportState(data []byte) error {
+	var state practiceSystemState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("failed to unmarshal practice state: %w", err)
+	}
+
+	sps.mu.Lock()
+	defer sps.mu.Unlock()
+
+	for _, skill := range sps.skills {
+		saved, ok := state.Skills[skill.Name]
+		if !ok {
+			continue
+		}
+		skill.CurrentLevel = saved.CurrentLevel
+		skill.LastPracticed = saved.LastPracticed
+		skill.TotalPracticeTime = saved.TotalPracticeTime
+
+		// Apply decay for the time spent offline
+		if !skill.LastPracticed.IsZero() {
+			skill.CurrentLevel = sps.learningCurve.Decay(
+				skill.CurrentLevel, time.Since(skill.LastPracticed))
+		}
+	}
+
+	sps.sessionsCompleted = state.SessionsCompleted
+	sps.skillsImproved = state.SkillsImproved
+
+	return nil
+}
+
+// applySkillDecay decays skills that have gone unpracticed, returning
+// the number of skills that lost proficiency
+func (sps *SkillPracticeSystem) applySkillDecay() int {
+	sps.mu.Lock()
+	defer sps.mu.Unlock()
+
+	decayed := 0
+	for _, skill := range sps.skills {
+		if skill.LastPracticed.IsZero() {
+			continue
+		}
+
+		newLevel := sps.learningCurve.Decay(skill.CurrentLevel, time.Since(skill.LastPracticed))
+		if newLevel < skill.CurrentLevel {
+			skill.CurrentLevel = newLevel
+			decayed++
+		}
+	}
+
+	if decayed > 0 {
+		sps.decayEvents += uint64(decayed)
+	}
+
+	return decayed
+}
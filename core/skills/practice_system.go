@@ -9,6 +9,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/EchoCog/echollama/core/events"
+	"github.com/EchoCog/echollama/core/llm"
 )
 
 // SkillPracticeSystem enables autonomous skill development and measurement
@@ -31,14 +34,24 @@ type SkillPracticeSystem struct {
 	// Autonomous practice goals
 	practiceGoals     []*PracticeGoal
 	
+	// Learning dynamics
+	learningCurve     *LearningCurveModel
+
+	// LLM-backed curriculum (optional)
+	curriculum        *CurriculumGenerator
+
+	// Event sourcing (optional)
+	eventStore        *events.EventStore
+
 	// Configuration
 	practiceInterval  time.Duration
 	improvementTarget float64
-	
+
 	// Metrics
 	sessionsCompleted uint64
 	skillsImproved    uint64
-	
+	decayEvents       uint64
+
 	running           bool
 }
 
@@ -165,6 +178,7 @@ func NewSkillPracticeSystem() *SkillPracticeSystem {
 		performanceMetrics: make(map[string]*PerformanceMetrics),
 		practiceGoals:      make([]*PracticeGoal, 0),
 		practiceHistory:    make([]*PracticeSession, 0),
+		learningCurve:      NewLearningCurveModel(),
 		practiceInterval:   30 * time.Minute,
 		improvementTarget:  0.1, // 10% improvement target
 	}
@@ -388,8 +402,15 @@ func (sps *SkillPracticeSystem) conductPracticeSession() {
 		StartTime:  time.Now(),
 	}
 	
-	// Execute practice (simulated for now)
-	performance := sps.executePractice(skill, scenario)
+	// Execute practice - real LLM training when a curriculum is
+	// configured, simulated otherwise
+	var performance *PerformanceResult
+	if sps.curriculum != nil {
+		performance = sps.executeCurriculumPractice(skill, scenario)
+	}
+	if performance == nil {
+		performance = sps.executePractice(skill, scenario)
+	}
 	
 	// Record results
 	session.EndTime = time.Now()
@@ -448,6 +469,71 @@ func (sps *SkillPracticeSystem) selectPracticeScenario(skill *Skill) *PracticeSc
 	return skill.PracticeScenarios[0]
 }
 
+// EnableCurriculum attaches an LLM-backed curriculum generator so
+// practice sessions become real self-directed training. Curricula are
+// generated lazily for skills that still have only seed scenarios.
+func (sps *SkillPracticeSystem) EnableCurriculum(llmManager *llm.ProviderManager) {
+	sps.mu.Lock()
+	defer sps.mu.Unlock()
+
+	sps.curriculum = NewCurriculumGenerator(llmManager)
+}
+
+// executeCurriculumPractice runs a real practice attempt through the
+// curriculum generator. Returns nil if the LLM path is unavailable so
+// the caller can fall back to simulated practice.
+func (sps *SkillPracticeSystem) executeCurriculumPractice(skill *Skill, scenario *PracticeScenario) *PerformanceResult {
+	ctx, cancel := context.WithTimeout(sps.ctx, scenario.TimeLimit)
+	defer cancel()
+
+	// Lazily expand the seed scenarios into a graded curriculum
+	if len(skill.PracticeScenarios) <= 1 {
+		if generated, err := sps.curriculum.GenerateCurriculum(ctx, skill, 5); err == nil {
+			sps.mu.Lock()
+			skill.PracticeScenarios = append(skill.PracticeScenarios, generated...)
+			sps.mu.Unlock()
+			fmt.Printf("📚 Generated %d-exercise curriculum for %s\n", len(generated), skill.Name)
+		}
+	}
+
+	attempt, score, err := sps.curriculum.AttemptExercise(ctx, skill, scenario)
+	if err != nil {
+		return nil
+	}
+
+	AdjustDifficulty(scenario, score)
+
+	result := &PerformanceResult{
+		Score:        score,
+		MetricScores: map[string]float64{"quality": score},
+		Strengths:    make([]string, 0),
+		Weaknesses:   make([]string, 0),
+	}
+
+	if score > 0.7 {
+		result.Strengths = append(result.Strengths, scenario.Name)
+	} else if score < 0.5 {
+		result.Weaknesses = append(result.Weaknesses, scenario.Name)
+	}
+
+	metrics := sps.performanceMetrics[skill.ID]
+	if metrics != nil && metrics.AverageScore > 0 {
+		result.Improvement = score - metrics.AverageScore
+	}
+
+	fmt.Printf("🎯 Practiced %s (%s): score %.2f\n   %s\n",
+		skill.Name, scenario.Name, score, truncateAttempt(attempt, 120))
+
+	return result
+}
+
+func truncateAttempt(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max] + "..."
+}
+
 // executePractice simulates practicing a skill
 func (sps *SkillPracticeSystem) executePractice(skill *Skill, scenario *PracticeScenario) *PerformanceResult {
 	// Simulate practice performance
@@ -535,14 +621,31 @@ func (sps *SkillPracticeSystem) updatePerformanceMetrics(skillID string, perform
 func (sps *SkillPracticeSystem) updateSkillLevel(skill *Skill, performance *PerformanceResult) {
 	sps.mu.Lock()
 	defer sps.mu.Unlock()
-	
-	// Increase skill level based on performance
-	improvement := performance.Improvement * 0.5 // Gradual improvement
-	skill.CurrentLevel += improvement
+
+	// Apply the learning curve: gains diminish as mastery approaches
+	gain := sps.learningCurve.PracticeGain(skill.CurrentLevel, performance.Score, skill.Difficulty)
+	skill.CurrentLevel += gain
 	skill.CurrentLevel = clamp(skill.CurrentLevel, 0.0, 1.0)
 	skill.LastPracticed = time.Now()
-	
+
 	sps.skillsImproved++
+
+	if sps.eventStore != nil {
+		sps.eventStore.Append("skill.updated", "skill_practice_system", map[string]interface{}{
+			"skill":  skill.Name,
+			"level":  skill.CurrentLevel,
+			"gain":   gain,
+			"score":  performance.Score,
+		})
+	}
+}
+
+// SetEventStore attaches an event store so skill level changes are
+// recorded as append-only events
+func (sps *SkillPracticeSystem) SetEventStore(store *events.EventStore) {
+	sps.mu.Lock()
+	defer sps.mu.Unlock()
+	sps.eventStore = store
 }
 
 // skillAssessmentLoop periodically assesses skill levels
@@ -560,10 +663,11 @@ func (sps *SkillPracticeSystem) skillAssessmentLoop() {
 	}
 }
 
-// assessAllSkills evaluates current skill levels
+// assessAllSkills evaluates current skill levels and decays neglected skills
 func (sps *SkillPracticeSystem) assessAllSkills() {
-	// Assess each skill and identify areas for improvement
-	// This could generate new practice goals
+	if decayed := sps.applySkillDecay(); decayed > 0 {
+		fmt.Printf("📉 Skill decay: %d unpracticed skill(s) lost proficiency\n", decayed)
+	}
 }
 
 // practiceGoalGenerationLoop generates autonomous practice goals
@@ -610,11 +714,18 @@ func (sps *SkillPracticeSystem) GetMetrics() map[string]interface{} {
 	sps.mu.RLock()
 	defer sps.mu.RUnlock()
 	
+	skillLevels := make(map[string]float64, len(sps.skills))
+	for _, skill := range sps.skills {
+		skillLevels[skill.Name] = skill.CurrentLevel
+	}
+
 	return map[string]interface{}{
 		"sessions_completed": sps.sessionsCompleted,
 		"skills_improved":    sps.skillsImproved,
+		"decay_events":       sps.decayEvents,
 		"total_skills":       len(sps.skills),
 		"active_goals":       len(sps.practiceGoals),
+		"skill_levels":       skillLevels,
 	}
 }
 
@@ -0,0 +1,211 @@
+package telemetry
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MetricSource is anything exposing a metrics map — every V4 subsystem
+// (cognitive load, wake/rest, stream of consciousness, dreams, wisdom,
+// goals, LLM providers) already satisfies this via GetMetrics().
+type MetricSource interface {
+	GetMetrics() map[string]interface{}
+}
+
+// PrometheusExporter renders registered metric sources in the
+// Prometheus text exposition format so long-running agents can be
+// scraped with standard tooling. No client library is required: the
+// exporter flattens each source's GetMetrics() map into gauges and
+// counters under the ecco9_ namespace.
+type PrometheusExporter struct {
+	mu      sync.RWMutex
+	sources map[string]MetricSource
+
+	// Extra gauges registered directly (value read at scrape time)
+	gauges map[string]func() float64
+
+	server *http.Server
+}
+
+// NewPrometheusExporter creates an empty exporter
+func NewPrometheusExporter() *PrometheusExporter {
+	return &PrometheusExporter{
+		sources: make(map[string]MetricSource),
+		gauges:  make(map[string]func() float64),
+	}
+}
+
+// RegisterSource exposes a subsystem's metrics under the given name,
+// e.g. RegisterSource("cognitive_load", loadManager) yields metrics
+// like ecco9_cognitive_load_fatigue
+func (pe *PrometheusExporter) RegisterSource(name string, source MetricSource) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.sources[sanitizeMetricName(name)] = source
+}
+
+// RegisterGauge exposes a single value read at scrape time, for
+// metrics not covered by a subsystem's GetMetrics() map
+func (pe *PrometheusExporter) RegisterGauge(name string, read func() float64) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.gauges[sanitizeMetricName(name)] = read
+}
+
+// Handler returns an http.Handler serving the exposition text,
+// suitable for mounting at /metrics
+func (pe *PrometheusExporter) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		w.Write([]byte(pe.Render()))
+	})
+}
+
+// Serve starts an HTTP server exposing /metrics on the given address
+func (pe *PrometheusExporter) Serve(addr string) error {
+	pe.mu.Lock()
+	if pe.server != nil {
+		pe.mu.Unlock()
+		return fmt.Errorf("telemetry server already running")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", pe.Handler())
+	pe.server = &http.Server{Addr: addr, Handler: mux}
+	server := pe.server
+	pe.mu.Unlock()
+
+	fmt.Printf("📊 Telemetry: Prometheus metrics on http://%s/metrics\n", addr)
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fmt.Printf("⚠️  Telemetry: metrics server failed: %v\n", err)
+		}
+	}()
+
+	return nil
+}
+
+// Stop shuts down the metrics server if one is running
+func (pe *PrometheusExporter) Stop() {
+	pe.mu.Lock()
+	server := pe.server
+	pe.server = nil
+	pe.mu.Unlock()
+
+	if server != nil {
+		server.Close()
+	}
+}
+
+// Render produces the full exposition text for all registered sources
+func (pe *PrometheusExporter) Render() string {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+
+	var sb strings.Builder
+
+	sourceNames := make([]string, 0, len(pe.sources))
+	for name := range pe.sources {
+		sourceNames = append(sourceNames, name)
+	}
+	sort.Strings(sourceNames)
+
+	for _, sourceName := range sourceNames {
+		metrics := pe.sources[sourceName].GetMetrics()
+
+		keys := make([]string, 0, len(metrics))
+		for key := range metrics {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			value, ok := numericValue(metrics[key])
+			if !ok {
+				continue
+			}
+			writeMetric(&sb, "ecco9_"+sourceName+"_"+sanitizeMetricName(key), value)
+		}
+	}
+
+	gaugeNames := make([]string, 0, len(pe.gauges))
+	for name := range pe.gauges {
+		gaugeNames = append(gaugeNames, name)
+	}
+	sort.Strings(gaugeNames)
+
+	for _, name := range gaugeNames {
+		writeMetric(&sb, "ecco9_"+name, pe.gauges[name]())
+	}
+
+	return sb.String()
+}
+
+// writeMetric emits the TYPE line and sample for one metric. Names
+// that read as monotonic (totals and counts) are typed as counters.
+func writeMetric(sb *strings.Builder, name string, value float64) {
+	metricType := "gauge"
+	if isCounterName(name) {
+		metricType = "counter"
+	}
+	fmt.Fprintf(sb, "# TYPE %s %s\n", name, metricType)
+	fmt.Fprintf(sb, "%s %g\n", name, value)
+}
+
+// isCounterName guesses monotonic metrics from naming conventions the
+// subsystems already follow (total_*, *_total, *_count, *_events)
+func isCounterName(name string) bool {
+	return strings.Contains(name, "total") ||
+		strings.HasSuffix(name, "_count") ||
+		strings.HasSuffix(name, "_events") ||
+		strings.HasSuffix(name, "_cycles")
+}
+
+// numericValue coerces the interface{} values GetMetrics maps carry
+// into float64 samples; non-numeric values (strings, nested maps) are
+// skipped
+func numericValue(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case bool:
+		if n {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sanitizeMetricName maps arbitrary names onto the Prometheus metric
+// name alphabet [a-zA-Z0-9_]
+func sanitizeMetricName(name string) string {
+	var sb strings.Builder
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,106 @@
+package wisdom
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// wisdomMetricsState is the serialized form of WisdomMetrics
+type wisdomMetricsState struct {
+	WisdomDepthScore   float64          `json:"wisdom_depth_score"`
+	CoherenceStability float64          `json:"coherence_stability"`
+	LearningVelocity   float64          `json:"learning_velocity"`
+	InsightFrequency   float64          `json:"insight_frequency"`
+	WisdomHistory      []WisdomSnapshot `json:"wisdom_history"`
+	InsightCount       int64            `json:"insight_count"`
+	SkillsAcquired     int64            `json:"skills_acquired"`
+	PatternsRecognized int64            `json:"patterns_recognized"`
+	ThoughtsRecorded   int64            `json:"thoughts_recorded"`
+	StartTime          time.Time        `json:"start_time"`
+	CycleAverages      []CycleAverage   `json:"cycle_averages"`
+}
+
+// ExportState serializes the wisdom metrics for archives and transfer
+func (wm *WisdomMetrics) ExportState() ([]byte, error) {
+	wm.mu.RLock()
+	state := wisdomMetricsState{
+		WisdomDepthScore:   wm.WisdomDepthScore,
+		CoherenceStability: wm.CoherenceStability,
+		LearningVelocity:   wm.LearningVelocity,
+		InsightFrequency:   wm.InsightFrequency,
+		WisdomHistory:      wm.WisdomHistory,
+		InsightCount:       wm.InsightCount,
+		SkillsAcquired:     wm.SkillsAcquired,
+		PatternsRecognized: wm.PatternsRecognized,
+		ThoughtsRecorded:   wm.ThoughtsRecorded,
+		StartTime:          wm.StartTime,
+		CycleAverages:      wm.CycleAverages,
+	}
+	wm.mu.RUnlock()
+
+	return json.MarshalIndent(state, "", "  ")
+}
+
+// SaveToFile persists wisdom metrics so cultivation survives restarts
+func (wm *WisdomMetrics) SaveToFile(path string) error {
+	data, err := wm.ExportState()
+	if err != nil {
+		return fmt.Errorf("failed to marshal wisdom metrics: %w", err)
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create wisdom metrics directory: %w", err)
+		}
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write wisdom metrics: %w", err)
+	}
+
+	return nil
+}
+
+// LoadFromFile restores previously persisted wisdom metrics. A missing
+// file is not an error - the metrics simply start fresh.
+func (wm *WisdomMetrics) LoadFromFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read wisdom metrics: %w", err)
+	}
+
+	return wm.ImportState(data)
+}
+
+// ImportState applies serialized wisdom metrics produced by ExportState
+func (wm *WisdomMetrics) ImportState(data []byte) error {
+	var state wisdomMetricsState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("failed to unmarshal wisdom metrics: %w", err)
+	}
+
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	wm.WisdomDepthScore = state.WisdomDepthScore
+	wm.CoherenceStability = state.CoherenceStability
+	wm.LearningVelocity = state.LearningVelocity
+	wm.InsightFrequency = state.InsightFrequency
+	wm.WisdomHistory = state.WisdomHistory
+	wm.InsightCount = state.InsightCount
+	wm.SkillsAcquired = state.SkillsAcquired
+	wm.PatternsRecognized = state.PatternsRecognized
+	wm.ThoughtsRecorded = state.ThoughtsRecorded
+	wm.CycleAverages = state.CycleAverages
+	if !state.StartTime.IsZero() {
+		wm.StartTime = state.StartTime
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package wisdom
+
+import (
+	"time"
+)
+
+// AggregatePeriod selects the bucketing granularity for rolling aggregates
+type AggregatePeriod string
+
+const (
+	AggregateHourly AggregatePeriod = "hour"
+	AggregateDaily  AggregatePeriod = "day"
+)
+
+// WisdomAggregate summarizes wisdom over one time bucket
+type WisdomAggregate struct {
+	Period       AggregatePeriod `json:"period"`
+	BucketStart  time.Time       `json:"bucket_start"`
+	AvgWisdom    float64         `json:"avg_wisdom"`
+	MinWisdom    float64         `json:"min_wisdom"`
+	MaxWisdom    float64         `json:"max_wisdom"`
+	AvgCoherence float64         `json:"avg_coherence"`
+	Samples      int             `json:"samples"`
+}
+
+// CycleAverage records the mean wisdom score over one wake cycle,
+// used for regression detection across cycles
+type CycleAverage struct {
+	CycleNumber uint64    `json:"cycle_number"`
+	EndedAt     time.Time `json:"ended_at"`
+	AvgWisdom   float64   `json:"avg_wisdom"`
+}
+
+// regressionWindow is how many consecutive declining wake cycles
+// trigger a wisdom regression alert
+const regressionWindow = 3
+
+// GetAggregates buckets the wisdom history into hourly or daily rolling
+// aggregates, oldest first
+func (wm *WisdomMetrics) GetAggregates(period AggregatePeriod) []WisdomAggregate {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	var bucket time.Duration
+	switch period {
+	case AggregateDaily:
+		bucket = 24 * time.Hour
+	default:
+		period = AggregateHourly
+		bucket = time.Hour
+	}
+
+	aggregates := make([]WisdomAggregate, 0)
+	var current *WisdomAggregate
+
+	for _, snapshot := range wm.WisdomHistory {
+		start := snapshot.Timestamp.Truncate(bucket)
+
+		if current == nil || !current.BucketStart.Equal(start) {
+			if current != nil {
+				finalizeAggregate(current)
+				aggregates = append(aggregates, *current)
+			}
+			current = &WisdomAggregate{
+				Period:      period,
+				BucketStart: start,
+				MinWisdom:   snapshot.WisdomScore,
+				MaxWisdom:   snapshot.WisdomScore,
+			}
+		}
+
+		current.AvgWisdom += snapshot.WisdomScore
+		current.AvgCoherence += snapshot.Coherence
+		if snapshot.WisdomScore < current.MinWisdom {
+			current.MinWisdom = snapshot.WisdomScore
+		}
+		if snapshot.WisdomScore > current.MaxWisdom {
+			current.MaxWisdom = snapshot.WisdomScore
+		}
+		current.Samples++
+	}
+
+	if current != nil {
+		finalizeAggregate(current)
+		aggregates = append(aggregates, *current)
+	}
+
+	return aggregates
+}
+
+func finalizeAggregate(agg *WisdomAggregate) {
+	if agg.Samples > 0 {
+		agg.AvgWisdom /= float64(agg.Samples)
+		agg.AvgCoherence /= float64(agg.Samples)
+	}
+}
+
+// WisdomTrend describes the direction of wisdom change over a window
+type WisdomTrend struct {
+	Window    time.Duration `json:"window"`
+	Slope     float64       `json:"slope"` // wisdom change per hour
+	Direction string        `json:"direction"`
+	Samples   int           `json:"samples"`
+}
+
+// GetTrend computes the wisdom trend over the given trailing window
+// using a least-squares fit over the history
+func (wm *WisdomMetrics) GetTrend(window time.Duration) WisdomTrend {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	cutoff := time.Now().Add(-window)
+	trend := WisdomTrend{Window: window, Direction: "stable"}
+
+	// Least-squares fit of wisdom score against hours since cutoff
+	var n, sumX, sumY, sumXY, sumXX float64
+	for _, snapshot := range wm.WisdomHistory {
+		if snapshot.Timestamp.Before(cutoff) {
+			continue
+		}
+		x := snapshot.Timestamp.Sub(cutoff).Hours()
+		y := snapshot.WisdomScore
+		n++
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	trend.Samples = int(n)
+	if n < 2 {
+		return trend
+	}
+
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return trend
+	}
+
+	trend.Slope = (n*sumXY - sumX*sumY) / denom
+	switch {
+	case trend.Slope > 0.001:
+		trend.Direction = "improving"
+	case trend.Slope < -0.001:
+		trend.Direction = "declining"
+	}
+
+	return trend
+}
+
+// RecordThought notes that a thought contributed to wisdom cultivation
+func (wm *WisdomMetrics) RecordThought() {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	wm.ThoughtsRecorded++
+}
+
+// RecordWakeCycle closes out a wake cycle for regression tracking,
+// recording the average wisdom score observed during the cycle.
+// It returns true if wisdom has declined across the last few cycles
+// (a "wisdom regression"), in which case the alert callback also fires.
+func (wm *WisdomMetrics) RecordWakeCycle(cycleNumber uint64, avgWisdom float64) bool {
+	wm.mu.Lock()
+
+	wm.CycleAverages = append(wm.CycleAverages, CycleAverage{
+		CycleNumber: cycleNumber,
+		EndedAt:     time.Now(),
+		AvgWisdom:   avgWisdom,
+	})
+
+	// Keep a bounded history of cycles
+	if len(wm.CycleAverages) > 100 {
+		wm.CycleAverages = wm.CycleAverages[len(wm.CycleAverages)-100:]
+	}
+
+	regressed := wm.isRegressing()
+	alert := wm.onRegression
+	wm.mu.Unlock()
+
+	if regressed && alert != nil {
+		alert(avgWisdom)
+	}
+
+	return regressed
+}
+
+// SetRegressionAlert registers a callback fired when wisdom declines
+// across consecutive wake cycles
+func (wm *WisdomMetrics) SetRegressionAlert(fn func(currentWisdom float64)) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	wm.onRegression = fn
+}
+
+// isRegressing reports whether wisdom declined monotonically across the
+// last regressionWindow cycles. Caller must hold the lock.
+func (wm *WisdomMetrics) isRegressing() bool {
+	if len(wm.CycleAverages) < regressionWindow+1 {
+		return false
+	}
+
+	recent := wm.CycleAverages[len(wm.CycleAverages)-(regressionWindow+1):]
+	for i := 1; i < len(recent); i++ {
+		if recent[i].AvgWisdom >= recent[i-1].AvgWisdom {
+			return false
+		}
+	}
+
+	return true
+}
@@ -92,5 +92,5 @@ require (
 	golang.org/x/term v0.30.0
 	golang.org/x/text v0.15.0
 	google.golang.org/protobuf v1.34.1
-	gopkg.in/yaml.v3 v3.0.1 // indirect
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -1,149 +0,0 @@
-package main
-
-import (
-	"fmt"
-	"time"
-
-	"github.com/EchoCog/echollama/core/deeptreeecho"
-)
-
-func main() {
-	fmt.Println("╔════════════════════════════════════════════════════════════╗")
-	fmt.Println("║  Echo9llama Autonomous Consciousness V4 Test              ║")
-	fmt.Println("║  Iteration 4: Concurrent Engines & Continuous Stream      ║")
-	fmt.Println("╚════════════════════════════════════════════════════════════╝")
-	fmt.Println()
-
-	// Create autonomous consciousness
-	fmt.Println("🧠 Initializing Autonomous Consciousness V4...")
-	ac := deeptreeecho.NewAutonomousConsciousnessV4("EchoSelf")
-	
-	if ac == nil {
-		fmt.Println("❌ Failed to create autonomous consciousness")
-		return
-	}
-	
-	fmt.Println("✅ Autonomous Consciousness V4 created")
-	fmt.Println()
-
-	// Test 1: Wake the system
-	fmt.Println("📋 Test 1: Wake System")
-	fmt.Println("   Waking autonomous consciousness...")
-	ac.Wake()
-	fmt.Println("   ✅ System is awake")
-	fmt.Println()
-
-	// Test 2: Check status
-	fmt.Println("📋 Test 2: System Status")
-	status := ac.GetStatus()
-	fmt.Printf("   Identity: %v\n", status["identity"])
-	fmt.Printf("   Awake: %v\n", status["awake"])
-	fmt.Printf("   Running: %v\n", status["running"])
-	fmt.Printf("   Iterations: %v\n", status["iterations"])
-	fmt.Println("   ✅ Status retrieved successfully")
-	fmt.Println()
-
-	// Test 3: Let it run for a bit
-	fmt.Println("📋 Test 3: Autonomous Operation")
-	fmt.Println("   Running for 5 seconds to observe autonomous behavior...")
-	time.Sleep(5 * time.Second)
-	
-	status = ac.GetStatus()
-	fmt.Printf("   Iterations after 5s: %v\n", status["iterations"])
-	fmt.Println("   ✅ System is operating autonomously")
-	fmt.Println()
-
-	// Test 4: Check consciousness stream
-	fmt.Println("📋 Test 4: Continuous Consciousness Stream")
-	if consciousnessStatus, ok := status["consciousness_stream"].(map[string]interface{}); ok {
-		fmt.Printf("   Activity Level: %v\n", consciousnessStatus["activity_level"])
-		fmt.Printf("   Thoughts Emerged: %v\n", consciousnessStatus["thoughts_emerged"])
-		fmt.Printf("   Flow Quality: %v\n", consciousnessStatus["flow_quality"])
-		fmt.Println("   ✅ Consciousness stream is active")
-	} else {
-		fmt.Println("   ⚠️  Consciousness stream status not available")
-	}
-	fmt.Println()
-
-	// Test 5: Check concurrent inference engines
-	fmt.Println("📋 Test 5: Concurrent Inference Engines")
-	if inferenceStatus, ok := status["inference_engines"].(map[string]interface{}); ok {
-		fmt.Printf("   Affordance Engine (Past): %v\n", inferenceStatus["affordance_active"])
-		fmt.Printf("   Relevance Engine (Present): %v\n", inferenceStatus["relevance_active"])
-		fmt.Printf("   Salience Engine (Future): %v\n", inferenceStatus["salience_active"])
-		fmt.Println("   ✅ Concurrent engines are operational")
-	} else {
-		fmt.Println("   ⚠️  Inference engine status not available")
-	}
-	fmt.Println()
-
-	// Test 6: Check cognitive load
-	fmt.Println("📋 Test 6: Cognitive Load Management")
-	if loadStatus, ok := status["cognitive_load"].(map[string]interface{}); ok {
-		fmt.Printf("   Current Load: %v\n", loadStatus["current_load"])
-		fmt.Printf("   Fatigue Level: %v\n", loadStatus["fatigue_level"])
-		fmt.Println("   ✅ Cognitive load is being tracked")
-	} else {
-		fmt.Println("   ⚠️  Cognitive load status not available")
-	}
-	fmt.Println()
-
-	// Test 7: Check interest patterns
-	fmt.Println("📋 Test 7: Interest Patterns")
-	if interestStatus, ok := status["interests"].(map[string]interface{}); ok {
-		fmt.Printf("   Curiosity Level: %v\n", interestStatus["curiosity_level"])
-		fmt.Printf("   Top Interests: %v\n", interestStatus["top_interests"])
-		fmt.Println("   ✅ Interest patterns are active")
-	} else {
-		fmt.Println("   ⚠️  Interest pattern status not available")
-	}
-	fmt.Println()
-
-	// Test 8: Check skills
-	fmt.Println("📋 Test 8: Skill Registry")
-	if skillStatus, ok := status["skills"].(map[string]interface{}); ok {
-		fmt.Printf("   Total Skills: %v\n", skillStatus["total_skills"])
-		fmt.Printf("   Practice Sessions: %v\n", skillStatus["practice_sessions"])
-		fmt.Println("   ✅ Skill registry is functional")
-	} else {
-		fmt.Println("   ⚠️  Skill registry status not available")
-	}
-	fmt.Println()
-
-	// Test 9: Check wisdom metrics
-	fmt.Println("📋 Test 9: Wisdom Metrics")
-	if wisdomStatus, ok := status["wisdom"].(map[string]interface{}); ok {
-		fmt.Printf("   Wisdom Score: %v\n", wisdomStatus["wisdom_score"])
-		fmt.Printf("   Knowledge Depth: %v\n", wisdomStatus["knowledge_depth"])
-		fmt.Printf("   Reflective Insight: %v\n", wisdomStatus["reflective_insight"])
-		fmt.Println("   ✅ Wisdom metrics are being tracked")
-	} else {
-		fmt.Println("   ⚠️  Wisdom metrics status not available")
-	}
-	fmt.Println()
-
-	// Test 10: Graceful shutdown
-	fmt.Println("📋 Test 10: Graceful Shutdown")
-	fmt.Println("   Stopping autonomous consciousness...")
-	err := ac.Stop()
-	if err != nil {
-		fmt.Printf("   ❌ Stop failed: %v\n", err)
-	} else {
-		fmt.Println("   ✅ System stopped gracefully")
-	}
-	fmt.Println()
-
-	// Summary
-	fmt.Println("╔════════════════════════════════════════════════════════════╗")
-	fmt.Println("║  Test Summary                                              ║")
-	fmt.Println("╚════════════════════════════════════════════════════════════╝")
-	fmt.Println("✅ Autonomous Consciousness V4 is operational")
-	fmt.Println("✅ Concurrent inference engines implemented")
-	fmt.Println("✅ Continuous consciousness stream active")
-	fmt.Println("✅ Cognitive load management functional")
-	fmt.Println("✅ Interest patterns and skill tracking working")
-	fmt.Println("✅ Wisdom metrics being calculated")
-	fmt.Println()
-	fmt.Println("🎉 Iteration 4 validation complete!")
-	fmt.Println("🚀 Ready for next evolution toward fully autonomous AGI")
-}